@@ -0,0 +1,227 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/mr-tron/base58"
+)
+
+// Account is a comparable value form of an AccountID for hot paths, such as
+// indexers that keep tens of millions of accounts in memory. Well-known
+// namespaces are stored as a one-byte code, eip155 chain IDs as integers, the
+// references of well-known Solana and BIP122 networks as a table index, and EVM
+// addresses and 32-byte base58 or hex addresses as fixed-size byte arrays, so
+// such accounts hold no pointers. Other values fall back to strings.
+//
+// Like AccountKey, Account is canonical: EVM addresses are restored in EIP-55
+// form, so accounts that differ only in address casing are equal. The zero
+// value is the zero account.
+type Account struct {
+	namespace byte // code in compactNamespaces, 0 if other is used
+	refKind   byte
+	addrKind  byte
+	chain     uint64   // eip155 chain ID or index in accountReferences
+	raw       [32]byte // address bytes, see addrKind
+	other     Namespace
+	reference string
+	address   string
+}
+
+// Reference encodings of Account.
+const (
+	accountRefString    = iota // reference
+	accountRefChainID          // eip155 chain ID in chain
+	accountRefWellKnown        // accountReferences[chain]
+)
+
+// Address encodings of Account.
+const (
+	accountAddrString = iota // address
+	accountAddrEVM           // raw[:20], EIP-55 hex
+	accountAddrBase58        // raw, base58 of 32 bytes
+	accountAddrHex32         // raw, 0x followed by 64 lowercase hex characters
+)
+
+// accountReferences are the references stored as an index in Account.
+var accountReferences = []string{
+	SolanaMainnet.String(), SolanaDevnet.String(), SolanaTestnet.String(),
+	BitcoinMainnet.String(), BitcoinTestnet.String(),
+	BitcoinCashMainnet.String(), LitecoinMainnet.String(), LitecoinTestnet.String(),
+	DogecoinMainnet.String(), DogecoinTestnet.String(), DashMainnet.String(),
+	ZcashMainnet.String(), ZcashTestnet.String(),
+}
+
+var accountReferenceIndex = func() map[string]int {
+	m := make(map[string]int, len(accountReferences))
+	for i, ref := range accountReferences {
+		m[ref] = i
+	}
+	return m
+}()
+
+// AccountOf returns the Account value of an account; nil and zero accounts
+// return the zero Account.
+func AccountOf(a AccountID) Account {
+	if a == nil || a.IsZero() {
+		return Account{}
+	}
+	return newAccount(a.Namespace(), a.Reference(), a.Address())
+}
+
+// ParseAccount parses and validates a CAIP-10 string into an Account.
+func ParseAccount(s string, opts ...ParseOption) (Account, error) {
+	a, err := Parse(s, opts...)
+	if err != nil {
+		return Account{}, err
+	}
+	return AccountOf(a), nil
+}
+
+func newAccount(namespace Namespace, reference, address string) Account {
+	var v Account
+	if v.namespace = compactNamespaceCode(namespace); v.namespace == 0 {
+		v.other = namespace
+	}
+
+	if chainID, ok := compactChainID(namespace, reference); ok {
+		v.refKind, v.chain = accountRefChainID, chainID
+	} else if i, ok := accountReferenceIndex[reference]; ok && (namespace == NamespaceSolana || namespace == NamespaceBIP122) {
+		v.refKind, v.chain = accountRefWellKnown, uint64(i)
+	} else {
+		v.reference = reference
+	}
+
+	switch kind, raw := compactAddress(namespace, address); kind {
+	case compactAddrEIP55, compactAddrLowerHex:
+		v.addrKind = accountAddrEVM
+		copy(v.raw[:], raw)
+	case compactAddrBase58:
+		v.addrKind = accountAddrBase58
+		copy(v.raw[:], raw)
+	default:
+		if isLowerHex32(address) {
+			v.addrKind = accountAddrHex32
+			hex.Decode(v.raw[:], []byte(address[2:]))
+		} else if namespace == NamespaceEIP155 {
+			v.address = strings.ToLower(address)
+		} else {
+			v.address = address
+		}
+	}
+	return v
+}
+
+// isLowerHex32 reports whether s is 0x followed by 64 lowercase hex characters.
+func isLowerHex32(s string) bool {
+	if len(s) != 66 || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	for i := 2; i < len(s); i++ {
+		if c := s[i]; (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsZero reports whether the account is the zero value.
+func (v Account) IsZero() bool {
+	return v == Account{}
+}
+
+// Namespace returns the blockchain namespace.
+func (v Account) Namespace() Namespace {
+	if v.namespace != 0 {
+		return compactNamespaces[v.namespace]
+	}
+	return v.other
+}
+
+// Reference returns the chain reference.
+func (v Account) Reference() string {
+	switch v.refKind {
+	case accountRefChainID:
+		return strconv.FormatUint(v.chain, 10)
+	case accountRefWellKnown:
+		return accountReferences[v.chain]
+	default:
+		return v.reference
+	}
+}
+
+// Address returns the account address in canonical form.
+func (v Account) Address() string {
+	switch v.addrKind {
+	case accountAddrEVM:
+		return ecommon.BytesToAddress(v.raw[:ecommon.AddressLength]).Hex()
+	case accountAddrBase58:
+		return base58.Encode(v.raw[:])
+	case accountAddrHex32:
+		return "0x" + hex.EncodeToString(v.raw[:])
+	default:
+		return v.address
+	}
+}
+
+// ChainID returns the CAIP-2 chain ID of the account.
+func (v Account) ChainID() ChainID {
+	if v.IsZero() {
+		return ChainID{}
+	}
+	return ChainID{Namespace: v.Namespace(), Reference: v.Reference()}
+}
+
+// String returns the CAIP-10 string of the account.
+func (v Account) String() string {
+	if v.IsZero() {
+		return ""
+	}
+	return string(v.Namespace()) + ":" + v.Reference() + ":" + v.Address()
+}
+
+// Key returns the AccountKey of the account.
+func (v Account) Key() AccountKey {
+	if v.IsZero() {
+		return AccountKey{}
+	}
+	return newAccountKey(v.Namespace(), v.Reference(), v.Address())
+}
+
+// AccountID parses the account into its native AccountID type with validation.
+func (v Account) AccountID(opts ...ParseOption) (AccountID, error) {
+	if v.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	return ParseWithNamespace(v.Namespace(), v.Reference(), v.Address(), opts...)
+}
+
+// Generic returns the account as a *GenericAccountID without validation. The
+// account was validated when it was created from an AccountID.
+func (v Account) Generic() *GenericAccountID {
+	if v.IsZero() {
+		return nil
+	}
+	return newGenericUnchecked(v.Namespace(), v.Reference(), v.Address())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Account) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is the zero account.
+func (v *Account) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = Account{}
+		return nil
+	}
+	a, err := ParseAccount(string(text))
+	if err != nil {
+		return err
+	}
+	*v = a
+	return nil
+}
@@ -0,0 +1,96 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountValue(t *testing.T) {
+	tests := []struct {
+		input    string
+		inline   bool // no string fields
+		expected string
+	}{
+		{"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", true, ""},
+		{"eip155:137:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", true, "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", true, ""},
+		{"sui:mainnet:0x02a212de6a9dfa3a69e22387acfbafbb1a9e591bd9d636e7895dcfc8de05f331", false, ""},
+		{"bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", false, ""},
+		{"cosmos:cosmoshub-4:cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0", false, ""},
+		{"hedera:mainnet:0.0.123", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			want := tt.expected
+			if want == "" {
+				want = tt.input
+			}
+			v, err := ParseAccount(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, want, v.String())
+			assert.Equal(t, KeyOf(MustParse(tt.input)), v.Key())
+			if tt.inline {
+				assert.Empty(t, v.other)
+				assert.Empty(t, v.reference)
+				assert.Empty(t, v.address)
+			}
+
+			a, err := v.AccountID()
+			require.NoError(t, err)
+			assert.Equal(t, want, a.String())
+			assert.Equal(t, v, AccountOf(a))
+			assert.Equal(t, want, v.Generic().String())
+			assert.Equal(t, a.ChainID(), v.ChainID())
+		})
+	}
+}
+
+func TestAccountValueComparable(t *testing.T) {
+	checksummed, err := ParseAccount("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	require.NoError(t, err)
+	lower, err := ParseAccount("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	require.NoError(t, err)
+	other, err := ParseAccount("eip155:10:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	require.NoError(t, err)
+
+	assert.True(t, checksummed == lower)
+	assert.False(t, checksummed == other)
+	seen := map[Account]int{checksummed: 1}
+	seen[lower]++
+	assert.Equal(t, 2, seen[checksummed])
+}
+
+func TestAccountValueZero(t *testing.T) {
+	var v Account
+	assert.True(t, v.IsZero())
+	assert.Equal(t, Account{}, AccountOf(nil))
+	assert.Equal(t, "", v.String())
+	assert.True(t, v.ChainID().IsZero())
+	assert.True(t, v.Key().IsZero())
+	assert.Nil(t, v.Generic())
+	_, err := v.AccountID()
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+
+	_, err = ParseAccount("eip155:1:0x123")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestAccountValueJSON(t *testing.T) {
+	type row struct {
+		Owner Account            `json:"owner"`
+		Seen  map[Account]string `json:"seen"`
+	}
+	v, err := ParseAccount("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	require.NoError(t, err)
+	data, err := json.Marshal(row{Owner: v, Seen: map[Account]string{v: "x"}})
+	require.NoError(t, err)
+
+	var got row
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, v, got.Owner)
+	assert.Equal(t, "x", got.Seen[v])
+}
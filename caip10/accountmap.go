@@ -0,0 +1,119 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+type accountIDMapEntry[V any] struct {
+	account AccountID
+	value   V
+}
+
+// AccountIDMap is a map keyed by account. Keys are compared by AccountKey, so a
+// *GenericAccountID and a native EIP155AccountID for the same address, or EVM
+// addresses differing only in case, refer to the same entry.
+//
+// The zero value is an empty map ready to use. It is not safe for concurrent use.
+type AccountIDMap[V any] struct {
+	m map[AccountKey]accountIDMapEntry[V]
+}
+
+// NewAccountIDMap creates an empty AccountIDMap.
+func NewAccountIDMap[V any]() *AccountIDMap[V] {
+	return &AccountIDMap[V]{m: make(map[AccountKey]accountIDMapEntry[V])}
+}
+
+// Set stores v under account, replacing any value stored under an equivalent account.
+// Zero accounts are ignored.
+func (m *AccountIDMap[V]) Set(account AccountID, v V) {
+	if account == nil || account.IsZero() {
+		return
+	}
+	if m.m == nil {
+		m.m = make(map[AccountKey]accountIDMapEntry[V])
+	}
+	m.m[KeyOf(account)] = accountIDMapEntry[V]{account: account, value: v}
+}
+
+// Get returns the value stored under account.
+func (m *AccountIDMap[V]) Get(account AccountID) (V, bool) {
+	if account == nil || account.IsZero() {
+		var zero V
+		return zero, false
+	}
+	e, ok := m.m[KeyOf(account)]
+	return e.value, ok
+}
+
+// Has reports whether a value is stored under account.
+func (m *AccountIDMap[V]) Has(account AccountID) bool {
+	_, ok := m.Get(account)
+	return ok
+}
+
+// Delete removes the value stored under account.
+func (m *AccountIDMap[V]) Delete(account AccountID) {
+	if account == nil || account.IsZero() {
+		return
+	}
+	delete(m.m, KeyOf(account))
+}
+
+// Len returns the number of entries.
+func (m *AccountIDMap[V]) Len() int {
+	return len(m.m)
+}
+
+// Clear removes all entries.
+func (m *AccountIDMap[V]) Clear() {
+	clear(m.m)
+}
+
+// All returns an iterator over the entries in unspecified order.
+// The account yielded is the one most recently passed to Set.
+func (m *AccountIDMap[V]) All() iter.Seq2[AccountID, V] {
+	return func(yield func(AccountID, V) bool) {
+		for _, e := range m.m {
+			if !yield(e.account, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Accounts returns the keys in unspecified order.
+func (m *AccountIDMap[V]) Accounts() []AccountID {
+	out := make([]AccountID, 0, len(m.m))
+	for _, e := range m.m {
+		out = append(out, e.account)
+	}
+	return out
+}
+
+// MarshalJSON encodes the map as a JSON object keyed by CAIP-10 strings.
+func (m *AccountIDMap[V]) MarshalJSON() ([]byte, error) {
+	out := make(map[string]V, len(m.m))
+	for _, e := range m.m {
+		out[e.account.String()] = e.value
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a JSON object keyed by CAIP-10 strings, replacing the contents.
+func (m *AccountIDMap[V]) UnmarshalJSON(data []byte) error {
+	var in map[string]V
+	if err := json.Unmarshal(data, &in); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	m.m = make(map[AccountKey]accountIDMapEntry[V], len(in))
+	for k, v := range in {
+		a, err := Parse(k)
+		if err != nil {
+			return err
+		}
+		m.Set(a, v)
+	}
+	return nil
+}
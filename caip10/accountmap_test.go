@@ -0,0 +1,78 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountIDMap(t *testing.T) {
+	native := MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	generic := newGenericUnchecked(NamespaceEIP155, "1", "0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB")
+	other := MustParse("eip155:137:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+
+	var m AccountIDMap[int]
+	m.Set(native, 1)
+	m.Set(other, 2)
+	m.Set(sol, 3)
+	assert.Equal(t, 3, m.Len())
+
+	v, ok := m.Get(generic)
+	assert.True(t, ok, "generic and native accounts share a key")
+	assert.Equal(t, 1, v)
+
+	m.Set(generic, 10)
+	assert.Equal(t, 3, m.Len())
+	v, _ = m.Get(native)
+	assert.Equal(t, 10, v)
+
+	m.Delete(generic)
+	assert.False(t, m.Has(native))
+	assert.Equal(t, 2, m.Len())
+
+	m.Set(nil, 4)
+	m.Set(&GenericAccountID{}, 5)
+	assert.Equal(t, 2, m.Len())
+	_, ok = m.Get(nil)
+	assert.False(t, ok)
+
+	seen := map[string]int{}
+	for a, v := range m.All() {
+		seen[a.String()] = v
+	}
+	assert.Equal(t, map[string]int{other.String(): 2, sol.String(): 3}, seen)
+	assert.Len(t, m.Accounts(), 2)
+
+	m.Clear()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestAccountIDMapCaseSensitive(t *testing.T) {
+	// base58 and other non-hex addresses remain case-sensitive
+	m := NewAccountIDMap[string]()
+	m.Set(MustNewGeneric("cosmos", "cosmoshub-4", "Abc"), "upper")
+	m.Set(MustNewGeneric("cosmos", "cosmoshub-4", "abc"), "lower")
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestAccountIDMapJSON(t *testing.T) {
+	m := NewAccountIDMap[int]()
+	m.Set(MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"), 7)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb":7}`, string(data))
+
+	var back AccountIDMap[int]
+	require.NoError(t, json.Unmarshal(data, &back))
+	v, ok := back.Get(MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"))
+	assert.True(t, ok)
+	assert.Equal(t, 7, v)
+
+	err = json.Unmarshal([]byte(`{"not-an-account":1}`), &back)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
@@ -0,0 +1,216 @@
+package caip10
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+	"fmt"
+)
+
+const NamespaceAlgorand Namespace = "algorand"
+
+// AlgorandNetwork represents an Algorand network (chain reference).
+// The reference is the first 32 characters of the url-safe base64 genesis hash.
+// https://github.com/ChainAgnostic/namespaces/blob/main/algorand/caip2.md
+type AlgorandNetwork string
+
+// Common Algorand networks (genesis hash prefix)
+const (
+	AlgorandMainnet AlgorandNetwork = "wGHE2Pwdvd7S12BL5FaOP20EGYesN73k"
+	AlgorandTestnet AlgorandNetwork = "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDe"
+	AlgorandBetanet AlgorandNetwork = "mFgazF-2uRS1tMiL9dsj01hJGySEmPN2"
+)
+
+// String returns the network reference string.
+// If the reference is longer than 32 characters, it will be truncated.
+func (n AlgorandNetwork) String() string {
+	s := string(n)
+	if len(s) > 32 {
+		return s[:32]
+	}
+	return s
+}
+
+// AlgorandPublicKeyLength is the length of an ed25519 account public key.
+const AlgorandPublicKeyLength = 32
+
+// algorandAddressLength is the length of a base32 address:
+// 32 key bytes followed by a 4 byte checksum.
+const algorandAddressLength = 58
+
+// algorandChecksumLength is the number of trailing SHA512/256 bytes used as checksum.
+const algorandChecksumLength = 4
+
+// algorandEncoding is the unpadded RFC 4648 base32 alphabet used by Algorand.
+var algorandEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func algorandChecksum(pubkey []byte) []byte {
+	h := sha512.Sum512_256(pubkey)
+	return h[len(h)-algorandChecksumLength:]
+}
+
+// EncodeAlgorandAddress encodes a raw ed25519 public key as an Algorand address.
+func EncodeAlgorandAddress(pubkey [AlgorandPublicKeyLength]byte) string {
+	data := append(pubkey[:], algorandChecksum(pubkey[:])...)
+	return algorandEncoding.EncodeToString(data)
+}
+
+// DecodeAlgorandAddress decodes an Algorand address into its raw ed25519 public key.
+//
+// Validation steps:
+//  1. Length is exactly 58 characters
+//  2. Base32 decode succeeds (uppercase alphabet, no padding)
+//  3. Last 4 bytes match the SHA512/256 checksum of the public key
+func DecodeAlgorandAddress(address string) ([AlgorandPublicKeyLength]byte, error) {
+	var pubkey [AlgorandPublicKeyLength]byte
+	if len(address) != algorandAddressLength {
+		return pubkey, fmt.Errorf("%w: algorand address must be %d characters, got %d",
+			ErrInvalidAddress, algorandAddressLength, len(address))
+	}
+
+	data, err := algorandEncoding.DecodeString(address)
+	if err != nil {
+		return pubkey, fmt.Errorf("%w: invalid base32 encoding", ErrInvalidAddress)
+	}
+
+	key := data[:AlgorandPublicKeyLength]
+	if !bytes.Equal(algorandChecksum(key), data[AlgorandPublicKeyLength:]) {
+		return pubkey, fmt.Errorf("%w: algorand checksum mismatch", ErrInvalidChecksum)
+	}
+	copy(pubkey[:], key)
+	return pubkey, nil
+}
+
+// ValidateAlgorandAddress validates an Algorand address string.
+// Returns nil if valid, error otherwise.
+func ValidateAlgorandAddress(address string) error {
+	_, err := DecodeAlgorandAddress(address)
+	return err
+}
+
+// AlgorandAccountID is the interface for Algorand account IDs.
+type AlgorandAccountID interface {
+	AccountID
+	// Network returns the Algorand network.
+	Network() AlgorandNetwork
+	// PublicKey returns the raw ed25519 public key.
+	PublicKey() [AlgorandPublicKeyLength]byte
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+	// IsBetanet returns true if this is a betanet account.
+	IsBetanet() bool
+}
+
+// Ensure algorandAccountID implements AlgorandAccountID at compile time
+var _ AlgorandAccountID = (*algorandAccountID)(nil)
+
+func init() {
+	RegisterParser(&algorandParser{})
+}
+
+// algorandAccountID represents an Algorand account ID per CAIP-10.
+type algorandAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	pubkey            [AlgorandPublicKeyLength]byte
+}
+
+// NewAlgorand creates a new AlgorandAccountID from a raw ed25519 public key.
+func NewAlgorand(network AlgorandNetwork, pubkey [AlgorandPublicKeyLength]byte) AlgorandAccountID {
+	return &algorandAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceAlgorand, network.String(), EncodeAlgorandAddress(pubkey)),
+		pubkey:           pubkey,
+	}
+}
+
+// NewAlgorandFromAddress creates a new AlgorandAccountID from a base32 address.
+func NewAlgorandFromAddress(network AlgorandNetwork, address string) (AlgorandAccountID, error) {
+	if err := validateReference(NamespaceAlgorand, string(network)); err != nil {
+		return nil, err
+	}
+	pubkey, err := DecodeAlgorandAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return NewAlgorand(network, pubkey), nil
+}
+
+// MustNewAlgorandFromAddress creates a new AlgorandAccountID and panics if invalid.
+func MustNewAlgorandFromAddress(network AlgorandNetwork, address string) AlgorandAccountID {
+	a, err := NewAlgorandFromAddress(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Algorand network.
+func (a *algorandAccountID) Network() AlgorandNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return AlgorandNetwork(a.Reference())
+}
+
+// PublicKey returns the raw ed25519 public key.
+func (a *algorandAccountID) PublicKey() [AlgorandPublicKeyLength]byte {
+	if a == nil {
+		return [AlgorandPublicKeyLength]byte{}
+	}
+	return a.pubkey
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *algorandAccountID) IsMainnet() bool {
+	return a.Network() == AlgorandMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *algorandAccountID) IsTestnet() bool {
+	return a.Network() == AlgorandTestnet
+}
+
+// IsBetanet returns true if this is a betanet account.
+func (a *algorandAccountID) IsBetanet() bool {
+	return a.Network() == AlgorandBetanet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *algorandAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *algorandAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- algorandParser ---
+
+type algorandParser struct{}
+
+func (p *algorandParser) Namespace() Namespace {
+	return NamespaceAlgorand
+}
+
+func (p *algorandParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceAlgorand {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceAlgorand, ns)
+	}
+	return NewAlgorandFromAddress(AlgorandNetwork(ref), addr)
+}
+
+func (p *algorandParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewAlgorandFromAddress(AlgorandNetwork(reference), address)
+}
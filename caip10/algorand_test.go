@@ -0,0 +1,77 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// algorandZeroAddress is the address of the all-zero public key.
+const algorandZeroAddress = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+
+func TestAlgorandAddressRoundTrip(t *testing.T) {
+	pubkey, err := DecodeAlgorandAddress(algorandZeroAddress)
+	require.NoError(t, err)
+	assert.Equal(t, [AlgorandPublicKeyLength]byte{}, pubkey)
+	assert.Equal(t, algorandZeroAddress, EncodeAlgorandAddress(pubkey))
+
+	pubkey[0] = 0xab
+	addr := EncodeAlgorandAddress(pubkey)
+	decoded, err := DecodeAlgorandAddress(addr)
+	require.NoError(t, err)
+	assert.Equal(t, pubkey, decoded)
+}
+
+func TestAlgorandParse(t *testing.T) {
+	input := "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:" + algorandZeroAddress
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	algo, ok := a.(AlgorandAccountID)
+	require.True(t, ok, "expected AlgorandAccountID, got %T", a)
+	assert.Equal(t, AlgorandMainnet, algo.Network())
+	assert.True(t, algo.IsMainnet())
+	assert.False(t, algo.IsTestnet())
+	assert.False(t, algo.IsBetanet())
+	assert.Equal(t, ChainIDAlgorandMainnet, algo.ChainID())
+	assert.Equal(t, [AlgorandPublicKeyLength]byte{}, algo.PublicKey())
+	assert.Equal(t, input, algo.String())
+}
+
+func TestAlgorandNetworks(t *testing.T) {
+	var pubkey [AlgorandPublicKeyLength]byte
+	assert.True(t, NewAlgorand(AlgorandTestnet, pubkey).IsTestnet())
+	assert.True(t, NewAlgorand(AlgorandBetanet, pubkey).IsBetanet())
+	assert.NoError(t, NewAlgorand(AlgorandBetanet, pubkey).Validate())
+}
+
+func TestAlgorandParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "algorand:mainnet:" + algorandZeroAddress, ErrInvalidReference},
+		{"bad checksum", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKA", ErrInvalidAddress},
+		{"lowercase", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaay5hfkq", ErrInvalidAddress},
+		{"too short", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:AAAAAAAA", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestAlgorandNilReceiver(t *testing.T) {
+	var a *algorandAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, AlgorandNetwork(""), a.Network())
+	assert.Equal(t, [AlgorandPublicKeyLength]byte{}, a.PublicKey())
+	assert.False(t, a.IsMainnet())
+}
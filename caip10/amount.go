@@ -0,0 +1,252 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// slip44Currencies are the native currencies of well-known SLIP-44 coin types,
+// used for chains without registered ChainMetadata.
+var slip44Currencies = map[SLIP44CoinType]NativeCurrency{
+	SLIP44Bitcoin:     {Name: "Bitcoin", Symbol: "BTC", Decimals: 8},
+	SLIP44Litecoin:    {Name: "Litecoin", Symbol: "LTC", Decimals: 8},
+	SLIP44Dogecoin:    {Name: "Dogecoin", Symbol: "DOGE", Decimals: 8},
+	SLIP44Dash:        {Name: "Dash", Symbol: "DASH", Decimals: 8},
+	SLIP44Ethereum:    {Name: "Ether", Symbol: "ETH", Decimals: 18},
+	SLIP44Cosmos:      {Name: "Atom", Symbol: "ATOM", Decimals: 6},
+	SLIP44BitcoinCash: {Name: "Bitcoin Cash", Symbol: "BCH", Decimals: 8},
+	SLIP44Solana:      {Name: "Solana", Symbol: "SOL", Decimals: 9},
+	SLIP44Gnosis:      {Name: "xDAI", Symbol: "XDAI", Decimals: 18},
+	SLIP44BNB:         {Name: "BNB", Symbol: "BNB", Decimals: 18},
+	SLIP44Polygon:     {Name: "POL", Symbol: "POL", Decimals: 18},
+	SLIP44Fantom:      {Name: "Fantom", Symbol: "FTM", Decimals: 18},
+	SLIP44Avalanche:   {Name: "Avalanche", Symbol: "AVAX", Decimals: 18},
+	SLIP44Celo:        {Name: "Celo", Symbol: "CELO", Decimals: 18},
+}
+
+// AssetAmount is an amount of an asset in its smallest unit, e.g. wei, with the
+// decimals and symbol used to display it.
+type AssetAmount struct {
+	Asset    AssetID
+	Raw      *big.Int // amount in the smallest unit; nil is zero
+	Decimals uint8
+	Symbol   string // display symbol, e.g. "ETH"; may be empty
+}
+
+// NewAssetAmount creates an amount of raw smallest units of an asset, with the
+// decimals and symbol of its TokenMetadata. Native assets without registered
+// metadata use the chain's ChainMetadata or the well-known SLIP-44 currencies.
+// Returns ErrUnknownAsset if the asset has no known decimals.
+func NewAssetAmount(asset AssetID, raw *big.Int) (AssetAmount, error) {
+	if asset == nil || asset.IsZero() {
+		return AssetAmount{}, ErrEmptyValue
+	}
+	symbol, decimals, ok := lookupAssetDecimals(asset)
+	if !ok {
+		return AssetAmount{}, fmt.Errorf("%w: no decimals known for %s", ErrUnknownAsset, asset)
+	}
+	return AssetAmount{Asset: asset, Raw: raw, Decimals: decimals, Symbol: symbol}, nil
+}
+
+// NewNativeAmount creates an amount of the native asset of a chain, see NativeAssetFor.
+func NewNativeAmount(chainID ChainID, raw *big.Int) (AssetAmount, error) {
+	asset, err := NativeAssetFor(chainID)
+	if err != nil {
+		return AssetAmount{}, err
+	}
+	return NewAssetAmount(asset, raw)
+}
+
+// ParseAssetAmount parses a human-readable amount of an asset, such as "1.5" or
+// "1.5 ETH". A symbol, if given, must match the asset's (case-insensitively).
+func ParseAssetAmount(asset AssetID, s string) (AssetAmount, error) {
+	a, err := NewAssetAmount(asset, nil)
+	if err != nil {
+		return AssetAmount{}, err
+	}
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 1:
+	case 2:
+		if !strings.EqualFold(fields[1], a.Symbol) {
+			return AssetAmount{}, fmt.Errorf("%w: symbol %q does not match %s", ErrAssetMismatch, fields[1], asset)
+		}
+	default:
+		return AssetAmount{}, fmt.Errorf("%w: invalid amount %q", ErrInvalidFormat, s)
+	}
+	if a.Raw, err = parseDecimalAmount(fields[0], a.Decimals); err != nil {
+		return AssetAmount{}, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	return a, nil
+}
+
+// ParseNativeAmount parses a human-readable amount of a chain's native asset,
+// such as "1.5 ETH" on Ethereum.
+func ParseNativeAmount(chainID ChainID, s string) (AssetAmount, error) {
+	asset, err := NativeAssetFor(chainID)
+	if err != nil {
+		return AssetAmount{}, err
+	}
+	return ParseAssetAmount(asset, s)
+}
+
+// lookupAssetDecimals returns the display symbol and decimals of an asset.
+func lookupAssetDecimals(asset AssetID) (symbol string, decimals uint8, ok bool) {
+	if meta, ok := LookupTokenMetadata(asset); ok {
+		return meta.Symbol, meta.Decimals, true
+	}
+	native, ok := asset.(SLIP44AssetID)
+	if !ok {
+		return "", 0, false
+	}
+	if meta, ok := LookupChainMetadata(asset.ChainID()); ok && meta.NativeCurrency.Symbol != "" {
+		return meta.NativeCurrency.Symbol, meta.NativeCurrency.Decimals, true
+	}
+	c, ok := slip44Currencies[native.CoinType()]
+	return c.Symbol, c.Decimals, ok
+}
+
+// IsZero reports whether the amount is zero.
+func (a AssetAmount) IsZero() bool {
+	return a.Raw == nil || a.Raw.Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount.
+func (a AssetAmount) Sign() int {
+	if a.Raw == nil {
+		return 0
+	}
+	return a.Raw.Sign()
+}
+
+// Decimal returns the amount in whole units, e.g. "1.5".
+func (a AssetAmount) Decimal() string {
+	return formatDecimalAmount(a.raw(), a.Decimals)
+}
+
+// String returns the amount with its symbol, e.g. "1.5 ETH", or with the asset ID
+// if the symbol is empty.
+func (a AssetAmount) String() string {
+	unit := a.Symbol
+	if unit == "" && a.Asset != nil {
+		unit = a.Asset.String()
+	}
+	if unit == "" {
+		return a.Decimal()
+	}
+	return a.Decimal() + " " + unit
+}
+
+// Add returns a + b. Both must be amounts of the same asset with the same decimals.
+func (a AssetAmount) Add(b AssetAmount) (AssetAmount, error) {
+	if err := a.checkSameAsset(b); err != nil {
+		return AssetAmount{}, err
+	}
+	a.Raw = new(big.Int).Add(a.raw(), b.raw())
+	return a, nil
+}
+
+// Sub returns a - b. Both must be amounts of the same asset with the same decimals.
+func (a AssetAmount) Sub(b AssetAmount) (AssetAmount, error) {
+	if err := a.checkSameAsset(b); err != nil {
+		return AssetAmount{}, err
+	}
+	a.Raw = new(big.Int).Sub(a.raw(), b.raw())
+	return a, nil
+}
+
+// Cmp compares a and b and returns -1, 0 or +1. Both must be amounts of the same
+// asset with the same decimals.
+func (a AssetAmount) Cmp(b AssetAmount) (int, error) {
+	if err := a.checkSameAsset(b); err != nil {
+		return 0, err
+	}
+	return a.raw().Cmp(b.raw()), nil
+}
+
+func (a AssetAmount) checkSameAsset(b AssetAmount) error {
+	if !EqualAsset(a.Asset, b.Asset) || a.Decimals != b.Decimals {
+		return fmt.Errorf("%w: %s and %s", ErrAssetMismatch, a.Asset, b.Asset)
+	}
+	return nil
+}
+
+func (a AssetAmount) raw() *big.Int {
+	if a.Raw == nil {
+		return new(big.Int)
+	}
+	return a.Raw
+}
+
+// assetAmountJSON is the JSON form of an AssetAmount. The raw amount is a string
+// so that it survives JSON number precision limits.
+type assetAmountJSON struct {
+	Asset    string `json:"asset"`
+	Amount   string `json:"amount"`
+	Decimals uint8  `json:"decimals"`
+	Symbol   string `json:"symbol,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AssetAmount) MarshalJSON() ([]byte, error) {
+	v := assetAmountJSON{Amount: a.raw().String(), Decimals: a.Decimals, Symbol: a.Symbol}
+	if a.Asset != nil {
+		v.Asset = a.Asset.String()
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AssetAmount) UnmarshalJSON(data []byte) error {
+	var v assetAmountJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	asset, err := ParseAssetID(v.Asset)
+	if err != nil {
+		return err
+	}
+	raw, ok := new(big.Int).SetString(v.Amount, 10)
+	if !ok {
+		return fmt.Errorf("%w: invalid raw amount %q", ErrInvalidFormat, v.Amount)
+	}
+	*a = AssetAmount{Asset: asset, Raw: raw, Decimals: v.Decimals, Symbol: v.Symbol}
+	return nil
+}
+
+// parseDecimalAmount parses a decimal string, e.g. "-1.5", into smallest units.
+func parseDecimalAmount(s string, decimals uint8) (*big.Int, error) {
+	digits, neg := strings.CutPrefix(s, "-")
+	whole, frac, _ := strings.Cut(digits, ".")
+	if (whole != "" && !isDecimal(whole)) || (frac != "" && !isDecimal(frac)) || whole+frac == "" {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	if len(frac) > int(decimals) {
+		return nil, fmt.Errorf("amount %q has more than %d decimals", s, decimals)
+	}
+	n, _ := new(big.Int).SetString("0"+whole+frac+strings.Repeat("0", int(decimals)-len(frac)), 10)
+	if neg {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// formatDecimalAmount formats smallest units as a decimal string without
+// trailing zeros.
+func formatDecimalAmount(raw *big.Int, decimals uint8) string {
+	s := new(big.Int).Abs(raw).String()
+	d := int(decimals)
+	if len(s) <= d {
+		s = strings.Repeat("0", d-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-d], strings.TrimRight(s[len(s)-d:], "0")
+	if raw.Sign() < 0 {
+		whole = "-" + whole
+	}
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
@@ -0,0 +1,119 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNativeAmount(t *testing.T) {
+	a, err := ParseNativeAmount(ChainIDEthereumMainnet, "1.5 ETH")
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1/slip44:60", a.Asset.String())
+	assert.Equal(t, "1500000000000000000", a.Raw.String())
+	assert.Equal(t, uint8(18), a.Decimals)
+	assert.Equal(t, "1.5 ETH", a.String())
+	assert.Equal(t, "1.5", a.Decimal())
+
+	sol, err := ParseNativeAmount(ChainIDSolanaMainnet, "0.000000001")
+	require.NoError(t, err)
+	assert.Equal(t, "1", sol.Raw.String())
+	assert.Equal(t, "0.000000001 SOL", sol.String())
+
+	pol, err := ParseNativeAmount(ChainIDPolygon, "2 pol")
+	require.NoError(t, err)
+	assert.Equal(t, "2 POL", pol.String())
+
+	neg, err := ParseNativeAmount(ChainIDBitcoinMainnet, "-0.5")
+	require.NoError(t, err)
+	assert.Equal(t, -1, neg.Sign())
+	assert.Equal(t, "-0.5 BTC", neg.String())
+}
+
+func TestParseAssetAmountErrors(t *testing.T) {
+	eth, err := NativeAssetFor(ChainIDEthereumMainnet)
+	require.NoError(t, err)
+
+	_, err = ParseAssetAmount(eth, "1.5 SOL")
+	assert.True(t, errors.Is(err, ErrAssetMismatch), "got %v", err)
+	_, err = ParseAssetAmount(eth, "1.5 ETH extra")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ParseAssetAmount(eth, "1.0000000000000000001")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ParseAssetAmount(eth, "abc")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ParseAssetAmount(nil, "1")
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+
+	unknown := MustParseAssetID("eip155:1/erc20:0x0000000000000000000000000000000000000001")
+	_, err = NewAssetAmount(unknown, big.NewInt(1))
+	assert.True(t, errors.Is(err, ErrUnknownAsset), "got %v", err)
+}
+
+func TestAssetAmountMetadata(t *testing.T) {
+	chain := NewEIP155ChainID(8765432)
+	require.NoError(t, RegisterChainMetadata(ChainMetadata{
+		ChainID:        chain,
+		NativeCurrency: NativeCurrency{Name: "Test", Symbol: "TST", Decimals: 6},
+	}))
+	a, err := ParseNativeAmount(chain, "1.25 TST")
+	require.NoError(t, err)
+	assert.Equal(t, "1250000", a.Raw.String())
+}
+
+func TestAssetAmountArithmetic(t *testing.T) {
+	a, _ := ParseNativeAmount(ChainIDEthereumMainnet, "1.5")
+	b, _ := ParseNativeAmount(ChainIDEthereumMainnet, "0.25")
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, "1.75 ETH", sum.String())
+	assert.Equal(t, "1.5 ETH", a.String(), "operands are not modified")
+
+	diff, err := b.Sub(a)
+	require.NoError(t, err)
+	assert.Equal(t, "-1.25 ETH", diff.String())
+
+	cmp, err := a.Cmp(b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+
+	zero := AssetAmount{Asset: a.Asset, Decimals: 18, Symbol: "ETH"}
+	assert.True(t, zero.IsZero())
+	sum, err = zero.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, b.Raw, sum.Raw)
+
+	sol, _ := ParseNativeAmount(ChainIDSolanaMainnet, "1")
+	_, err = a.Add(sol)
+	assert.True(t, errors.Is(err, ErrAssetMismatch), "got %v", err)
+	_, err = a.Cmp(sol)
+	assert.True(t, errors.Is(err, ErrAssetMismatch), "got %v", err)
+}
+
+func TestAssetAmountJSON(t *testing.T) {
+	a, _ := ParseNativeAmount(ChainIDEthereumMainnet, "1.5")
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"asset":"eip155:1/slip44:60","amount":"1500000000000000000","decimals":18,"symbol":"ETH"}`, string(data))
+
+	var back AssetAmount
+	require.NoError(t, json.Unmarshal(data, &back))
+	assert.True(t, EqualAsset(a.Asset, back.Asset))
+	assert.Equal(t, a.Raw, back.Raw)
+	assert.Equal(t, a.String(), back.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"asset":"eip155:1/slip44:60","amount":"1.5","decimals":18}`), &back))
+	assert.Error(t, json.Unmarshal([]byte(`{"asset":"bad","amount":"1","decimals":18}`), &back))
+}
+
+func TestFormatDecimalAmount(t *testing.T) {
+	assert.Equal(t, "0", formatDecimalAmount(big.NewInt(0), 18))
+	assert.Equal(t, "12", formatDecimalAmount(big.NewInt(12), 0))
+	assert.Equal(t, "0.01", formatDecimalAmount(big.NewInt(1), 2))
+	assert.Equal(t, "-0.01", formatDecimalAmount(big.NewInt(-1), 2))
+}
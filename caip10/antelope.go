@@ -0,0 +1,253 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/ripemd160"
+)
+
+const NamespaceAntelope Namespace = "antelope"
+
+// AntelopeNetwork represents an Antelope (EOSIO) chain (chain reference).
+// The reference is the first 32 characters of the chain id (hex encoded).
+// https://github.com/ChainAgnostic/namespaces/blob/main/antelope/caip2.md
+type AntelopeNetwork string
+
+// Common Antelope chains (chain id prefix)
+const (
+	AntelopeEOS   AntelopeNetwork = "aca376f206b8fc25a6ed44dbdc66547c" // EOS mainnet
+	AntelopeWAX   AntelopeNetwork = "1064487b3cd1a897ce03ae5b6a865651" // WAX mainnet
+	AntelopeTelos AntelopeNetwork = "4667b205c6838ef70ff7988f6e8257e8" // Telos mainnet
+)
+
+// String returns the network reference string.
+// If the reference is longer than 32 characters, it will be truncated.
+func (n AntelopeNetwork) String() string {
+	s := string(n)
+	if len(s) > 32 {
+		return s[:32]
+	}
+	return s
+}
+
+// AntelopeKeyType is the curve of an Antelope public key.
+type AntelopeKeyType string
+
+const (
+	AntelopeKeyK1 AntelopeKeyType = "K1" // secp256k1
+	AntelopeKeyR1 AntelopeKeyType = "R1" // secp256r1
+)
+
+// AntelopePublicKeyLength is the length of a compressed public key.
+const AntelopePublicKeyLength = 33
+
+// Public key string prefixes
+const (
+	antelopeLegacyKeyPrefix = "EOS"
+	antelopeKeyPrefix       = "PUB_"
+)
+
+// antelopeNameRegex validates account names: up to 12 characters of [a-z1-5.],
+// an optional 13th character of [a-j1-5], and no trailing dot.
+var antelopeNameRegex = regexp.MustCompile(`^[a-z1-5.]{0,11}[a-z1-5]$|^[a-z1-5.]{12}[a-j1-5]$`)
+
+// ValidateAntelopeAccountName validates an Antelope account name.
+// Returns nil if valid, error otherwise.
+func ValidateAntelopeAccountName(name string) error {
+	if !antelopeNameRegex.MatchString(name) {
+		return fmt.Errorf("%w: invalid antelope account name %q", ErrInvalidAddress, name)
+	}
+	return nil
+}
+
+func antelopeChecksum(key []byte, suffix string) []byte {
+	h := ripemd160.New()
+	h.Write(key)
+	h.Write([]byte(suffix))
+	return h.Sum(nil)[:4]
+}
+
+// DecodeAntelopePublicKey decodes a legacy EOS... or PUB_K1_/PUB_R1_ public key.
+//
+// Validation steps:
+//  1. Known prefix (EOS, PUB_K1_ or PUB_R1_)
+//  2. Base58 decodes to a 33-byte compressed key and a 4-byte checksum
+//  3. RIPEMD-160 checksum matches (suffixed with the key type for PUB_ keys)
+func DecodeAntelopePublicKey(s string) (AntelopeKeyType, [AntelopePublicKeyLength]byte, error) {
+	var key [AntelopePublicKeyLength]byte
+	var keyType AntelopeKeyType
+	var encoded, suffix string
+
+	switch {
+	case strings.HasPrefix(s, antelopeLegacyKeyPrefix):
+		keyType, encoded = AntelopeKeyK1, s[len(antelopeLegacyKeyPrefix):]
+	case strings.HasPrefix(s, antelopeKeyPrefix+string(AntelopeKeyK1)+"_"):
+		keyType, encoded, suffix = AntelopeKeyK1, s[len(antelopeKeyPrefix)+3:], string(AntelopeKeyK1)
+	case strings.HasPrefix(s, antelopeKeyPrefix+string(AntelopeKeyR1)+"_"):
+		keyType, encoded, suffix = AntelopeKeyR1, s[len(antelopeKeyPrefix)+3:], string(AntelopeKeyR1)
+	default:
+		return "", key, fmt.Errorf("%w: unknown antelope public key prefix", ErrInvalidAddress)
+	}
+
+	data, err := base58.Decode(encoded)
+	if err != nil {
+		return "", key, fmt.Errorf("%w: invalid base58 encoding", ErrInvalidAddress)
+	}
+	if len(data) != AntelopePublicKeyLength+4 {
+		return "", key, fmt.Errorf("%w: antelope public key must decode to %d bytes, got %d",
+			ErrInvalidAddress, AntelopePublicKeyLength+4, len(data))
+	}
+	if data[0] != 0x02 && data[0] != 0x03 {
+		return "", key, fmt.Errorf("%w: antelope public key must be compressed", ErrInvalidAddress)
+	}
+	if !bytes.Equal(antelopeChecksum(data[:AntelopePublicKeyLength], suffix), data[AntelopePublicKeyLength:]) {
+		return "", key, fmt.Errorf("%w: antelope public key checksum mismatch", ErrInvalidChecksum)
+	}
+	copy(key[:], data)
+	return keyType, key, nil
+}
+
+// EncodeAntelopePublicKey encodes a compressed public key in PUB_<type>_ form.
+func EncodeAntelopePublicKey(keyType AntelopeKeyType, key [AntelopePublicKeyLength]byte) string {
+	data := append(key[:], antelopeChecksum(key[:], string(keyType))...)
+	return antelopeKeyPrefix + string(keyType) + "_" + base58.Encode(data)
+}
+
+// EncodeAntelopeLegacyPublicKey encodes a compressed secp256k1 public key in legacy EOS... form.
+func EncodeAntelopeLegacyPublicKey(key [AntelopePublicKeyLength]byte) string {
+	data := append(key[:], antelopeChecksum(key[:], "")...)
+	return antelopeLegacyKeyPrefix + base58.Encode(data)
+}
+
+// isAntelopePublicKey reports whether s uses a public key prefix; account
+// names cannot contain upper case letters, so the forms never overlap.
+func isAntelopePublicKey(s string) bool {
+	return strings.HasPrefix(s, antelopeLegacyKeyPrefix) || strings.HasPrefix(s, antelopeKeyPrefix)
+}
+
+// AntelopeAccountID is the interface for Antelope account IDs.
+type AntelopeAccountID interface {
+	AccountID
+	// Network returns the Antelope chain.
+	Network() AntelopeNetwork
+	// IsAccountName returns true if the address is an account name.
+	IsAccountName() bool
+	// IsPublicKey returns true if the address is a public key.
+	IsPublicKey() bool
+	// PublicKey returns the key type and compressed public key of a public-key address.
+	PublicKey() (AntelopeKeyType, [AntelopePublicKeyLength]byte, bool)
+}
+
+// Ensure antelopeAccountID implements AntelopeAccountID at compile time
+var _ AntelopeAccountID = (*antelopeAccountID)(nil)
+
+func init() {
+	RegisterParser(&antelopeParser{})
+}
+
+// antelopeAccountID represents an Antelope account ID per CAIP-10.
+type antelopeAccountID struct {
+	*GenericAccountID                 // embedded, inherits all serialization methods
+	keyType           AntelopeKeyType // empty for account names
+	pubkey            [AntelopePublicKeyLength]byte
+}
+
+// NewAntelope creates a new AntelopeAccountID from an account name or public key.
+func NewAntelope(network AntelopeNetwork, address string) (AntelopeAccountID, error) {
+	if err := validateReference(NamespaceAntelope, string(network)); err != nil {
+		return nil, err
+	}
+	a := &antelopeAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceAntelope, network.String(), address),
+	}
+	if isAntelopePublicKey(address) {
+		keyType, key, err := DecodeAntelopePublicKey(address)
+		if err != nil {
+			return nil, err
+		}
+		a.keyType, a.pubkey = keyType, key
+		return a, nil
+	}
+	if err := ValidateAntelopeAccountName(address); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// MustNewAntelope creates a new AntelopeAccountID and panics if invalid.
+func MustNewAntelope(network AntelopeNetwork, address string) AntelopeAccountID {
+	a, err := NewAntelope(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Antelope chain.
+func (a *antelopeAccountID) Network() AntelopeNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return AntelopeNetwork(a.Reference())
+}
+
+// IsAccountName returns true if the address is an account name.
+func (a *antelopeAccountID) IsAccountName() bool {
+	return !a.IsZero() && a.keyType == ""
+}
+
+// IsPublicKey returns true if the address is a public key.
+func (a *antelopeAccountID) IsPublicKey() bool {
+	return !a.IsZero() && a.keyType != ""
+}
+
+// PublicKey returns the key type and compressed public key of a public-key address.
+func (a *antelopeAccountID) PublicKey() (AntelopeKeyType, [AntelopePublicKeyLength]byte, bool) {
+	if !a.IsPublicKey() {
+		return "", [AntelopePublicKeyLength]byte{}, false
+	}
+	return a.keyType, a.pubkey, true
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *antelopeAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *antelopeAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- antelopeParser ---
+
+type antelopeParser struct{}
+
+func (p *antelopeParser) Namespace() Namespace {
+	return NamespaceAntelope
+}
+
+func (p *antelopeParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceAntelope {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceAntelope, ns)
+	}
+	return NewAntelope(AntelopeNetwork(ref), addr)
+}
+
+func (p *antelopeParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewAntelope(AntelopeNetwork(reference), address)
+}
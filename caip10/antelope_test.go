@@ -0,0 +1,96 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eosio development key
+const (
+	antelopeLegacyKey = "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+	antelopeK1Key     = "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"
+	antelopeKeyHex    = "02c0ded2bc1f1305fb0faac5e6c03ee3a1924234985427b6167ca569d13df435cf"
+)
+
+func TestAntelopeAccountName(t *testing.T) {
+	input := "antelope:aca376f206b8fc25a6ed44dbdc66547c:eosio.token"
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	eos, ok := a.(AntelopeAccountID)
+	require.True(t, ok, "expected AntelopeAccountID, got %T", a)
+	assert.Equal(t, AntelopeEOS, eos.Network())
+	assert.True(t, eos.IsAccountName())
+	assert.False(t, eos.IsPublicKey())
+	assert.Equal(t, ChainIDEOS, eos.ChainID())
+	_, _, ok = eos.PublicKey()
+	assert.False(t, ok)
+	assert.Equal(t, input, eos.String())
+}
+
+func TestAntelopePublicKey(t *testing.T) {
+	for _, addr := range []string{antelopeLegacyKey, antelopeK1Key} {
+		t.Run(addr, func(t *testing.T) {
+			a, err := NewAntelope(AntelopeWAX, addr)
+			require.NoError(t, err)
+			assert.True(t, a.IsPublicKey())
+
+			keyType, key, ok := a.PublicKey()
+			require.True(t, ok)
+			assert.Equal(t, AntelopeKeyK1, keyType)
+			assert.Equal(t, antelopeKeyHex, hex.EncodeToString(key[:]))
+		})
+	}
+
+	_, key, err := DecodeAntelopePublicKey(antelopeLegacyKey)
+	require.NoError(t, err)
+	assert.Equal(t, antelopeK1Key, EncodeAntelopePublicKey(AntelopeKeyK1, key))
+	assert.Equal(t, antelopeLegacyKey, EncodeAntelopeLegacyPublicKey(key))
+}
+
+func TestValidateAntelopeAccountName(t *testing.T) {
+	valid := []string{"a", "eosio", "eosio.token", "alice1234512", "zzzzzzzzzzzzj", "1.2.3"}
+	for _, name := range valid {
+		assert.NoError(t, ValidateAntelopeAccountName(name), name)
+	}
+
+	invalid := []string{"", "Alice", "alice6", "alice.", "alice1234512z", "aaaaaaaaaaaaaa", "hello-world"}
+	for _, name := range invalid {
+		err := ValidateAntelopeAccountName(name)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), "%q: got %v", name, err)
+	}
+}
+
+func TestAntelopeParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "antelope:eos:eosio", ErrInvalidReference},
+		{"bad name", "antelope:aca376f206b8fc25a6ed44dbdc66547c:Eosio", ErrInvalidAddress},
+		{"legacy checksum", "antelope:aca376f206b8fc25a6ed44dbdc66547c:EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CW", ErrInvalidAddress},
+		{"k1 key with legacy checksum", "antelope:aca376f206b8fc25a6ed44dbdc66547c:PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", ErrInvalidAddress},
+		{"unknown key type", "antelope:aca376f206b8fc25a6ed44dbdc66547c:PUB_WA_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BLiEu", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestAntelopeNilReceiver(t *testing.T) {
+	var a *antelopeAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, AntelopeNetwork(""), a.Network())
+	assert.False(t, a.IsAccountName())
+	assert.False(t, a.IsPublicKey())
+}
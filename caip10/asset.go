@@ -0,0 +1,426 @@
+package caip10
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// AssetNamespace is a CAIP-19 asset namespace (e.g. erc20, slip44).
+// See: https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-19.md
+type AssetNamespace string
+
+// AssetID is the base interface for CAIP-19 asset identifiers.
+// Format: namespace:reference/asset_namespace:asset_reference[/token_id]
+type AssetID interface {
+	// Core accessors
+
+	ChainID() ChainID
+	AssetNamespace() AssetNamespace
+	AssetReference() string
+	AssetTokenID() string // empty when the asset has no token id
+
+	// State
+
+	IsZero() bool
+	Equal(other AssetID) bool
+	Validate() error
+
+	// fmt.Stringer
+
+	String() string
+
+	// Serialization interfaces
+
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	json.Marshaler
+	json.Unmarshaler
+
+	// Database interfaces
+
+	driver.Valuer
+	Scan(src any) error
+
+	// CBOR serialization
+
+	MarshalCBOR() ([]byte, error)
+	UnmarshalCBOR(data []byte) error
+}
+
+// AssetParser is the interface for asset-namespace-specific parsers.
+// Asset namespaces are scoped by chain namespace (e.g. "nft" under solana),
+// so a parser declares the chain namespace it applies to, or "" for any chain.
+type AssetParser interface {
+	Namespace() Namespace
+	AssetNamespace() AssetNamespace
+	ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error)
+}
+
+// assetParserKey identifies a parser by chain namespace and asset namespace.
+type assetParserKey struct {
+	namespace      Namespace
+	assetNamespace AssetNamespace
+}
+
+// assetRegistry holds asset-namespace-specific parsers
+var assetRegistry = make(map[assetParserKey]AssetParser)
+
+// RegisterAssetParser registers a parser for an asset namespace.
+func RegisterAssetParser(p AssetParser) {
+	assetRegistry[assetParserKey{p.Namespace(), p.AssetNamespace()}] = p
+}
+
+// GetAssetParser returns the parser for an asset namespace on a chain namespace.
+// Parsers registered for the specific chain namespace take precedence over chain-agnostic ones.
+func GetAssetParser(namespace Namespace, assetNamespace AssetNamespace) (AssetParser, bool) {
+	if p, ok := assetRegistry[assetParserKey{namespace, assetNamespace}]; ok {
+		return p, true
+	}
+	p, ok := assetRegistry[assetParserKey{"", assetNamespace}]
+	return p, ok
+}
+
+// ParseAssetID parses a CAIP-19 string into an AssetID.
+// It automatically selects the appropriate parser based on asset namespace.
+func ParseAssetID(s string) (AssetID, error) {
+	chain, ans, ref, tokenID, err := SplitCAIP19(s)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := parseAssetChainID(chain)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssetWithChainID(chainID, ans, ref, tokenID)
+}
+
+// MustParseAssetID parses a CAIP-19 string and panics if invalid.
+func MustParseAssetID(s string) AssetID {
+	a, err := ParseAssetID(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseAssetWithChainID parses using a specific asset namespace parser.
+func ParseAssetWithChainID(chainID ChainID, assetNamespace AssetNamespace, reference, tokenID string) (AssetID, error) {
+	if p, ok := GetAssetParser(chainID.Namespace, assetNamespace); ok {
+		return p.ParseAsset(chainID, reference, tokenID)
+	}
+	return NewGenericAsset(chainID, assetNamespace, reference, tokenID)
+}
+
+// EqualAsset compares two AssetIDs for equality.
+func EqualAsset(a, b AssetID) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Equal(b)
+}
+
+// parseAssetChainID parses the CAIP-2 part of an asset ID.
+// Unlike ParseChainID, unknown namespaces are accepted if they match the CAIP-2 syntax.
+func parseAssetChainID(s string) (ChainID, error) {
+	ns, ref, err := SplitCAIP2(s)
+	if err != nil {
+		return ChainID{}, err
+	}
+	c := ChainID{Namespace: Namespace(ns), Reference: ref}
+	if err := validateAssetChainID(c); err != nil {
+		return ChainID{}, err
+	}
+	return c, nil
+}
+
+// validateAssetChainID validates the chain of an asset, falling back to CAIP-2 syntax rules
+// for namespaces without a dedicated reference validator.
+func validateAssetChainID(c ChainID) error {
+	if !NamespaceRegex.MatchString(string(c.Namespace)) {
+		return fmt.Errorf("%w: must match [-a-z0-9]{3,8}, got %q", ErrInvalidNamespace, c.Namespace)
+	}
+	// validateReference reports ErrInvalidNamespace only for namespaces it does not know
+	if err := validateReference(c.Namespace, c.Reference); err == nil || !errors.Is(err, ErrInvalidNamespace) {
+		return err
+	}
+	if !ReferenceRegex.MatchString(c.Reference) {
+		return fmt.Errorf("%w: must match [-_a-zA-Z0-9]{1,32}, got %q", ErrInvalidReference, c.Reference)
+	}
+	return nil
+}
+
+// Ensure GenericAssetID implements AssetID at compile time
+var _ AssetID = (*GenericAssetID)(nil)
+
+// GenericAssetID is the base implementation of AssetID.
+// It can be embedded by asset-namespace-specific implementations to inherit serialization methods.
+type GenericAssetID struct {
+	chainID        ChainID
+	assetNamespace AssetNamespace
+	assetReference string
+	tokenID        string
+}
+
+// NewGenericAsset creates a new GenericAssetID with validation.
+func NewGenericAsset(chainID ChainID, assetNamespace AssetNamespace, reference, tokenID string) (*GenericAssetID, error) {
+	a := &GenericAssetID{
+		chainID:        chainID,
+		assetNamespace: assetNamespace,
+		assetReference: reference,
+		tokenID:        tokenID,
+	}
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// MustNewGenericAsset creates a new GenericAssetID and panics if invalid.
+func MustNewGenericAsset(chainID ChainID, assetNamespace AssetNamespace, reference, tokenID string) *GenericAssetID {
+	a, err := NewGenericAsset(chainID, assetNamespace, reference, tokenID)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// newGenericAssetUnchecked creates without validation (for internal use by embedders).
+func newGenericAssetUnchecked(chainID ChainID, assetNamespace AssetNamespace, reference, tokenID string) *GenericAssetID {
+	return &GenericAssetID{
+		chainID:        chainID,
+		assetNamespace: assetNamespace,
+		assetReference: reference,
+		tokenID:        tokenID,
+	}
+}
+
+// ChainID returns the CAIP-2 chain ID of the asset.
+func (a *GenericAssetID) ChainID() ChainID {
+	if a == nil {
+		return ChainID{}
+	}
+	return a.chainID
+}
+
+// AssetNamespace returns the asset namespace.
+func (a *GenericAssetID) AssetNamespace() AssetNamespace {
+	if a == nil {
+		return ""
+	}
+	return a.assetNamespace
+}
+
+// AssetReference returns the asset reference.
+func (a *GenericAssetID) AssetReference() string {
+	if a == nil {
+		return ""
+	}
+	return a.assetReference
+}
+
+// AssetTokenID returns the raw token id, or empty if the asset has none.
+func (a *GenericAssetID) AssetTokenID() string {
+	if a == nil {
+		return ""
+	}
+	return a.tokenID
+}
+
+// String returns the full CAIP-19 string representation.
+func (a *GenericAssetID) String() string {
+	if a.IsZero() {
+		return ""
+	}
+	s := a.chainID.String() + "/" + string(a.assetNamespace) + ":" + a.assetReference
+	if a.tokenID != "" {
+		s += "/" + a.tokenID
+	}
+	return s
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *GenericAssetID) IsZero() bool {
+	return a == nil || (a.chainID.IsZero() && a.assetNamespace == "" && a.assetReference == "" && a.tokenID == "")
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *GenericAssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.chainID.Equal(other.ChainID()) &&
+		a.assetNamespace == other.AssetNamespace() &&
+		a.assetReference == other.AssetReference() &&
+		a.tokenID == other.AssetTokenID()
+}
+
+// Validate checks if the AssetID is valid per CAIP-19 spec.
+// Asset namespaces with a registered parser are validated by that parser.
+func (a *GenericAssetID) Validate() error {
+	if a == nil {
+		return ErrEmptyValue
+	}
+	if err := validateAssetChainID(a.chainID); err != nil {
+		return err
+	}
+	if !AssetNamespaceRegex.MatchString(string(a.assetNamespace)) {
+		return fmt.Errorf("%w: must match [-a-z0-9]{3,8}, got %q", ErrInvalidAssetNamespace, a.assetNamespace)
+	}
+	if !AssetReferenceRegex.MatchString(a.assetReference) {
+		return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,128}, got %q", ErrInvalidAssetReference, a.assetReference)
+	}
+	if a.tokenID != "" && !TokenIDRegex.MatchString(a.tokenID) {
+		return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,78}, got %q", ErrInvalidTokenID, a.tokenID)
+	}
+	if p, ok := GetAssetParser(a.chainID.Namespace, a.assetNamespace); ok {
+		if _, err := p.ParseAsset(a.chainID, a.assetReference, a.tokenID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToNative converts GenericAssetID to its asset-namespace-specific type.
+// Returns e.g. ERC20AssetID for erc20, or *GenericAssetID for unregistered asset namespaces.
+func (a *GenericAssetID) ToNative() any {
+	if a == nil {
+		return nil
+	}
+	if p, ok := GetAssetParser(a.chainID.Namespace, a.assetNamespace); ok {
+		native, err := p.ParseAsset(a.chainID, a.assetReference, a.tokenID)
+		if err == nil {
+			return native
+		}
+	}
+	return a
+}
+
+// --- encoding.TextMarshaler / encoding.TextUnmarshaler ---
+
+func (a *GenericAssetID) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *GenericAssetID) UnmarshalText(text []byte) error {
+	chain, ans, ref, tokenID, err := SplitCAIP19(string(text))
+	if err != nil {
+		return err
+	}
+	chainID, err := parseAssetChainID(chain)
+	if err != nil {
+		return err
+	}
+	parsed, err := NewGenericAsset(chainID, ans, ref, tokenID)
+	if err != nil {
+		return err
+	}
+	*a = *parsed
+	return nil
+}
+
+// --- encoding.BinaryMarshaler / encoding.BinaryUnmarshaler ---
+
+func (a *GenericAssetID) MarshalBinary() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *GenericAssetID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*a = GenericAssetID{}
+		return nil
+	}
+	return a.UnmarshalText(data)
+}
+
+// --- json.Marshaler / json.Unmarshaler ---
+
+func (a *GenericAssetID) MarshalJSON() ([]byte, error) {
+	if a.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(a.String())
+}
+
+func (a *GenericAssetID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = GenericAssetID{}
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("%w: expected JSON string", ErrInvalidFormat)
+	}
+
+	s := string(data[1 : len(data)-1])
+	if s == "" {
+		*a = GenericAssetID{}
+		return nil
+	}
+
+	return a.UnmarshalText([]byte(s))
+}
+
+// --- database/sql interfaces ---
+
+func (a *GenericAssetID) Value() (driver.Value, error) {
+	if a.IsZero() {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+func (a *GenericAssetID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*a = GenericAssetID{}
+		return nil
+	case string:
+		if v == "" {
+			*a = GenericAssetID{}
+			return nil
+		}
+		return a.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == 0 {
+			*a = GenericAssetID{}
+			return nil
+		}
+		return a.UnmarshalText(v)
+	default:
+		return fmt.Errorf("caip10: cannot scan type %T into GenericAssetID", src)
+	}
+}
+
+// --- CBOR ---
+
+func (a *GenericAssetID) MarshalCBOR() ([]byte, error) {
+	if a.IsZero() {
+		return cbor.Marshal("")
+	}
+	return cbor.Marshal(a.String())
+}
+
+func (a *GenericAssetID) UnmarshalCBOR(data []byte) error {
+	var s string
+	if err := cbor.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*a = GenericAssetID{}
+		return nil
+	}
+	return a.UnmarshalText([]byte(s))
+}
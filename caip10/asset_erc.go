@@ -0,0 +1,442 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// EIP-155 asset namespaces.
+// https://github.com/ChainAgnostic/namespaces/blob/main/eip155/caip19.md
+const (
+	AssetNamespaceERC20   AssetNamespace = "erc20"
+	AssetNamespaceERC721  AssetNamespace = "erc721"
+	AssetNamespaceERC1155 AssetNamespace = "erc1155"
+)
+
+// ERC20AssetID is the interface for ERC-20 fungible token asset IDs.
+type ERC20AssetID interface {
+	AssetID
+	// Contract returns the token contract address.
+	Contract() ecommon.Address
+	// EIP155ChainID returns the chain ID as *big.Int.
+	EIP155ChainID() *big.Int
+	// ContractAccountID returns the token contract as an EIP155AccountID.
+	ContractAccountID() EIP155AccountID
+	// SetChainID returns a new ERC20AssetID with the specified chain ID.
+	SetChainID(chainID *big.Int) ERC20AssetID
+}
+
+// ERC721AssetID is the interface for ERC-721 non-fungible token asset IDs.
+// An ERC721AssetID without a token ID identifies the whole collection.
+type ERC721AssetID interface {
+	AssetID
+	// Contract returns the token contract address.
+	Contract() ecommon.Address
+	// EIP155ChainID returns the chain ID as *big.Int.
+	EIP155ChainID() *big.Int
+	// ContractAccountID returns the token contract as an EIP155AccountID.
+	ContractAccountID() EIP155AccountID
+	// TokenID returns the token ID, or nil for a collection-level asset.
+	TokenID() *big.Int
+	// SetTokenID returns a new ERC721AssetID with the specified token ID.
+	SetTokenID(tokenID *big.Int) ERC721AssetID
+}
+
+// ERC1155AssetID is the interface for ERC-1155 multi-token asset IDs.
+// An ERC1155AssetID without a token ID identifies the whole contract.
+type ERC1155AssetID interface {
+	AssetID
+	// Contract returns the token contract address.
+	Contract() ecommon.Address
+	// EIP155ChainID returns the chain ID as *big.Int.
+	EIP155ChainID() *big.Int
+	// ContractAccountID returns the token contract as an EIP155AccountID.
+	ContractAccountID() EIP155AccountID
+	// TokenID returns the token ID, or nil for a contract-level asset.
+	TokenID() *big.Int
+	// SetTokenID returns a new ERC1155AssetID with the specified token ID.
+	SetTokenID(tokenID *big.Int) ERC1155AssetID
+}
+
+// Ensure ERC asset types implement their interfaces at compile time
+var (
+	_ ERC20AssetID   = (*erc20AssetID)(nil)
+	_ ERC721AssetID  = (*erc721AssetID)(nil)
+	_ ERC1155AssetID = (*erc1155AssetID)(nil)
+)
+
+func init() {
+	RegisterAssetParser(&erc20Parser{})
+	RegisterAssetParser(&erc721Parser{})
+	RegisterAssetParser(&erc1155Parser{})
+}
+
+// maxUint256 is the largest valid ERC-721/ERC-1155 token ID.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// eip155Asset holds the native fields shared by all EIP-155 asset types.
+type eip155Asset struct {
+	contract ecommon.Address // token contract address
+	evmChain *big.Int        // EIP-155 chain ID
+}
+
+// contractAccountID returns the token contract as an EIP155AccountID.
+func (a eip155Asset) contractAccountID() EIP155AccountID {
+	if a.evmChain == nil {
+		return nil
+	}
+	return NewEIP155(a.evmChain, a.contract)
+}
+
+// eip155ChainID returns a copy of the chain ID.
+func (a eip155Asset) eip155ChainID() *big.Int {
+	if a.evmChain == nil {
+		return nil
+	}
+	return new(big.Int).Set(a.evmChain)
+}
+
+// newEIP155Asset builds the shared asset fields, capping the chain ID like NewEIP155 does.
+func newEIP155Asset[C eip155ChainID](chainID C, contract ecommon.Address) (eip155Asset, ChainID) {
+	_chainID := bigIntOrIntToBigInt(chainID)
+	if _chainID.Cmp(maxEIP155ChainID) > 0 {
+		_chainID = new(big.Int).Set(maxEIP155ChainID)
+	}
+	base := eip155Asset{contract: contract, evmChain: new(big.Int).Set(_chainID)}
+	return base, ChainID{Namespace: NamespaceEIP155, Reference: _chainID.String()}
+}
+
+// parseEIP155AssetReference validates the chain and contract address of an EIP-155 asset.
+func parseEIP155AssetReference(ans AssetNamespace, chainID ChainID, reference string) (*big.Int, ecommon.Address, error) {
+	if chainID.Namespace != NamespaceEIP155 {
+		return nil, ecommon.Address{}, fmt.Errorf("%w: asset namespace %q requires an eip155 chain, got %q", ErrInvalidNamespace, ans, chainID.Namespace)
+	}
+	if err := validateReference(NamespaceEIP155, chainID.Reference); err != nil {
+		return nil, ecommon.Address{}, err
+	}
+	chain, _ := new(big.Int).SetString(chainID.Reference, 10)
+	if !ecommon.IsHexAddress(reference) || len(reference) != 2+2*ecommon.AddressLength {
+		return nil, ecommon.Address{}, fmt.Errorf("%w: invalid %s contract address %q", ErrInvalidAssetReference, ans, reference)
+	}
+	return chain, ecommon.HexToAddress(reference), nil
+}
+
+// parseTokenID parses an optional decimal uint256 token ID.
+func parseTokenID(ans AssetNamespace, tokenID string) (*big.Int, error) {
+	if tokenID == "" {
+		return nil, nil
+	}
+	for i := 0; i < len(tokenID); i++ {
+		if tokenID[i] < '0' || tokenID[i] > '9' {
+			return nil, fmt.Errorf("%w: %s token id must be decimal, got %q", ErrInvalidTokenID, ans, tokenID)
+		}
+	}
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("%w: %s token id exceeds uint256, got %q", ErrInvalidTokenID, ans, tokenID)
+	}
+	return id, nil
+}
+
+// tokenIDString formats an optional token ID.
+func tokenIDString(tokenID *big.Int) string {
+	if tokenID == nil {
+		return ""
+	}
+	return tokenID.String()
+}
+
+// --- ERC-20 ---
+
+// erc20AssetID represents an ERC-20 token asset ID per CAIP-19.
+type erc20AssetID struct {
+	*GenericAssetID // embedded, inherits all serialization methods
+	eip155Asset
+}
+
+// NewERC20Asset creates a new ERC20AssetID.
+// If chainID exceeds maxEIP155ChainID (10^32 - 1), it will be capped to that value.
+func NewERC20Asset[C eip155ChainID](chainID C, contract ecommon.Address) ERC20AssetID {
+	base, chain := newEIP155Asset(chainID, contract)
+	return &erc20AssetID{
+		GenericAssetID: newGenericAssetUnchecked(chain, AssetNamespaceERC20, contract.Hex(), ""),
+		eip155Asset:    base,
+	}
+}
+
+// SetChainID returns a new ERC20AssetID with the specified chain ID.
+func (a *erc20AssetID) SetChainID(chainID *big.Int) ERC20AssetID {
+	if a == nil {
+		return nil
+	}
+	return NewERC20Asset(chainID, a.contract)
+}
+
+// Contract returns the token contract address.
+func (a *erc20AssetID) Contract() ecommon.Address {
+	if a == nil {
+		return ecommon.Address{}
+	}
+	return a.contract
+}
+
+// EIP155ChainID returns the chain ID as *big.Int.
+func (a *erc20AssetID) EIP155ChainID() *big.Int {
+	if a == nil {
+		return nil
+	}
+	return a.eip155ChainID()
+}
+
+// ContractAccountID returns the token contract as an EIP155AccountID.
+func (a *erc20AssetID) ContractAccountID() EIP155AccountID {
+	if a == nil {
+		return nil
+	}
+	return a.contractAccountID()
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *erc20AssetID) IsZero() bool {
+	return a == nil || a.GenericAssetID == nil || a.GenericAssetID.IsZero()
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *erc20AssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAssetID.Equal(other)
+}
+
+// --- ERC-721 ---
+
+// erc721AssetID represents an ERC-721 token asset ID per CAIP-19.
+type erc721AssetID struct {
+	*GenericAssetID // embedded, inherits all serialization methods
+	eip155Asset
+	tokenID *big.Int // nil for collection-level assets
+}
+
+// NewERC721Asset creates a new ERC721AssetID. A nil tokenID identifies the collection.
+// If chainID exceeds maxEIP155ChainID (10^32 - 1), it will be capped to that value.
+func NewERC721Asset[C eip155ChainID](chainID C, contract ecommon.Address, tokenID *big.Int) ERC721AssetID {
+	base, chain := newEIP155Asset(chainID, contract)
+	var id *big.Int
+	if tokenID != nil {
+		id = new(big.Int).Set(tokenID)
+	}
+	return &erc721AssetID{
+		GenericAssetID: newGenericAssetUnchecked(chain, AssetNamespaceERC721, contract.Hex(), tokenIDString(id)),
+		eip155Asset:    base,
+		tokenID:        id,
+	}
+}
+
+// TokenID returns the token ID, or nil for a collection-level asset.
+func (a *erc721AssetID) TokenID() *big.Int {
+	if a == nil || a.tokenID == nil {
+		return nil
+	}
+	return new(big.Int).Set(a.tokenID)
+}
+
+// SetTokenID returns a new ERC721AssetID with the specified token ID.
+func (a *erc721AssetID) SetTokenID(tokenID *big.Int) ERC721AssetID {
+	if a == nil {
+		return nil
+	}
+	return NewERC721Asset(a.evmChain, a.contract, tokenID)
+}
+
+// Contract returns the token contract address.
+func (a *erc721AssetID) Contract() ecommon.Address {
+	if a == nil {
+		return ecommon.Address{}
+	}
+	return a.contract
+}
+
+// EIP155ChainID returns the chain ID as *big.Int.
+func (a *erc721AssetID) EIP155ChainID() *big.Int {
+	if a == nil {
+		return nil
+	}
+	return a.eip155ChainID()
+}
+
+// ContractAccountID returns the token contract as an EIP155AccountID.
+func (a *erc721AssetID) ContractAccountID() EIP155AccountID {
+	if a == nil {
+		return nil
+	}
+	return a.contractAccountID()
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *erc721AssetID) IsZero() bool {
+	return a == nil || a.GenericAssetID == nil || a.GenericAssetID.IsZero()
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *erc721AssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAssetID.Equal(other)
+}
+
+// --- ERC-1155 ---
+
+// erc1155AssetID represents an ERC-1155 token asset ID per CAIP-19.
+type erc1155AssetID struct {
+	*GenericAssetID // embedded, inherits all serialization methods
+	eip155Asset
+	tokenID *big.Int // nil for contract-level assets
+}
+
+// NewERC1155Asset creates a new ERC1155AssetID. A nil tokenID identifies the whole contract.
+// If chainID exceeds maxEIP155ChainID (10^32 - 1), it will be capped to that value.
+func NewERC1155Asset[C eip155ChainID](chainID C, contract ecommon.Address, tokenID *big.Int) ERC1155AssetID {
+	base, chain := newEIP155Asset(chainID, contract)
+	var id *big.Int
+	if tokenID != nil {
+		id = new(big.Int).Set(tokenID)
+	}
+	return &erc1155AssetID{
+		GenericAssetID: newGenericAssetUnchecked(chain, AssetNamespaceERC1155, contract.Hex(), tokenIDString(id)),
+		eip155Asset:    base,
+		tokenID:        id,
+	}
+}
+
+// TokenID returns the token ID, or nil for a contract-level asset.
+func (a *erc1155AssetID) TokenID() *big.Int {
+	if a == nil || a.tokenID == nil {
+		return nil
+	}
+	return new(big.Int).Set(a.tokenID)
+}
+
+// SetTokenID returns a new ERC1155AssetID with the specified token ID.
+func (a *erc1155AssetID) SetTokenID(tokenID *big.Int) ERC1155AssetID {
+	if a == nil {
+		return nil
+	}
+	return NewERC1155Asset(a.evmChain, a.contract, tokenID)
+}
+
+// Contract returns the token contract address.
+func (a *erc1155AssetID) Contract() ecommon.Address {
+	if a == nil {
+		return ecommon.Address{}
+	}
+	return a.contract
+}
+
+// EIP155ChainID returns the chain ID as *big.Int.
+func (a *erc1155AssetID) EIP155ChainID() *big.Int {
+	if a == nil {
+		return nil
+	}
+	return a.eip155ChainID()
+}
+
+// ContractAccountID returns the token contract as an EIP155AccountID.
+func (a *erc1155AssetID) ContractAccountID() EIP155AccountID {
+	if a == nil {
+		return nil
+	}
+	return a.contractAccountID()
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *erc1155AssetID) IsZero() bool {
+	return a == nil || a.GenericAssetID == nil || a.GenericAssetID.IsZero()
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *erc1155AssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAssetID.Equal(other)
+}
+
+// --- parsers ---
+
+type erc20Parser struct{}
+
+func (p *erc20Parser) Namespace() Namespace {
+	return NamespaceEIP155
+}
+
+func (p *erc20Parser) AssetNamespace() AssetNamespace {
+	return AssetNamespaceERC20
+}
+
+func (p *erc20Parser) ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error) {
+	chain, contract, err := parseEIP155AssetReference(AssetNamespaceERC20, chainID, reference)
+	if err != nil {
+		return nil, err
+	}
+	if tokenID != "" {
+		return nil, fmt.Errorf("%w: erc20 assets have no token id, got %q", ErrInvalidTokenID, tokenID)
+	}
+	return NewERC20Asset(chain, contract), nil
+}
+
+type erc721Parser struct{}
+
+func (p *erc721Parser) Namespace() Namespace {
+	return NamespaceEIP155
+}
+
+func (p *erc721Parser) AssetNamespace() AssetNamespace {
+	return AssetNamespaceERC721
+}
+
+func (p *erc721Parser) ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error) {
+	chain, contract, err := parseEIP155AssetReference(AssetNamespaceERC721, chainID, reference)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseTokenID(AssetNamespaceERC721, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return NewERC721Asset(chain, contract, id), nil
+}
+
+type erc1155Parser struct{}
+
+func (p *erc1155Parser) Namespace() Namespace {
+	return NamespaceEIP155
+}
+
+func (p *erc1155Parser) AssetNamespace() AssetNamespace {
+	return AssetNamespaceERC1155
+}
+
+func (p *erc1155Parser) ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error) {
+	chain, contract, err := parseEIP155AssetReference(AssetNamespaceERC1155, chainID, reference)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseTokenID(AssetNamespaceERC1155, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return NewERC1155Asset(chain, contract, id), nil
+}
@@ -0,0 +1,147 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	daiContract    = "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	kittiesAddress = "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+)
+
+func TestERC20AssetParse(t *testing.T) {
+	a, err := ParseAssetID("eip155:1/erc20:0x6b175474e89094c44da98b954eedeac495271d0f")
+	require.NoError(t, err)
+
+	erc20, ok := a.(ERC20AssetID)
+	require.True(t, ok, "expected ERC20AssetID, got %T", a)
+	assert.Equal(t, ChainIDEthereumMainnet, erc20.ChainID())
+	assert.Equal(t, AssetNamespaceERC20, erc20.AssetNamespace())
+	assert.Equal(t, ecommon.HexToAddress(daiContract), erc20.Contract())
+	assert.Equal(t, int64(1), erc20.EIP155ChainID().Int64())
+	// Reference is normalized to the EIP-55 checksum form
+	assert.Equal(t, "eip155:1/erc20:"+daiContract, erc20.String())
+	assert.Equal(t, "eip155:1:"+daiContract, erc20.ContractAccountID().String())
+
+	polygon := erc20.SetChainID(big.NewInt(137))
+	assert.Equal(t, "eip155:137/erc20:"+daiContract, polygon.String())
+}
+
+func TestERC721AssetParse(t *testing.T) {
+	a, err := ParseAssetID("eip155:1/erc721:" + kittiesAddress + "/771769")
+	require.NoError(t, err)
+
+	nft, ok := a.(ERC721AssetID)
+	require.True(t, ok, "expected ERC721AssetID, got %T", a)
+	assert.Equal(t, ecommon.HexToAddress(kittiesAddress), nft.Contract())
+	assert.Equal(t, big.NewInt(771769), nft.TokenID())
+	assert.Equal(t, "771769", nft.AssetTokenID())
+	assert.Equal(t, "eip155:1/erc721:"+kittiesAddress+"/771769", nft.String())
+
+	collection := nft.SetTokenID(nil)
+	assert.Nil(t, collection.TokenID())
+	assert.Equal(t, "eip155:1/erc721:"+kittiesAddress, collection.String())
+}
+
+func TestERC1155AssetParse(t *testing.T) {
+	a, err := ParseAssetID("eip155:137/erc1155:0x2953399124F0cBB46d2CbACD8A89cF0599974963/1")
+	require.NoError(t, err)
+
+	mt, ok := a.(ERC1155AssetID)
+	require.True(t, ok, "expected ERC1155AssetID, got %T", a)
+	assert.Equal(t, int64(137), mt.EIP155ChainID().Int64())
+	assert.Equal(t, big.NewInt(1), mt.TokenID())
+
+	maxID := new(big.Int).Set(maxUint256)
+	b := NewERC1155Asset(137, mt.Contract(), maxID)
+	parsed, err := ParseAssetID(b.String())
+	require.NoError(t, err)
+	assert.True(t, b.Equal(parsed))
+}
+
+func TestERCAssetParseInvalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		errType error
+	}{
+		{"eip155:1/erc20:0x1234", ErrInvalidAssetReference},
+		{"eip155:1/erc20:" + daiContract + "/1", ErrInvalidTokenID},
+		{"eip155:1/erc721:" + kittiesAddress + "/abc", ErrInvalidTokenID},
+		{"eip155:1/erc721:" + kittiesAddress + "/-1", ErrInvalidTokenID},
+		{"eip155:1/erc1155:" + kittiesAddress + "/" + new(big.Int).Add(maxUint256, big.NewInt(1)).String(), ErrInvalidTokenID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseAssetID(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+
+			// Generic validation must apply the same rules
+			chain, ns, ref, tokenID := mustSplitAsset(t, tt.input)
+			_, err = NewGenericAsset(chain, ns, ref, tokenID)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestERCAssetOtherChainNamespace(t *testing.T) {
+	// erc20 is only defined under eip155; other chains fall back to generic rules
+	a, err := ParseAssetID("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/erc20:" + daiContract)
+	require.NoError(t, err)
+	_, ok := a.(*GenericAssetID)
+	assert.True(t, ok, "expected *GenericAssetID, got %T", a)
+
+	// Calling the parser directly still enforces the chain namespace
+	p, ok := GetAssetParser(NamespaceEIP155, AssetNamespaceERC20)
+	require.True(t, ok)
+	_, err = p.ParseAsset(ChainIDSolanaMainnet, daiContract, "")
+	assert.True(t, errors.Is(err, ErrInvalidNamespace))
+}
+
+func TestERCAssetJSONRoundTrip(t *testing.T) {
+	a := NewERC721Asset(1, ecommon.HexToAddress(kittiesAddress), big.NewInt(771769))
+
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+
+	var b GenericAssetID
+	require.NoError(t, json.Unmarshal(data, &b))
+	assert.True(t, a.Equal(&b))
+	assert.True(t, b.Equal(a))
+}
+
+func TestERCAssetNilReceiver(t *testing.T) {
+	var erc20 *erc20AssetID
+	assert.True(t, erc20.IsZero())
+	assert.Equal(t, ecommon.Address{}, erc20.Contract())
+	assert.Nil(t, erc20.EIP155ChainID())
+	assert.Nil(t, erc20.ContractAccountID())
+	assert.Nil(t, erc20.SetChainID(big.NewInt(1)))
+
+	var erc721 *erc721AssetID
+	assert.True(t, erc721.IsZero())
+	assert.Nil(t, erc721.TokenID())
+	assert.Nil(t, erc721.SetTokenID(big.NewInt(1)))
+
+	var erc1155 *erc1155AssetID
+	assert.True(t, erc1155.IsZero())
+	assert.Nil(t, erc1155.TokenID())
+	assert.Nil(t, erc1155.SetTokenID(big.NewInt(1)))
+}
+
+func mustSplitAsset(t *testing.T, s string) (ChainID, AssetNamespace, string, string) {
+	t.Helper()
+	chain, ans, ref, tokenID, err := SplitCAIP19(s)
+	require.NoError(t, err)
+	ns, cref, err := SplitCAIP2(chain)
+	require.NoError(t, err)
+	return ChainID{Namespace: Namespace(ns), Reference: cref}, ans, ref, tokenID
+}
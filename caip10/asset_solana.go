@@ -0,0 +1,164 @@
+package caip10
+
+import (
+	"fmt"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// Solana asset namespaces.
+// https://github.com/ChainAgnostic/namespaces/blob/main/solana/caip19.md
+const (
+	AssetNamespaceSolanaToken AssetNamespace = "token" // SPL fungible token mint
+	AssetNamespaceSolanaNFT   AssetNamespace = "nft"   // SPL non-fungible token mint
+)
+
+// SolanaTokenAssetID is the interface for SPL token asset IDs.
+type SolanaTokenAssetID interface {
+	AssetID
+	// Mint returns the token mint public key.
+	Mint() web3.PublicKey
+	// Network returns the Solana network of the asset.
+	Network() SolanaNetwork
+	// MintAccountID returns the token mint as a SolanaAccountID.
+	MintAccountID() SolanaAccountID
+	// IsNFT returns true if the asset uses the nft asset namespace.
+	IsNFT() bool
+	// IsOnCurve returns true if the mint is on the ed25519 curve.
+	// Mints created from keypairs are on curve, PDA mints are off curve.
+	IsOnCurve() bool
+}
+
+// Ensure solanaTokenAssetID implements SolanaTokenAssetID at compile time
+var _ SolanaTokenAssetID = (*solanaTokenAssetID)(nil)
+
+func init() {
+	RegisterAssetParser(&solanaTokenParser{ns: AssetNamespaceSolanaToken})
+	RegisterAssetParser(&solanaTokenParser{ns: AssetNamespaceSolanaNFT})
+}
+
+// solanaTokenAssetID represents an SPL token asset ID per CAIP-19.
+type solanaTokenAssetID struct {
+	*GenericAssetID                // embedded, inherits all serialization methods
+	mint            web3.PublicKey // native mint public key
+	network         SolanaNetwork
+}
+
+// newSolanaTokenAsset creates a token or nft asset for the given mint.
+func newSolanaTokenAsset(ns AssetNamespace, network SolanaNetwork, mint web3.PublicKey) SolanaTokenAssetID {
+	return &solanaTokenAssetID{
+		GenericAssetID: newGenericAssetUnchecked(NewSolanaChainID(network), ns, mint.String(), ""),
+		mint:           mint,
+		network:        network,
+	}
+}
+
+// NewSolanaTokenAsset creates a new SolanaTokenAssetID for a fungible SPL token mint.
+func NewSolanaTokenAsset(network SolanaNetwork, mint web3.PublicKey) SolanaTokenAssetID {
+	return newSolanaTokenAsset(AssetNamespaceSolanaToken, network, mint)
+}
+
+// NewSolanaNFTAsset creates a new SolanaTokenAssetID for a non-fungible SPL token mint.
+func NewSolanaNFTAsset(network SolanaNetwork, mint web3.PublicKey) SolanaTokenAssetID {
+	return newSolanaTokenAsset(AssetNamespaceSolanaNFT, network, mint)
+}
+
+// NewSolanaTokenAssetFromBase58 creates a new fungible SolanaTokenAssetID from a base58 mint address.
+// The mint is validated with the same rules as NewSolanaFromBase58.
+func NewSolanaTokenAssetFromBase58(network SolanaNetwork, base58Mint string) (SolanaTokenAssetID, error) {
+	return parseSolanaTokenAsset(AssetNamespaceSolanaToken, network, base58Mint)
+}
+
+// NewSolanaNFTAssetFromBase58 creates a new non-fungible SolanaTokenAssetID from a base58 mint address.
+// The mint is validated with the same rules as NewSolanaFromBase58.
+func NewSolanaNFTAssetFromBase58(network SolanaNetwork, base58Mint string) (SolanaTokenAssetID, error) {
+	return parseSolanaTokenAsset(AssetNamespaceSolanaNFT, network, base58Mint)
+}
+
+func parseSolanaTokenAsset(ns AssetNamespace, network SolanaNetwork, base58Mint string) (SolanaTokenAssetID, error) {
+	mint, err := NewSolanaFromBase58(network, base58Mint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s mint: %w", ErrInvalidAssetReference, ns, err)
+	}
+	return newSolanaTokenAsset(ns, network, mint.Account()), nil
+}
+
+// Mint returns the token mint public key.
+func (a *solanaTokenAssetID) Mint() web3.PublicKey {
+	if a == nil {
+		return web3.PublicKey{}
+	}
+	return a.mint
+}
+
+// Network returns the Solana network of the asset.
+func (a *solanaTokenAssetID) Network() SolanaNetwork {
+	if a == nil {
+		return ""
+	}
+	return a.network
+}
+
+// MintAccountID returns the token mint as a SolanaAccountID.
+func (a *solanaTokenAssetID) MintAccountID() SolanaAccountID {
+	if a == nil {
+		return nil
+	}
+	return NewSolana(a.network, a.mint)
+}
+
+// IsNFT returns true if the asset uses the nft asset namespace.
+func (a *solanaTokenAssetID) IsNFT() bool {
+	return a != nil && a.GenericAssetID != nil && a.AssetNamespace() == AssetNamespaceSolanaNFT
+}
+
+// IsOnCurve returns true if the mint is on the ed25519 curve.
+func (a *solanaTokenAssetID) IsOnCurve() bool {
+	if a == nil {
+		return false
+	}
+	return IsOnCurve(a.mint)
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *solanaTokenAssetID) IsZero() bool {
+	return a == nil || a.GenericAssetID == nil || a.GenericAssetID.IsZero()
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *solanaTokenAssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAssetID.Equal(other)
+}
+
+// --- solanaTokenParser ---
+
+type solanaTokenParser struct {
+	ns AssetNamespace
+}
+
+func (p *solanaTokenParser) Namespace() Namespace {
+	return NamespaceSolana
+}
+
+func (p *solanaTokenParser) AssetNamespace() AssetNamespace {
+	return p.ns
+}
+
+func (p *solanaTokenParser) ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error) {
+	if chainID.Namespace != NamespaceSolana {
+		return nil, fmt.Errorf("%w: asset namespace %q requires a solana chain, got %q", ErrInvalidNamespace, p.ns, chainID.Namespace)
+	}
+	if err := validateReference(NamespaceSolana, chainID.Reference); err != nil {
+		return nil, err
+	}
+	if tokenID != "" {
+		return nil, fmt.Errorf("%w: %s assets have no token id, got %q", ErrInvalidTokenID, p.ns, tokenID)
+	}
+	return parseSolanaTokenAsset(p.ns, SolanaNetwork(chainID.Reference), reference)
+}
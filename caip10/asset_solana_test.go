@@ -0,0 +1,82 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usdcMint = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+
+func TestSolanaTokenAssetParse(t *testing.T) {
+	input := "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/token:" + usdcMint
+	a, err := ParseAssetID(input)
+	require.NoError(t, err)
+
+	token, ok := a.(SolanaTokenAssetID)
+	require.True(t, ok, "expected SolanaTokenAssetID, got %T", a)
+	assert.Equal(t, SolanaMainnet, token.Network())
+	assert.Equal(t, web3.MustPublicKey(usdcMint), token.Mint())
+	assert.False(t, token.IsNFT())
+	assert.True(t, token.IsOnCurve())
+	assert.Equal(t, "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:"+usdcMint, token.MintAccountID().String())
+	assert.Equal(t, input, token.String())
+}
+
+func TestSolanaNFTAssetParse(t *testing.T) {
+	input := "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1/nft:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"
+	a, err := ParseAssetID(input)
+	require.NoError(t, err)
+
+	nft, ok := a.(SolanaTokenAssetID)
+	require.True(t, ok, "expected SolanaTokenAssetID, got %T", a)
+	assert.Equal(t, SolanaDevnet, nft.Network())
+	assert.True(t, nft.IsNFT())
+	assert.Equal(t, input, nft.String())
+}
+
+func TestSolanaTokenAssetOffCurve(t *testing.T) {
+	// PDA mints are off curve but still valid, matching SolanaAccountID rules
+	pda, _, err := web3.FindProgramAddress([][]byte{[]byte("mint")}, web3.MustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"))
+	require.NoError(t, err)
+
+	a, err := NewSolanaTokenAssetFromBase58(SolanaMainnet, pda.String())
+	require.NoError(t, err)
+	assert.False(t, a.IsOnCurve())
+}
+
+func TestSolanaTokenAssetParseInvalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		errType error
+	}{
+		{"solana:invalid/token:" + usdcMint, ErrInvalidReference},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/token:invalid", ErrInvalidAssetReference},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/nft:" + usdcMint + "/1", ErrInvalidTokenID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseAssetID(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+
+	// Mint errors keep the underlying address error
+	_, err := NewSolanaNFTAssetFromBase58(SolanaMainnet, "abc")
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+}
+
+func TestSolanaTokenAssetNilReceiver(t *testing.T) {
+	var a *solanaTokenAssetID
+	assert.True(t, a.IsZero())
+	assert.True(t, a.Mint().IsZero())
+	assert.Equal(t, SolanaNetwork(""), a.Network())
+	assert.Nil(t, a.MintAccountID())
+	assert.False(t, a.IsNFT())
+	assert.False(t, a.IsOnCurve())
+}
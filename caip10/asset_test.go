@@ -0,0 +1,163 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCAIP19(t *testing.T) {
+	tests := []struct {
+		input     string
+		chainID   string
+		namespace AssetNamespace
+		reference string
+		tokenID   string
+		wantErr   bool
+	}{
+		{"eip155:1/erc20:0x6b175474e89094c44da98b954eedeac495271d0f", "eip155:1", "erc20", "0x6b175474e89094c44da98b954eedeac495271d0f", "", false},
+		{"eip155:1/erc721:0x06012c8cf97BEaD5deAe237070F9587f8E7A266d/771769", "eip155:1", "erc721", "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "771769", false},
+		{"cosmos:cosmoshub-3/slip44:118", "cosmos:cosmoshub-3", "slip44", "118", "", false},
+		{"", "", "", "", "", true},
+		{"eip155:1", "", "", "", "", true},
+		{"eip155:1/erc20", "", "", "", "", true},
+		{"eip155:1/erc721:0xabc/", "", "", "", "", true},
+		{"eip155:1/erc721:0xabc/1/2", "", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			chainID, ns, ref, tokenID, err := SplitCAIP19(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.chainID, chainID)
+			assert.Equal(t, tt.namespace, ns)
+			assert.Equal(t, tt.reference, ref)
+			assert.Equal(t, tt.tokenID, tokenID)
+		})
+	}
+}
+
+func TestGenericAssetParse(t *testing.T) {
+	const ibc = "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	a, err := ParseAssetID("cosmos:cosmoshub-4/ibc:" + ibc)
+	require.NoError(t, err)
+
+	g, ok := a.(*GenericAssetID)
+	require.True(t, ok, "expected *GenericAssetID, got %T", a)
+	assert.Equal(t, Namespace("cosmos"), g.ChainID().Namespace)
+	assert.Equal(t, "cosmoshub-4", g.ChainID().Reference)
+	assert.Equal(t, AssetNamespace("ibc"), g.AssetNamespace())
+	assert.Equal(t, ibc, g.AssetReference())
+	assert.Equal(t, "", g.AssetTokenID())
+	assert.Equal(t, "cosmos:cosmoshub-4/ibc:"+ibc, g.String())
+}
+
+func TestParseAssetIDInvalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		errType error
+	}{
+		{"", ErrEmptyValue},
+		{"cosmos:cosmoshub-3", ErrInvalidFormat},
+		{"ab:ref/slip44:118", ErrInvalidNamespace},
+		{"eip155:abc/slip44:60", ErrInvalidReference},
+		{"cosmos:hub/ab:118", ErrInvalidAssetNamespace},
+		{"cosmos:hub/slip44:", ErrInvalidAssetReference},
+		{"cosmos:hub/slip44:1@8", ErrInvalidAssetReference},
+		{"cosmos:hub/nft:abc/" + string(make([]byte, 79)), ErrInvalidTokenID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseAssetID(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestGenericAssetSerialization(t *testing.T) {
+	a := MustNewGenericAsset(ChainID{Namespace: "cosmos", Reference: "cosmoshub-3"}, "slip44", "118", "")
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(a)
+		require.NoError(t, err)
+		assert.Equal(t, `"cosmos:cosmoshub-3/slip44:118"`, string(data))
+
+		var b GenericAssetID
+		require.NoError(t, json.Unmarshal(data, &b))
+		assert.True(t, a.Equal(&b))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		data, err := a.MarshalBinary()
+		require.NoError(t, err)
+
+		var b GenericAssetID
+		require.NoError(t, b.UnmarshalBinary(data))
+		assert.True(t, a.Equal(&b))
+	})
+
+	t.Run("cbor", func(t *testing.T) {
+		data, err := cbor.Marshal(a)
+		require.NoError(t, err)
+
+		var b GenericAssetID
+		require.NoError(t, cbor.Unmarshal(data, &b))
+		assert.True(t, a.Equal(&b))
+	})
+
+	t.Run("database", func(t *testing.T) {
+		v, err := a.Value()
+		require.NoError(t, err)
+
+		var b GenericAssetID
+		require.NoError(t, b.Scan(v))
+		assert.True(t, a.Equal(&b))
+
+		require.NoError(t, b.Scan(nil))
+		assert.True(t, b.IsZero())
+		assert.Error(t, b.Scan(42))
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		var z *GenericAssetID
+		assert.True(t, z.IsZero())
+		assert.Equal(t, "", z.String())
+
+		data, err := json.Marshal(&GenericAssetID{})
+		require.NoError(t, err)
+		assert.Equal(t, `""`, string(data))
+	})
+}
+
+func TestGenericAssetToNative(t *testing.T) {
+	var g GenericAssetID
+	require.NoError(t, g.UnmarshalText([]byte("eip155:1/erc20:0x6b175474e89094c44da98b954eedeac495271d0f")))
+
+	native := g.ToNative()
+	_, ok := native.(ERC20AssetID)
+	assert.True(t, ok, "expected ERC20AssetID, got %T", native)
+
+	unknown := MustNewGenericAsset(ChainID{Namespace: "cosmos", Reference: "hub"}, "foo", "bar", "")
+	assert.Same(t, unknown, unknown.ToNative())
+}
+
+func TestEqualAsset(t *testing.T) {
+	a1 := MustParseAssetID("cosmos:cosmoshub-3/slip44:118")
+	a2 := MustParseAssetID("cosmos:cosmoshub-3/slip44:118")
+	a3 := MustParseAssetID("cosmos:cosmoshub-4/slip44:118")
+
+	assert.True(t, EqualAsset(a1, a2))
+	assert.False(t, EqualAsset(a1, a3))
+	assert.True(t, EqualAsset(nil, nil))
+	assert.False(t, EqualAsset(a1, nil))
+}
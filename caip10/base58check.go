@@ -0,0 +1,60 @@
+package caip10
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+// base58CheckChecksumLength is the length of the double SHA-256 checksum suffix.
+const base58CheckChecksumLength = 4
+
+func base58CheckChecksum(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:base58CheckChecksumLength]
+}
+
+// decodeBase58Check decodes a base58check string and returns the data
+// (version prefix and payload) without checksum.
+func decodeBase58Check(s string) ([]byte, error) {
+	decoded, err := base58.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base58 encoding", ErrInvalidAddress)
+	}
+	if len(decoded) <= base58CheckChecksumLength {
+		return nil, fmt.Errorf("%w: base58check data too short", ErrInvalidAddress)
+	}
+	data, checksum := decoded[:len(decoded)-base58CheckChecksumLength], decoded[len(decoded)-base58CheckChecksumLength:]
+	if !bytes.Equal(base58CheckChecksum(data), checksum) {
+		return nil, fmt.Errorf("%w: base58check checksum mismatch", ErrInvalidChecksum)
+	}
+	return data, nil
+}
+
+// encodeBase58Check encodes data (version prefix and payload) with a checksum suffix.
+func encodeBase58Check(data []byte) string {
+	return base58.Encode(append(append([]byte{}, data...), base58CheckChecksum(data)...))
+}
+
+// base58CheckHash160Length is the payload length of legacy P2PKH/P2SH addresses.
+const base58CheckHash160Length = 20
+
+// validateBase58CheckVersion validates a base58check address with a one-byte version
+// prefix from versions followed by a 20-byte hash160.
+func validateBase58CheckVersion(address string, versions []byte) error {
+	data, err := decodeBase58Check(address)
+	if err != nil {
+		return err
+	}
+	if len(data) != 1+base58CheckHash160Length {
+		return fmt.Errorf("%w: base58check address must decode to %d bytes, got %d",
+			ErrInvalidAddress, 1+base58CheckHash160Length, len(data))
+	}
+	if bytes.IndexByte(versions, data[0]) < 0 {
+		return fmt.Errorf("%w: unexpected base58check version byte 0x%02x", ErrInvalidAddress, data[0])
+	}
+	return nil
+}
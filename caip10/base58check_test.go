@@ -0,0 +1,62 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase58CheckRoundTrip(t *testing.T) {
+	// Bitcoin P2PKH address of hash160 0x00..00
+	data, _ := hex.DecodeString("000000000000000000000000000000000000000000")
+	encoded := encodeBase58Check(data)
+	assert.Equal(t, "1111111111111111111114oLvT2", encoded)
+
+	decoded, err := decodeBase58Check(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, decoded)
+}
+
+func TestBase58CheckInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"bad checksum", "1111111111111111111114oLvT3"},
+		{"invalid character", "1111111111111111111114oLvT0"},
+		{"too short", "1111"},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeBase58Check(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
+
+func TestValidateBase58CheckVersion(t *testing.T) {
+	versions := []byte{0x00, 0x05}
+	assert.NoError(t, validateBase58CheckVersion("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", versions))
+	assert.NoError(t, validateBase58CheckVersion("3CNHUhP3uyB9EUtRLsmvFUmvGdjGdkTxJw", versions))
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"wrong version", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r"},
+		{"short payload", "13RJa7YdZQz3JHotw6gx1sco2AAPDrMZM"},
+		{"bad checksum", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBase58CheckVersion(tt.input, versions)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
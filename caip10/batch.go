@@ -0,0 +1,94 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ItemError is the error of one input of a batch operation.
+type ItemError struct {
+	Index int    // position of the input in the batch
+	Input string // the input string, or the account's String for ValidateAll
+	Err   error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("[%d] %q: %v", e.Index, e.Input, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError aggregates the failures of ValidateAll and ParseMany, in input order.
+// errors.Is and errors.As match against every item error.
+type BatchError struct {
+	Total  int // number of inputs in the batch
+	Errors []*ItemError
+}
+
+func (e *BatchError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "caip10: %d of %d inputs invalid", len(e.Errors), e.Total)
+	for i, item := range e.Errors {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(item.Error())
+	}
+	return b.String()
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, item := range e.Errors {
+		errs[i] = item
+	}
+	return errs
+}
+
+// ValidateAll validates every account and reports all failures in a *BatchError,
+// or returns nil if all are valid. nil accounts fail with ErrEmptyValue.
+func ValidateAll(ids ...AccountID) error {
+	batch := &BatchError{Total: len(ids)}
+	for i, id := range ids {
+		var err error
+		if id == nil {
+			err = ErrEmptyValue
+		} else {
+			err = id.Validate()
+		}
+		if err != nil {
+			item := &ItemError{Index: i, Err: err}
+			if id != nil {
+				item.Input = id.String()
+			}
+			batch.Errors = append(batch.Errors, item)
+		}
+	}
+	if len(batch.Errors) == 0 {
+		return nil
+	}
+	return batch
+}
+
+// ParseMany parses every input with Parse. The returned slice has one entry per
+// input, nil where parsing failed; all failures are reported in a *BatchError.
+func ParseMany(inputs []string, opts ...ParseOption) ([]AccountID, error) {
+	ids := make([]AccountID, len(inputs))
+	batch := &BatchError{Total: len(inputs)}
+	for i, s := range inputs {
+		a, err := Parse(s, opts...)
+		if err != nil {
+			batch.Errors = append(batch.Errors, &ItemError{Index: i, Input: s, Err: err})
+			continue
+		}
+		ids[i] = a
+	}
+	if len(batch.Errors) == 0 {
+		return ids, nil
+	}
+	return ids, batch
+}
@@ -0,0 +1,65 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMany(t *testing.T) {
+	inputs := []string{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"eip155:1:0x12",
+		"",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+	}
+	ids, err := ParseMany(inputs)
+	require.Len(t, ids, 4)
+	assert.NotNil(t, ids[0])
+	assert.Nil(t, ids[1])
+	assert.Nil(t, ids[2])
+	assert.NotNil(t, ids[3])
+
+	var batch *BatchError
+	require.True(t, errors.As(err, &batch), "got %v", err)
+	assert.Equal(t, 4, batch.Total)
+	require.Len(t, batch.Errors, 2)
+	assert.Equal(t, 1, batch.Errors[0].Index)
+	assert.Equal(t, "eip155:1:0x12", batch.Errors[0].Input)
+	assert.Equal(t, 2, batch.Errors[1].Index)
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+	assert.True(t, errors.Is(batch.Errors[1], ErrEmptyValue))
+	assert.False(t, errors.Is(err, ErrInvalidNamespace))
+	assert.Contains(t, err.Error(), `caip10: 2 of 4 inputs invalid: [1] "eip155:1:0x12": `)
+	assert.Contains(t, err.Error(), `; [2] "": caip10: empty value`)
+
+	ids, err = ParseMany(inputs[:1], WithPolicy(ValidationStrict))
+	require.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	ids, err = ParseMany(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestValidateAll(t *testing.T) {
+	valid := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	invalid := newGenericUnchecked("EIP155", "1", "0xab")
+
+	assert.NoError(t, ValidateAll())
+	assert.NoError(t, ValidateAll(valid, valid))
+
+	err := ValidateAll(valid, invalid, nil)
+	var batch *BatchError
+	require.True(t, errors.As(err, &batch), "got %v", err)
+	assert.Equal(t, 3, batch.Total)
+	require.Len(t, batch.Errors, 2)
+	assert.Equal(t, 1, batch.Errors[0].Index)
+	assert.Equal(t, "EIP155:1:0xab", batch.Errors[0].Input)
+	assert.True(t, errors.Is(batch.Errors[0], ErrInvalidNamespace))
+	assert.Equal(t, 2, batch.Errors[1].Index)
+	assert.Equal(t, "", batch.Errors[1].Input)
+	assert.True(t, errors.Is(err, ErrEmptyValue))
+}
@@ -0,0 +1,144 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bech32Variant selects the checksum constant of a bech32 string.
+// https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+// https://github.com/bitcoin/bips/blob/master/bip-0350.mediawiki
+type bech32Variant uint32
+
+const (
+	bech32Classic bech32Variant = 1          // BIP-173
+	bech32M       bech32Variant = 0x2bc830a3 // BIP-350
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// bech32CharsetRev maps an ASCII character to its 5-bit value, or -1.
+var bech32CharsetRev = func() [128]int8 {
+	var rev [128]int8
+	for i := range rev {
+		rev[i] = -1
+	}
+	for i, c := range bech32Charset {
+		rev[c] = int8(i)
+	}
+	return rev
+}()
+
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+// decodeBech32 decodes a bech32 or bech32m string into its lowercase human-readable
+// part and 5-bit data values (checksum removed). maxLen limits the total length;
+// pass 0 for no limit, as used by Cardano.
+func decodeBech32(s string, maxLen int) (hrp string, data []byte, variant bech32Variant, err error) {
+	if maxLen > 0 && len(s) > maxLen {
+		return "", nil, 0, fmt.Errorf("%w: bech32 string exceeds %d characters", ErrInvalidAddress, maxLen)
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, 0, fmt.Errorf("%w: bech32 string has mixed case", ErrInvalidAddress)
+	}
+	s = lower
+
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, 0, fmt.Errorf("%w: invalid bech32 separator position", ErrInvalidAddress)
+	}
+	hrp = s[:pos]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, fmt.Errorf("%w: invalid bech32 human-readable part", ErrInvalidAddress)
+		}
+	}
+
+	values := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		c := s[i]
+		if c >= 128 || bech32CharsetRev[c] < 0 {
+			return "", nil, 0, fmt.Errorf("%w: invalid bech32 character %q", ErrInvalidAddress, c)
+		}
+		values = append(values, byte(bech32CharsetRev[c]))
+	}
+
+	switch v := bech32Variant(bech32Polymod(append(bech32HRPExpand(hrp), values...))); v {
+	case bech32Classic, bech32M:
+		variant = v
+	default:
+		return "", nil, 0, fmt.Errorf("%w: bech32 checksum mismatch", ErrInvalidChecksum)
+	}
+	return hrp, values[:len(values)-6], variant, nil
+}
+
+// encodeBech32 encodes 5-bit data values with the given human-readable part and variant.
+func encodeBech32(hrp string, data []byte, variant bech32Variant) string {
+	values := append(bech32HRPExpand(hrp), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ uint32(variant)
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + 6)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		sb.WriteByte(bech32Charset[d])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// convertBits regroups data from fromBits-wide to toBits-wide values.
+// Without padding, leftover bits must be zero and fewer than fromBits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc, bits uint
+	maxv := uint(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		if uint(v)>>fromBits != 0 {
+			return nil, fmt.Errorf("%w: invalid data value %d", ErrInvalidAddress, v)
+		}
+		acc = acc<<fromBits | uint(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, fmt.Errorf("%w: invalid bech32 padding", ErrInvalidAddress)
+	}
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package caip10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBech32Valid(t *testing.T) {
+	// BIP-173 and BIP-350 test vectors
+	tests := []struct {
+		input   string
+		variant bech32Variant
+	}{
+		{"A12UEL5L", bech32Classic},
+		{"a12uel5l", bech32Classic},
+		{"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", bech32Classic},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", bech32Classic},
+		{"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w", bech32Classic},
+		{"A1LQFN3A", bech32M},
+		{"a1lqfn3a", bech32M},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", bech32M},
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", bech32M},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			hrp, data, variant, err := decodeBech32(tt.input, 90)
+			require.NoError(t, err)
+			assert.Equal(t, tt.variant, variant)
+			assert.Equal(t, strings.ToLower(tt.input), encodeBech32(hrp, data, variant))
+		})
+	}
+}
+
+func TestDecodeBech32Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"no separator", "pzry9x0s0muk"},
+		{"empty hrp", "1pzry9x0s0muk"},
+		{"invalid data character", "x1b4n0q5v"},
+		{"checksum too short", "li1dgmt3"},
+		{"mixed case", "A1G7SGD8"},
+		{"bad checksum", "a12uel5m"},
+		{"too long", "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := decodeBech32(tt.input, 90)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	data := []byte{0x00, 0x14, 0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91}
+	five, err := convertBits(data, 8, 5, true)
+	require.NoError(t, err)
+
+	back, err := convertBits(five, 5, 8, false)
+	require.NoError(t, err)
+	assert.Equal(t, data, back)
+
+	// Non-zero padding bits are rejected
+	five[len(five)-1] |= 1
+	_, err = convertBits(five, 5, 8, false)
+	assert.Error(t, err)
+}
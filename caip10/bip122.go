@@ -1,8 +1,13 @@
 package caip10
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 )
 
 const NamespaceBIP122 Namespace = "bip122"
@@ -31,6 +36,10 @@ const (
 
 	// Dash
 	DashMainnet BIP122Network = "00000ffd590b1485b3caadc19b22e637" // Dash mainnet
+
+	// Zcash (transparent addresses only)
+	ZcashMainnet BIP122Network = "00040fe8ec8471911baa1db1266ea15d" // Zcash mainnet
+	ZcashTestnet BIP122Network = "05a60a92d99d85997cce3b87616c089f" // Zcash testnet
 )
 
 // String returns the network reference string.
@@ -43,83 +52,180 @@ func (n BIP122Network) String() string {
 	return s
 }
 
-// BIP122 address validation regexes
+// Generic BIP122 address regex (loose validation for unregistered networks)
+// Covers base58btc addresses and bech32/bech32m addresses
+var genericBIP122AddressRegex = regexp.MustCompile(`^([a-km-zA-HJ-NP-Z1-9]{25,35}|[a-z]{1,12}:?[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,64})$`)
+
+// Base58check version bytes of hash160 addresses by script type. The first
+// version of each set is used to encode addresses.
+var (
+	bitcoinMainnetP2PKHVersions  = []byte{0x00}       // 1
+	bitcoinMainnetP2SHVersions   = []byte{0x05}       // 3
+	bitcoinTestnetP2PKHVersions  = []byte{0x6f}       // m/n
+	bitcoinTestnetP2SHVersions   = []byte{0xc4}       // 2
+	litecoinMainnetP2PKHVersions = []byte{0x30}       // L
+	litecoinMainnetP2SHVersions  = []byte{0x32, 0x05} // M and legacy 3
+	litecoinTestnetP2PKHVersions = []byte{0x6f}       // m/n
+	litecoinTestnetP2SHVersions  = []byte{0x3a, 0xc4} // Q and legacy 2
+	dogecoinMainnetP2PKHVersions = []byte{0x1e}       // D
+	dogecoinMainnetP2SHVersions  = []byte{0x16}       // 9/A
+	dogecoinTestnetP2PKHVersions = []byte{0x71}       // n
+	dogecoinTestnetP2SHVersions  = []byte{0xc4}       // 2
+	dashMainnetP2PKHVersions     = []byte{0x4c}       // X
+	dashMainnetP2SHVersions      = []byte{0x10}       // 7
+)
+
+// BIP122NetworkInfo describes a BIP122 network for strict address validation.
+type BIP122NetworkInfo struct {
+	// Network is the chain reference (first 32 characters of the genesis block hash).
+	Network BIP122Network
+	// Name is the human readable network name, e.g. "Bitcoin mainnet".
+	Name string
+	// AddressRegex validates additional address formats. Ignored if Validate is set.
+	AddressRegex *regexp.Regexp
+	// SegWitHRP is the human-readable part of SegWit and Taproot addresses, e.g. "bc".
+	// Ignored if Validate is set.
+	SegWitHRP string
+	// P2PKHVersions and P2SHVersions are the accepted version bytes of P2PKH and
+	// P2SH base58check addresses with a 20-byte hash160 payload. The first of each
+	// is used to encode addresses. Ignored if Validate is set.
+	P2PKHVersions []byte
+	P2SHVersions  []byte
+	// Base58Versions are further accepted base58check version bytes. If
+	// P2PKHVersions and P2SHVersions are empty, the first two are the P2PKH and
+	// P2SH versions. Ignored if Validate is set.
+	Base58Versions []byte
+	// Validate validates an address. Takes precedence over AddressRegex.
+	Validate func(address string) error
+}
+
+// validate validates an address against the network rules.
+func (i BIP122NetworkInfo) validate(address string) error {
+	if i.Validate != nil {
+		return i.Validate(address)
+	}
+	if i.AddressRegex != nil && i.AddressRegex.MatchString(address) {
+		return nil
+	}
+	if i.SegWitHRP != "" && strings.HasPrefix(strings.ToLower(address), i.SegWitHRP+"1") {
+		return validateSegWitAddress(address, i.SegWitHRP)
+	}
+	if versions := i.base58Versions(); len(versions) > 0 {
+		return validateBase58CheckVersion(address, versions)
+	}
+	return fmt.Errorf("%w: invalid address format for network %s", ErrInvalidAddress, i.Network)
+}
+
+// base58Versions returns all accepted base58check version bytes.
+func (i BIP122NetworkInfo) base58Versions() []byte {
+	return slices.Concat(i.P2PKHVersions, i.P2SHVersions, i.Base58Versions)
+}
+
+// base58ScriptVersions returns the P2PKH and P2SH base58check version bytes.
+func (i BIP122NetworkInfo) base58ScriptVersions() (p2pkh, p2sh []byte) {
+	if len(i.P2PKHVersions) > 0 || len(i.P2SHVersions) > 0 {
+		return i.P2PKHVersions, i.P2SHVersions
+	}
+	if len(i.Base58Versions) < 2 {
+		return i.Base58Versions, nil
+	}
+	return i.Base58Versions[:1], i.Base58Versions[1:2]
+}
+
 var (
-	// Bitcoin mainnet addresses:
-	// - P2SH: starts with "3", base58btc encoded
-	// - P2WPKH (SegWit): starts with "bc1q", bech32 encoded
-	// - P2TR (Taproot): starts with "bc1p", bech32m encoded
-	bitcoinMainnetAddressRegex = regexp.MustCompile(`^(bc1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,59}|3[a-km-zA-HJ-NP-Z1-9]{25,34})$`)
-
-	// Bitcoin testnet addresses:
-	// - P2SH: starts with "2", base58btc encoded
-	// - P2WPKH/P2TR: starts with "tb1", bech32/bech32m encoded
-	bitcoinTestnetAddressRegex = regexp.MustCompile(`^(tb1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,59}|2[a-km-zA-HJ-NP-Z1-9]{25,34})$`)
-
-	// Bitcoin Cash mainnet addresses:
-	// - CashAddr: starts with "q" or "p" (without prefix), or "bitcoincash:q/p"
-	// - Legacy: starts with "1" or "3", base58btc encoded (same as Bitcoin)
-	bitcoinCashMainnetAddressRegex = regexp.MustCompile(`^(bitcoincash:)?[qp][qpzry9x8gf2tvdw0s3jn54khce6mua7l]{41}$|^[13][a-km-zA-HJ-NP-Z1-9]{25,34}$`)
-
-	// Litecoin mainnet addresses:
-	// - P2SH: starts with "M" or "3", base58btc encoded
-	// - P2WPKH: starts with "ltc1", bech32 encoded
-	litecoinMainnetAddressRegex = regexp.MustCompile(`^(ltc1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,59}|[M3][a-km-zA-HJ-NP-Z1-9]{25,34})$`)
-
-	// Litecoin testnet addresses:
-	// - P2WPKH: starts with "tltc1", bech32 encoded
-	litecoinTestnetAddressRegex = regexp.MustCompile(`^(tltc1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,59}|[mn2][a-km-zA-HJ-NP-Z1-9]{25,34})$`)
-
-	// Dogecoin mainnet addresses:
-	// - P2PKH: starts with "D", base58 encoded
-	// - P2SH: starts with "9" or "A", base58 encoded
-	dogecoinMainnetAddressRegex = regexp.MustCompile(`^[D9A][a-km-zA-HJ-NP-Z1-9]{25,34}$`)
-
-	// Dogecoin testnet addresses:
-	// - P2PKH: starts with "n", base58 encoded
-	dogecoinTestnetAddressRegex = regexp.MustCompile(`^[nm][a-km-zA-HJ-NP-Z1-9]{25,34}$`)
-
-	// Dash mainnet addresses:
-	// - P2PKH: starts with "X", base58 encoded
-	// - P2SH: starts with "7", base58 encoded
-	dashMainnetAddressRegex = regexp.MustCompile(`^[X7][a-km-zA-HJ-NP-Z1-9]{25,34}$`)
-
-	// Generic BIP122 address regex (loose validation)
-	// Covers base58btc addresses and bech32/bech32m addresses
-	genericBIP122AddressRegex = regexp.MustCompile(`^([a-km-zA-HJ-NP-Z1-9]{25,35}|[a-z]{1,12}:?[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,64})$`)
+	bip122NetworksMu sync.RWMutex
+	bip122Networks   = map[BIP122Network]BIP122NetworkInfo{}
 )
 
+// defaultBIP122Networks are the networks registered at package initialization.
+var defaultBIP122Networks = []BIP122NetworkInfo{
+	{Network: BitcoinMainnet, Name: "Bitcoin mainnet", SegWitHRP: "bc", P2PKHVersions: bitcoinMainnetP2PKHVersions, P2SHVersions: bitcoinMainnetP2SHVersions},
+	{Network: BitcoinTestnet, Name: "Bitcoin testnet", SegWitHRP: "tb", P2PKHVersions: bitcoinTestnetP2PKHVersions, P2SHVersions: bitcoinTestnetP2SHVersions},
+	{Network: BitcoinCashMainnet, Name: "Bitcoin Cash mainnet", Validate: ValidateBitcoinCashAddress},
+	{Network: LitecoinMainnet, Name: "Litecoin mainnet", SegWitHRP: "ltc", P2PKHVersions: litecoinMainnetP2PKHVersions, P2SHVersions: litecoinMainnetP2SHVersions},
+	{Network: LitecoinTestnet, Name: "Litecoin testnet", SegWitHRP: "tltc", P2PKHVersions: litecoinTestnetP2PKHVersions, P2SHVersions: litecoinTestnetP2SHVersions},
+	{Network: DogecoinMainnet, Name: "Dogecoin mainnet", P2PKHVersions: dogecoinMainnetP2PKHVersions, P2SHVersions: dogecoinMainnetP2SHVersions},
+	{Network: DogecoinTestnet, Name: "Dogecoin testnet", P2PKHVersions: dogecoinTestnetP2PKHVersions, P2SHVersions: dogecoinTestnetP2SHVersions},
+	{Network: DashMainnet, Name: "Dash mainnet", P2PKHVersions: dashMainnetP2PKHVersions, P2SHVersions: dashMainnetP2SHVersions},
+	{Network: ZcashMainnet, Name: "Zcash mainnet", Validate: func(address string) error {
+		return ValidateZcashTransparentAddress(ZcashMainnet, address)
+	}},
+	{Network: ZcashTestnet, Name: "Zcash testnet", Validate: func(address string) error {
+		return ValidateZcashTransparentAddress(ZcashTestnet, address)
+	}},
+}
+
+// RegisterBIP122Network registers a BIP122 network for strict address validation,
+// replacing any network previously registered with the same reference.
+// It is safe for concurrent use.
+func RegisterBIP122Network(info BIP122NetworkInfo) error {
+	if !bip122ReferenceRegex.MatchString(string(info.Network)) {
+		return fmt.Errorf("%w: BIP122 reference must be 32 lowercase hex characters, got %q", ErrInvalidReference, info.Network)
+	}
+	if info.AddressRegex == nil && info.Validate == nil && info.SegWitHRP == "" && len(info.base58Versions()) == 0 {
+		return fmt.Errorf("%w: BIP122 network %s has no address validator", ErrInvalidFormat, info.Network)
+	}
+
+	bip122NetworksMu.Lock()
+	defer bip122NetworksMu.Unlock()
+	bip122Networks[info.Network] = info
+	return nil
+}
+
+// LookupBIP122Network returns the registered network info for a reference.
+func LookupBIP122Network(network BIP122Network) (BIP122NetworkInfo, bool) {
+	bip122NetworksMu.RLock()
+	defer bip122NetworksMu.RUnlock()
+	info, ok := bip122Networks[network]
+	return info, ok
+}
+
+// bip122GenesisHashLength is the length of a hex-encoded block hash.
+const bip122GenesisHashLength = 64
+
+// BIP122ReferenceFromGenesisHash returns the chain reference of a network from its
+// full genesis block hash in RPC display order, as printed by "getblockhash 0":
+// the first 32 characters, lower cased.
+func BIP122ReferenceFromGenesisHash(fullHash string) (BIP122Network, error) {
+	if len(fullHash) != bip122GenesisHashLength || !isHex(fullHash) {
+		return "", fmt.Errorf("%w: genesis hash must be %d hex characters, got %q", ErrInvalidReference, bip122GenesisHashLength, fullHash)
+	}
+	return BIP122Network(strings.ToLower(fullHash[:32])), nil
+}
+
+// BIP122ReferenceFromGenesisBytes returns the chain reference of a network from
+// its genesis block hash in internal byte order, the raw double SHA-256 of the
+// header, which is the reverse of the display order.
+func BIP122ReferenceFromGenesisBytes(hash [32]byte) BIP122Network {
+	slices.Reverse(hash[:])
+	return BIP122Network(hex.EncodeToString(hash[:16]))
+}
+
+// LookupBIP122NetworkByGenesisHash returns the registered network whose reference
+// is derived from a full genesis hash in display order.
+func LookupBIP122NetworkByGenesisHash(fullHash string) (BIP122NetworkInfo, bool) {
+	network, err := BIP122ReferenceFromGenesisHash(fullHash)
+	if err != nil {
+		return BIP122NetworkInfo{}, false
+	}
+	return LookupBIP122Network(network)
+}
+
 // ValidateBIP122Address validates a BIP122 address string for a specific network.
+// Registered networks use their own validator; unknown networks fall back to
+// loose validation.
 // Returns nil if valid, error otherwise.
 func ValidateBIP122Address(network BIP122Network, address string) error {
 	if len(address) == 0 {
 		return fmt.Errorf("%w: empty address", ErrInvalidAddress)
 	}
 
-	var regex *regexp.Regexp
-	switch network {
-	case BitcoinMainnet:
-		regex = bitcoinMainnetAddressRegex
-	case BitcoinTestnet:
-		regex = bitcoinTestnetAddressRegex
-	case BitcoinCashMainnet:
-		regex = bitcoinCashMainnetAddressRegex
-	case LitecoinMainnet:
-		regex = litecoinMainnetAddressRegex
-	case LitecoinTestnet:
-		regex = litecoinTestnetAddressRegex
-	case DogecoinMainnet:
-		regex = dogecoinMainnetAddressRegex
-	case DogecoinTestnet:
-		regex = dogecoinTestnetAddressRegex
-	case DashMainnet:
-		regex = dashMainnetAddressRegex
-	default:
-		// Use generic validation for unknown networks
-		regex = genericBIP122AddressRegex
-	}
-
-	if !regex.MatchString(address) {
+	if info, ok := LookupBIP122Network(network); ok {
+		return info.validate(address)
+	}
+
+	// Use generic validation for unknown networks
+	if !genericBIP122AddressRegex.MatchString(address) {
 		return fmt.Errorf("%w: invalid address format for network %s", ErrInvalidAddress, network)
 	}
 
@@ -155,12 +261,16 @@ var _ BIP122AccountID = (*bip122AccountID)(nil)
 
 func init() {
 	RegisterParser(&bip122Parser{})
+	for _, info := range defaultBIP122Networks {
+		bip122Networks[info.Network] = info
+	}
 }
 
 // bip122AccountID represents a BIP122 account ID per CAIP-10.
 type bip122AccountID struct {
 	*GenericAccountID // embedded, inherits all serialization methods
 	network           BIP122Network
+	scriptType        ScriptType // classified from the address on construction
 }
 
 // NewBIP122 creates a new BIP122AccountID.
@@ -169,6 +279,7 @@ func NewBIP122(network BIP122Network, address string) BIP122AccountID {
 	return &bip122AccountID{
 		GenericAccountID: base,
 		network:          network,
+		scriptType:       classifyBIP122Script(network, address),
 	}
 }
 
@@ -218,6 +329,101 @@ func (a *bip122AccountID) Network() BIP122Network {
 	return a.network
 }
 
+// ScriptType returns the output script type of the address, or ScriptUnknown if
+// the address does not decode with the network's encodings.
+func (a *bip122AccountID) ScriptType() ScriptType {
+	if a == nil {
+		return ScriptUnknown
+	}
+	return a.scriptType
+}
+
+// bip122Native returns a as a *bip122AccountID, converting other BIP122AccountID
+// implementations. Nil and zero accounts return nil.
+func bip122Native(a BIP122AccountID) *bip122AccountID {
+	if n, ok := a.(*bip122AccountID); ok {
+		return n
+	}
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	return NewBIP122(a.Network(), a.Address()).(*bip122AccountID)
+}
+
+// ScriptTypeOf returns the output script type of the address of a, or
+// ScriptUnknown if the address does not decode with the network's encodings.
+func ScriptTypeOf(a BIP122AccountID) ScriptType {
+	return bip122Native(a).ScriptType()
+}
+
+// classifyBIP122Script returns the script type of an address on a registered network.
+func classifyBIP122Script(network BIP122Network, address string) ScriptType {
+	info, ok := LookupBIP122Network(network)
+	if !ok {
+		return ScriptUnknown
+	}
+	if info.SegWitHRP != "" && strings.HasPrefix(strings.ToLower(address), info.SegWitHRP+"1") {
+		_, version, program, err := DecodeSegWit(address)
+		switch {
+		case err != nil:
+			return ScriptUnknown
+		case version == 0 && len(program) == 20:
+			return ScriptP2WPKH
+		case version == 0 && len(program) == 32:
+			return ScriptP2WSH
+		case version == 1 && len(program) == 32:
+			return ScriptP2TR
+		}
+		return ScriptUnknown
+	}
+
+	switch network {
+	case BitcoinCashMainnet:
+		if isLegacyBitcoinCashAddress(address) {
+			return classifyBase58Script(address, bitcoinCashLegacyVersions[:1], bitcoinCashLegacyVersions[1:])
+		}
+		_, typ, _, err := DecodeCashAddr(address)
+		switch {
+		case err != nil:
+			return ScriptUnknown
+		case typ == CashAddrP2PKH:
+			return ScriptP2PKH
+		case typ == CashAddrP2SH:
+			return ScriptP2SH
+		}
+		return ScriptUnknown
+	case ZcashMainnet, ZcashTestnet:
+		data, err := decodeBase58Check(address)
+		if err != nil || len(data) < 2 {
+			return ScriptUnknown
+		}
+		switch {
+		case bytes.Equal(data[:2], zcashMainnetP2PKH), bytes.Equal(data[:2], zcashTestnetP2PKH):
+			return ScriptP2PKH
+		case bytes.Equal(data[:2], zcashMainnetP2SH), bytes.Equal(data[:2], zcashTestnetP2SH):
+			return ScriptP2SH
+		}
+		return ScriptUnknown
+	}
+	p2pkh, p2sh := info.base58ScriptVersions()
+	return classifyBase58Script(address, p2pkh, p2sh)
+}
+
+// classifyBase58Script classifies a hash160 base58check address by its version byte.
+func classifyBase58Script(address string, p2pkh, p2sh []byte) ScriptType {
+	data, err := decodeBase58Check(address)
+	if err != nil || len(data) != 1+base58CheckHash160Length {
+		return ScriptUnknown
+	}
+	switch {
+	case bytes.IndexByte(p2pkh, data[0]) >= 0:
+		return ScriptP2PKH
+	case bytes.IndexByte(p2sh, data[0]) >= 0:
+		return ScriptP2SH
+	}
+	return ScriptUnknown
+}
+
 // SetAddress returns a new BIP122AccountID with the specified address.
 func (a *bip122AccountID) SetAddress(address string) BIP122AccountID {
 	if a == nil {
@@ -242,6 +448,15 @@ func (a *bip122AccountID) Equal(other AccountID) bool {
 	return a.GenericAccountID.Equal(other)
 }
 
+// Format implements fmt.Formatter; %+v and %#v include the script type.
+func (a *bip122AccountID) Format(f fmt.State, verb rune) {
+	if a == nil || a.GenericAccountID == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	formatAccount(f, verb, a, formatField{"scriptType", a.ScriptType()})
+}
+
 // --- bip122Parser ---
 
 type bip122Parser struct{}
@@ -258,9 +473,9 @@ func (p *bip122Parser) Parse(s string) (AccountID, error) {
 	if ns != NamespaceBIP122 {
 		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceBIP122, ns)
 	}
-	return NewBIP122(BIP122Network(ref), addr), nil
+	return NewBIP122WithValidation(BIP122Network(ref), addr)
 }
 
 func (p *bip122Parser) ParseAddress(reference, address string) (AccountID, error) {
-	return NewBIP122(BIP122Network(reference), address), nil
+	return NewBIP122WithValidation(BIP122Network(reference), address)
 }
@@ -0,0 +1,91 @@
+package caip10
+
+import (
+	"sort"
+	"strings"
+)
+
+// DetectionConfidence ranks how likely a detected network is.
+type DetectionConfidence int
+
+// Detection confidences
+const (
+	ConfidenceLow    DetectionConfidence = 1 // shared legacy encoding, e.g. a Bitcoin Cash legacy address
+	ConfidenceMedium DetectionConfidence = 2 // version byte shared with other networks
+	ConfidenceHigh   DetectionConfidence = 3 // encoding unique to the network
+)
+
+// String returns the name of the confidence.
+func (c DetectionConfidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// BIP122NetworkCandidate is a network an address is valid on.
+type BIP122NetworkCandidate struct {
+	Network    BIP122Network
+	Name       string
+	Confidence DetectionConfidence
+}
+
+// DetectBIP122Network returns the registered networks a raw address is valid on,
+// most likely first, e.g. Bitcoin mainnet for "bc1q...", Litecoin for "ltc1..."
+// and Dogecoin for "D...". Addresses valid on a single network have high
+// confidence. A version byte shared by several networks, such as the "3" P2SH
+// prefix of Bitcoin and legacy Litecoin, ranks the network whose primary
+// encoding it is first. Returns nil if no network matches.
+func DetectBIP122Network(address string) []BIP122NetworkCandidate {
+	if address == "" {
+		return nil
+	}
+	bip122NetworksMu.RLock()
+	var out []BIP122NetworkCandidate
+	for _, info := range bip122Networks {
+		if info.validate(address) == nil {
+			out = append(out, BIP122NetworkCandidate{
+				Network:    info.Network,
+				Name:       info.Name,
+				Confidence: detectionConfidence(info, address),
+			})
+		}
+	}
+	bip122NetworksMu.RUnlock()
+
+	if len(out) == 1 {
+		out[0].Confidence = ConfidenceHigh
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Confidence != out[j].Confidence {
+			return out[i].Confidence > out[j].Confidence
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// detectionConfidence ranks an address valid on a network that other networks
+// may also accept.
+func detectionConfidence(info BIP122NetworkInfo, address string) DetectionConfidence {
+	if info.Validate != nil {
+		return ConfidenceLow
+	}
+	if info.SegWitHRP != "" && strings.HasPrefix(strings.ToLower(address), info.SegWitHRP+"1") {
+		return ConfidenceHigh
+	}
+	if data, err := decodeBase58Check(address); err == nil && len(data) > 0 {
+		// The preferred P2PKH and P2SH versions; legacy versions are shared.
+		p2pkh, p2sh := info.base58ScriptVersions()
+		if len(p2pkh) > 0 && data[0] == p2pkh[0] || len(p2sh) > 0 && data[0] == p2sh[0] {
+			return ConfidenceMedium
+		}
+	}
+	return ConfidenceLow
+}
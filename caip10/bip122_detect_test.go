@@ -0,0 +1,60 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectBIP122Network(t *testing.T) {
+	tests := []struct {
+		address    string
+		network    BIP122Network
+		confidence DetectionConfidence
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BitcoinMainnet, ConfidenceHigh},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", BitcoinMainnet, ConfidenceHigh},
+		{"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", BitcoinTestnet, ConfidenceHigh},
+		{"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", LitecoinMainnet, ConfidenceHigh},
+		{"37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf", BitcoinMainnet, ConfidenceMedium},
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", BitcoinMainnet, ConfidenceMedium},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			got := DetectBIP122Network(tt.address)
+			require.NotEmpty(t, got)
+			assert.Equal(t, tt.network, got[0].Network)
+			assert.Equal(t, tt.confidence, got[0].Confidence)
+		})
+	}
+
+	// A Dogecoin address matches only Dogecoin
+	doge, err := BIP122FromPubkey(DogecoinMainnet, mustHex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"), ScriptP2PKH)
+	require.NoError(t, err)
+	got := DetectBIP122Network(doge.Address())
+	require.Len(t, got, 1)
+	assert.Equal(t, DogecoinMainnet, got[0].Network)
+	assert.Equal(t, ConfidenceHigh, got[0].Confidence)
+
+	// Legacy P2PKH addresses are shared by Bitcoin and Bitcoin Cash
+	got = DetectBIP122Network("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")
+	require.Len(t, got, 2)
+	assert.Equal(t, BitcoinCashMainnet, got[1].Network)
+	assert.Equal(t, ConfidenceLow, got[1].Confidence)
+
+	// The "3" P2SH prefix is legacy on Litecoin
+	got = DetectBIP122Network("37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf")
+	var ltc *BIP122NetworkCandidate
+	for i := range got {
+		if got[i].Network == LitecoinMainnet {
+			ltc = &got[i]
+		}
+	}
+	require.NotNil(t, ltc)
+	assert.Equal(t, ConfidenceLow, ltc.Confidence)
+
+	assert.Nil(t, DetectBIP122Network(""))
+	assert.Nil(t, DetectBIP122Network("0x8e23Ee67d1332aD560396262C48ffbB01F93D052"))
+	assert.Equal(t, "high", ConfidenceHigh.String())
+}
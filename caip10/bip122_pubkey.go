@@ -0,0 +1,163 @@
+package caip10
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// ScriptType is the output script type of a BIP122 address.
+//
+// ScriptP2SHP2WPKH is only used to derive addresses: its address is a P2SH
+// address and classifies as ScriptP2SH.
+type ScriptType string
+
+// Script types
+const (
+	ScriptUnknown    ScriptType = ""
+	ScriptP2PKH      ScriptType = "p2pkh"       // pay to public key hash, base58check
+	ScriptP2SH       ScriptType = "p2sh"        // pay to script hash, base58check
+	ScriptP2SHP2WPKH ScriptType = "p2sh-p2wpkh" // P2WPKH wrapped in P2SH (BIP-49), base58check
+	ScriptP2WPKH     ScriptType = "p2wpkh"      // native segwit v0 key hash (BIP-84), bech32
+	ScriptP2WSH      ScriptType = "p2wsh"       // native segwit v0 script hash, bech32
+	ScriptP2TR       ScriptType = "p2tr"        // taproot (BIP-86), bech32m
+)
+
+// BIP122FromPubkey derives the address of a secp256k1 public key for a script
+// type on a registered network. pubkey is a 33-byte compressed or 65-byte
+// uncompressed key; P2TR also accepts a 32-byte x-only key. Segwit script types
+// require a compressed key.
+//
+// P2TR addresses commit to the key without a script tree, as in BIP-86.
+// Networks validated by a custom function, such as Bitcoin Cash and Zcash, are
+// not supported.
+func BIP122FromPubkey(network BIP122Network, pubkey []byte, scriptType ScriptType) (BIP122AccountID, error) {
+	info, ok := LookupBIP122Network(network)
+	if !ok || info.Validate != nil {
+		return nil, fmt.Errorf("%w: address derivation is not supported for network %s", ErrInvalidReference, network)
+	}
+
+	var address string
+	var err error
+	switch scriptType {
+	case ScriptP2PKH:
+		if err := checkPubkey(pubkey, false); err != nil {
+			return nil, err
+		}
+		address, err = encodeBase58Hash160(info, ScriptP2PKH, hash160(pubkey))
+	case ScriptP2SHP2WPKH:
+		if err := checkPubkey(pubkey, true); err != nil {
+			return nil, err
+		}
+		redeemScript := append([]byte{0x00, 0x14}, hash160(pubkey)...)
+		address, err = encodeBase58Hash160(info, ScriptP2SH, hash160(redeemScript))
+	case ScriptP2WPKH:
+		if err := checkPubkey(pubkey, true); err != nil {
+			return nil, err
+		}
+		address, err = encodeSegWitFor(info, 0, hash160(pubkey))
+	case ScriptP2TR:
+		var output []byte
+		if output, err = taprootOutputKey(pubkey); err != nil {
+			return nil, err
+		}
+		address, err = encodeSegWitFor(info, 1, output)
+	default:
+		return nil, fmt.Errorf("%w: unsupported script type %q", ErrInvalidFormat, scriptType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewBIP122WithValidation(network, address)
+}
+
+// checkPubkey checks that pubkey is a valid secp256k1 public key.
+func checkPubkey(pubkey []byte, compressed bool) error {
+	if compressed && len(pubkey) != secp256k1.PubKeyBytesLenCompressed {
+		return fmt.Errorf("%w: segwit requires a %d-byte compressed public key, got %d bytes",
+			ErrInvalidAddress, secp256k1.PubKeyBytesLenCompressed, len(pubkey))
+	}
+	if _, err := secp256k1.ParsePubKey(pubkey); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	return nil
+}
+
+// hash160 returns RIPEMD160(SHA256(data)).
+func hash160(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	h := ripemd160.New()
+	h.Write(sum[:])
+	return h.Sum(nil)
+}
+
+// encodeBase58Hash160 encodes a hash160 with the network's version byte of a
+// script type, ScriptP2PKH or ScriptP2SH.
+func encodeBase58Hash160(info BIP122NetworkInfo, script ScriptType, hash []byte) (string, error) {
+	version, ok := base58ScriptVersion(info, script)
+	if !ok {
+		return "", fmt.Errorf("%w: network %s has no base58 %s address version", ErrInvalidReference, info.Network, script)
+	}
+	return encodeBase58Check(append([]byte{version}, hash...)), nil
+}
+
+// base58ScriptVersion returns the version byte used to encode base58check
+// addresses of a script type, ScriptP2PKH or ScriptP2SH, on a network.
+func base58ScriptVersion(info BIP122NetworkInfo, script ScriptType) (byte, bool) {
+	p2pkh, p2sh := info.base58ScriptVersions()
+	versions := p2pkh
+	if script == ScriptP2SH {
+		versions = p2sh
+	}
+	if len(versions) == 0 {
+		return 0, false
+	}
+	return versions[0], true
+}
+
+// encodeSegWitFor encodes a witness program with the network's SegWit HRP.
+func encodeSegWitFor(info BIP122NetworkInfo, version byte, program []byte) (string, error) {
+	if info.SegWitHRP == "" {
+		return "", fmt.Errorf("%w: network %s does not support segwit", ErrInvalidReference, info.Network)
+	}
+	return EncodeSegWit(info.SegWitHRP, version, program)
+}
+
+// taprootOutputKey returns the x-only BIP-86 output key Q = P + H_TapTweak(P)G of
+// an internal key P.
+func taprootOutputKey(pubkey []byte) ([]byte, error) {
+	if len(pubkey) == 32 {
+		pubkey = append([]byte{secp256k1.PubKeyFormatCompressedEven}, pubkey...)
+	}
+	key, err := secp256k1.ParsePubKey(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	xOnly := key.SerializeCompressed()[1:]
+
+	var tweak secp256k1.ModNScalar
+	if overflow := tweak.SetByteSlice(taggedHash("TapTweak", xOnly)); overflow {
+		return nil, fmt.Errorf("%w: taproot tweak out of range", ErrInvalidAddress)
+	}
+
+	// Use the even-y point for P, as BIP-340 x-only keys do.
+	var p, t, q secp256k1.JacobianPoint
+	even, _ := secp256k1.ParsePubKey(append([]byte{secp256k1.PubKeyFormatCompressedEven}, xOnly...))
+	even.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&tweak, &t)
+	secp256k1.AddNonConst(&p, &t, &q)
+	q.ToAffine()
+	return secp256k1.NewPublicKey(&q.X, &q.Y).SerializeCompressed()[1:], nil
+}
+
+// taggedHash is the BIP-340 tagged hash SHA256(SHA256(tag) || SHA256(tag) || msg).
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(msg)
+	return h.Sum(nil)
+}
@@ -0,0 +1,87 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestBIP122FromPubkey(t *testing.T) {
+	const generator = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	const generatorUncompressed = "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+
+	tests := []struct {
+		name       string
+		network    BIP122Network
+		pubkey     string
+		scriptType ScriptType
+		want       string
+	}{
+		// Public keys of m/purpose'/0'/0'/0/0 for the "abandon ... about" mnemonic, from the BIP test vectors
+		{"bip44 p2pkh", BitcoinMainnet, "03aaeb52dd7494c361049de67cc680e83ebcbbbdbeb13637d92cd845f70308af5e", ScriptP2PKH, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+		{"bip49 p2sh-p2wpkh", BitcoinMainnet, "039b3b694b8fc5b5e07fb069c783cac754f5d38c3e08bed1960e31fdb1dda35c24", ScriptP2SHP2WPKH, "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf"},
+		{"bip84 p2wpkh", BitcoinMainnet, "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c", ScriptP2WPKH, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{"bip86 p2tr", BitcoinMainnet, "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115", ScriptP2TR, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+
+		{"generator p2pkh", BitcoinMainnet, generator, ScriptP2PKH, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{"generator uncompressed p2pkh", BitcoinMainnet, generatorUncompressed, ScriptP2PKH, "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm"},
+		{"generator p2wpkh", BitcoinMainnet, generator, ScriptP2WPKH, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{"generator testnet p2wpkh", BitcoinTestnet, generator, ScriptP2WPKH, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := BIP122FromPubkey(tt.network, mustHex(t, tt.pubkey), tt.scriptType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.Address())
+			assert.Equal(t, tt.network, a.Network())
+		})
+	}
+
+	// A compressed key with odd y and its x-only form derive the same taproot address
+	odd, err := BIP122FromPubkey(BitcoinMainnet, mustHex(t, "03"+generator[2:]), ScriptP2TR)
+	require.NoError(t, err)
+	xOnly, err := BIP122FromPubkey(BitcoinMainnet, mustHex(t, generator[2:]), ScriptP2TR)
+	require.NoError(t, err)
+	assert.Equal(t, xOnly.Address(), odd.Address())
+
+	// Litecoin and Dogecoin use their own version bytes and HRP
+	ltc, err := BIP122FromPubkey(LitecoinMainnet, mustHex(t, generator), ScriptP2WPKH)
+	require.NoError(t, err)
+	assert.Equal(t, "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", ltc.Address())
+	doge, err := BIP122FromPubkey(DogecoinMainnet, mustHex(t, generator), ScriptP2PKH)
+	require.NoError(t, err)
+	assert.Equal(t, byte('D'), doge.Address()[0])
+}
+
+func TestBIP122FromPubkeyInvalid(t *testing.T) {
+	generator := mustHex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	uncompressed := mustHex(t, "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"+
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+
+	_, err := BIP122FromPubkey(BitcoinMainnet, uncompressed, ScriptP2WPKH)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = BIP122FromPubkey(BitcoinMainnet, generator[:20], ScriptP2PKH)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = BIP122FromPubkey(BitcoinMainnet, append([]byte{0x05}, generator[1:]...), ScriptP2PKH)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = BIP122FromPubkey(BitcoinMainnet, generator, "p2wsh")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+
+	_, err = BIP122FromPubkey(DogecoinMainnet, generator, ScriptP2WPKH)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	_, err = BIP122FromPubkey(BitcoinCashMainnet, generator, ScriptP2PKH)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	_, err = BIP122FromPubkey("00000000000000000000000000000000", generator, ScriptP2PKH)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+}
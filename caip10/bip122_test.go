@@ -1,7 +1,11 @@
 package caip10
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
@@ -24,9 +28,9 @@ func TestBIP122Parse(t *testing.T) {
 			address: "bc1qwz2lhc40s8ty3l5jg3plpve3y3l82x9l42q7fk",
 		},
 		{
-			input:   "bip122:000000000019d6689c085ae165831e93:bc1pmzfrwwndsqmk5yh69yjr5lfgfg4ev8c0tsc06e",
+			input:   "bip122:000000000019d6689c085ae165831e93:bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
 			network: BitcoinMainnet,
-			address: "bc1pmzfrwwndsqmk5yh69yjr5lfgfg4ev8c0tsc06e",
+			address: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
 		},
 		{
 			input:   "bip122:1a91e3dace36e2be3bf030a65679fe82:DBcZSePDaMMduBMLymWHXhkE5ArFEvkagU",
@@ -292,7 +296,7 @@ func TestValidateBIP122Address(t *testing.T) {
 		{
 			name:    "Bitcoin mainnet Taproot",
 			network: BitcoinMainnet,
-			address: "bc1pmzfrwwndsqmk5yh69yjr5lfgfg4ev8c0tsc06e",
+			address: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
 			wantErr: false,
 		},
 		{
@@ -315,6 +319,91 @@ func TestValidateBIP122Address(t *testing.T) {
 			address: "ltc1q8c6fshw2dlwun7ekn9qwf37cu2rn755u9ym7p0",
 			wantErr: false,
 		},
+		// Legacy base58check version bytes
+		{
+			name:    "Bitcoin mainnet P2PKH",
+			network: BitcoinMainnet,
+			address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			wantErr: false,
+		},
+		{
+			name:    "Bitcoin mainnet P2SH bad checksum",
+			network: BitcoinMainnet,
+			address: "35PBEaofpUeH8VnnNSorM1QZsadrZoQp4M",
+			wantErr: true,
+		},
+		{
+			name:    "Bitcoin mainnet testnet version",
+			network: BitcoinMainnet,
+			address: "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r",
+			wantErr: true,
+		},
+		{
+			name:    "Bitcoin testnet P2SH",
+			network: BitcoinTestnet,
+			address: "2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf",
+			wantErr: false,
+		},
+		{
+			name:    "Bitcoin Cash legacy P2PKH",
+			network: BitcoinCashMainnet,
+			address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			wantErr: false,
+		},
+		{
+			name:    "Litecoin mainnet P2PKH",
+			network: LitecoinMainnet,
+			address: "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ",
+			wantErr: false,
+		},
+		{
+			name:    "Litecoin mainnet P2SH",
+			network: LitecoinMainnet,
+			address: "MJaRnao1s62a2zAKSkmG582KbLKianqb7v",
+			wantErr: false,
+		},
+		{
+			name:    "Litecoin testnet P2SH",
+			network: LitecoinTestnet,
+			address: "QXHFfTBKYXjaaTH1e7Rox8CcdNPGHVhM59",
+			wantErr: false,
+		},
+		{
+			name:    "Dogecoin mainnet P2SH",
+			network: DogecoinMainnet,
+			address: "A37YDYSwz3438rFtm1SLVcQHyD7JeueC9H",
+			wantErr: false,
+		},
+		{
+			name:    "Dogecoin mainnet too short",
+			network: DogecoinMainnet,
+			address: "Dtest",
+			wantErr: true,
+		},
+		{
+			name:    "Dogecoin mainnet bitcoin version",
+			network: DogecoinMainnet,
+			address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			wantErr: true,
+		},
+		{
+			name:    "Dogecoin testnet P2PKH",
+			network: DogecoinTestnet,
+			address: "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2",
+			wantErr: false,
+		},
+		{
+			name:    "Dash mainnet P2PKH",
+			network: DashMainnet,
+			address: "XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE",
+			wantErr: false,
+		},
+		{
+			name:    "Dash mainnet P2SH",
+			network: DashMainnet,
+			address: "7d5vJtfDixGnEFRNcVSRarmaCBZeScHACn",
+			wantErr: false,
+		},
 		// Empty address
 		{
 			name:    "empty address",
@@ -361,3 +450,194 @@ func TestBIP122NilReceiver(t *testing.T) {
 		t.Error("nil receiver SetAddress should return nil")
 	}
 }
+
+func TestBIP122ScriptType(t *testing.T) {
+	p2wsh, err := EncodeSegWit("bc", 0, make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		network BIP122Network
+		address string
+		want    ScriptType
+	}{
+		{BitcoinMainnet, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", ScriptP2PKH},
+		{BitcoinMainnet, "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf", ScriptP2SH},
+		{BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ScriptP2WPKH},
+		{BitcoinMainnet, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", ScriptP2WPKH},
+		{BitcoinMainnet, p2wsh, ScriptP2WSH},
+		{BitcoinMainnet, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", ScriptP2TR},
+		{BitcoinTestnet, "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", ScriptP2PKH},
+		{LitecoinMainnet, "3MSvaVbVFFLML86rt5eqgA9SvW23upaXdY", ScriptP2SH},
+		{LitecoinTestnet, "2MzQwSSnBHWHqSAqtTVQ6v47XtaisrJa1Vc", ScriptP2SH},
+		{BitcoinCashMainnet, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", ScriptP2PKH},
+		{BitcoinCashMainnet, "ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", ScriptP2SH},
+		{ZcashMainnet, "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi", ScriptP2PKH},
+		{ZcashMainnet, "t3JZe8uVCra9T1mot8DC99s7GVsDKFy2Xa2", ScriptP2SH},
+		{BitcoinMainnet, "invalid", ScriptUnknown},
+		{"000000000000000000000000000000ff", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", ScriptUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			if got := ScriptTypeOf(NewBIP122(tt.network, tt.address)); got != tt.want {
+				t.Errorf("ScriptType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Parsed and switched accounts are classified too
+	a := MustParse("bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4").(BIP122AccountID)
+	if ScriptTypeOf(a) != ScriptP2WPKH {
+		t.Errorf("parsed ScriptType() = %q, want %q", ScriptTypeOf(a), ScriptP2WPKH)
+	}
+	if got := ScriptTypeOf(a.SetAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")); got != ScriptP2PKH {
+		t.Errorf("SetAddress ScriptType() = %q, want %q", got, ScriptP2PKH)
+	}
+	var nilAccount *bip122AccountID
+	if nilAccount.ScriptType() != ScriptUnknown {
+		t.Error("nil receiver ScriptType should return ScriptUnknown")
+	}
+}
+
+func TestRegisterBIP122Network(t *testing.T) {
+	// Groestlcoin mainnet
+	network := BIP122Network("00000ac5927c594d49cc0bdb81759d0d")
+	t.Cleanup(func() {
+		bip122NetworksMu.Lock()
+		delete(bip122Networks, network)
+		bip122NetworksMu.Unlock()
+	})
+
+	// Unknown networks use loose validation
+	if err := ValidateBIP122Address(network, "35PBEaofpUeH8VnnNSorM1QZsadrZoQp4N"); err != nil {
+		t.Fatalf("loose validation failed: %v", err)
+	}
+
+	err := RegisterBIP122Network(BIP122NetworkInfo{
+		Network:      network,
+		Name:         "Groestlcoin mainnet",
+		AddressRegex: regexp.MustCompile(`^(grs1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{39,59}|F[a-km-zA-HJ-NP-Z1-9]{25,34})$`),
+	})
+	if err != nil {
+		t.Fatalf("RegisterBIP122Network failed: %v", err)
+	}
+
+	info, ok := LookupBIP122Network(network)
+	if !ok || info.Name != "Groestlcoin mainnet" {
+		t.Errorf("LookupBIP122Network: got %+v, %v", info, ok)
+	}
+	if err := ValidateBIP122Address(network, "FfgZPEfmvou5VxZRnTbRjPKhgVsrx7Qjq9"); err != nil {
+		t.Errorf("registered regex rejected valid address: %v", err)
+	}
+	if err := ValidateBIP122Address(network, "35PBEaofpUeH8VnnNSorM1QZsadrZoQp4N"); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("expected ErrInvalidAddress after registration, got %v", err)
+	}
+
+	// Validate takes precedence over AddressRegex
+	err = RegisterBIP122Network(BIP122NetworkInfo{
+		Network:      network,
+		Name:         "Groestlcoin mainnet",
+		AddressRegex: regexp.MustCompile(`.*`),
+		Validate: func(address string) error {
+			return fmt.Errorf("%w: rejected", ErrInvalidAddress)
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterBIP122Network failed: %v", err)
+	}
+	if err := ValidateBIP122Address(network, "FfgZPEfmvou5VxZRnTbRjPKhgVsrx7Qjq9"); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("expected Validate to take precedence, got %v", err)
+	}
+}
+
+func TestRegisterBIP122NetworkInvalid(t *testing.T) {
+	regex := regexp.MustCompile(`.*`)
+
+	err := RegisterBIP122Network(BIP122NetworkInfo{Network: "groestlcoin", AddressRegex: regex})
+	if !errors.Is(err, ErrInvalidReference) {
+		t.Errorf("expected ErrInvalidReference, got %v", err)
+	}
+
+	err = RegisterBIP122Network(BIP122NetworkInfo{Network: "00000ac5927c594d49cc0bdb81759d0d"})
+	if err == nil {
+		t.Error("expected error for network without validator")
+	}
+	if _, ok := LookupBIP122Network("00000ac5927c594d49cc0bdb81759d0d"); ok {
+		t.Error("invalid network should not be registered")
+	}
+}
+
+func TestLookupBIP122NetworkBuiltin(t *testing.T) {
+	for _, network := range []BIP122Network{BitcoinMainnet, LitecoinMainnet, DogecoinMainnet, DashMainnet, ZcashMainnet} {
+		info, ok := LookupBIP122Network(network)
+		if !ok {
+			t.Errorf("%s not registered", network)
+			continue
+		}
+		if info.Name == "" {
+			t.Errorf("%s has no name", network)
+		}
+	}
+}
+
+func TestBIP122ReferenceFromGenesisHash(t *testing.T) {
+	tests := []struct {
+		hash string
+		want BIP122Network
+	}{
+		{"000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", BitcoinMainnet},
+		{"000000000933EA01AD0EE984209779BAAEC3CED90FA3F408719526F8D77F4943", BitcoinTestnet},
+		{"12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2", LitecoinMainnet},
+		{"1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691", DogecoinMainnet},
+	}
+	for _, tt := range tests {
+		got, err := BIP122ReferenceFromGenesisHash(tt.hash)
+		if err != nil {
+			t.Fatalf("BIP122ReferenceFromGenesisHash(%q): %v", tt.hash, err)
+		}
+		if got != tt.want {
+			t.Errorf("BIP122ReferenceFromGenesisHash(%q) = %q, want %q", tt.hash, got, tt.want)
+		}
+		info, ok := LookupBIP122NetworkByGenesisHash(tt.hash)
+		if !ok || info.Network != tt.want {
+			t.Errorf("LookupBIP122NetworkByGenesisHash(%q) = %v, %v", tt.hash, info.Network, ok)
+		}
+	}
+
+	for _, hash := range []string{"", "000000000019d6689c085ae165831e93", "zz0000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"} {
+		if _, err := BIP122ReferenceFromGenesisHash(hash); !errors.Is(err, ErrInvalidReference) {
+			t.Errorf("BIP122ReferenceFromGenesisHash(%q): got %v", hash, err)
+		}
+	}
+	if _, ok := LookupBIP122NetworkByGenesisHash("1111111111111111111111111111111111111111111111111111111111111111"); ok {
+		t.Error("unknown genesis hash should not be found")
+	}
+
+	// Internal byte order is the reverse of the display order
+	var raw [32]byte
+	hex.Decode(raw[:], []byte("6fe28c0ab6f1b372c1a6a246ae63f74f931e8365e15a089c68d6190000000000"))
+	if got := BIP122ReferenceFromGenesisBytes(raw); got != BitcoinMainnet {
+		t.Errorf("BIP122ReferenceFromGenesisBytes = %q, want %q", got, BitcoinMainnet)
+	}
+}
+
+func TestBIP122ParseValidates(t *testing.T) {
+	tests := []string{
+		"bip122:1a91e3dace36e2be3bf030a65679fe82:Dtest",       // legacy, bad base58check
+		"bip122:000000000019d6689c085ae165831e93:bc1qgarbage", // segwit, bad bech32
+	}
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			if _, err := Parse(s); !errors.Is(err, ErrInvalidAddress) {
+				t.Errorf("Parse(%q): got %v, want ErrInvalidAddress", s, err)
+			}
+			_, ref, addr, err := SplitCAIP10(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := (&bip122Parser{}).ParseAddress(ref, addr); !errors.Is(err, ErrInvalidAddress) {
+				t.Errorf("ParseAddress(%q, %q): got %v, want ErrInvalidAddress", ref, addr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package caip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// BIP-32 extended public keys and the SLIP-132 version bytes that select the
+// network and script type of their addresses.
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+// https://github.com/satoshilabs/slips/blob/master/slip-0132.md
+
+// extendedKeyLength is the serialized length of an extended key without checksum.
+const extendedKeyLength = 78
+
+// extendedKeyVersion is the network and script type of an extended key version.
+type extendedKeyVersion struct {
+	network    BIP122Network
+	scriptType ScriptType
+}
+
+// extendedPublicKeyVersions maps SLIP-132 public key versions to their network
+// and script type.
+var extendedPublicKeyVersions = map[[4]byte]extendedKeyVersion{
+	{0x04, 0x88, 0xb2, 0x1e}: {BitcoinMainnet, ScriptP2PKH},       // xpub
+	{0x04, 0x9d, 0x7c, 0xb2}: {BitcoinMainnet, ScriptP2SHP2WPKH},  // ypub
+	{0x04, 0xb2, 0x47, 0x46}: {BitcoinMainnet, ScriptP2WPKH},      // zpub
+	{0x04, 0x35, 0x87, 0xcf}: {BitcoinTestnet, ScriptP2PKH},       // tpub
+	{0x04, 0x4a, 0x52, 0x62}: {BitcoinTestnet, ScriptP2SHP2WPKH},  // upub
+	{0x04, 0x5f, 0x1c, 0xf6}: {BitcoinTestnet, ScriptP2WPKH},      // vpub
+	{0x01, 0x9d, 0xa4, 0x62}: {LitecoinMainnet, ScriptP2PKH},      // Ltub
+	{0x01, 0xb2, 0x6e, 0xf6}: {LitecoinMainnet, ScriptP2SHP2WPKH}, // Mtub
+}
+
+// ExtendedPublicKey is a BIP-32 extended public key.
+type ExtendedPublicKey struct {
+	Version           [4]byte
+	Network           BIP122Network // from Version
+	ScriptType        ScriptType    // from Version
+	Depth             uint8
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [32]byte
+	Key               [33]byte // compressed public key
+}
+
+// ParseExtendedPublicKey parses an xpub, ypub or zpub (or a testnet or Litecoin
+// equivalent). The version bytes select the network and script type.
+func ParseExtendedPublicKey(s string) (*ExtendedPublicKey, error) {
+	data, err := decodeBase58Check(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != extendedKeyLength {
+		return nil, fmt.Errorf("%w: extended key must decode to %d bytes, got %d", ErrInvalidFormat, extendedKeyLength, len(data))
+	}
+	k := &ExtendedPublicKey{
+		Version:     [4]byte(data[0:4]),
+		Depth:       data[4],
+		ChildNumber: binary.BigEndian.Uint32(data[9:13]),
+		ChainCode:   [32]byte(data[13:45]),
+		Key:         [33]byte(data[45:78]),
+	}
+	copy(k.ParentFingerprint[:], data[5:9])
+	v, ok := extendedPublicKeyVersions[k.Version]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown extended public key version %x", ErrInvalidFormat, k.Version)
+	}
+	k.Network, k.ScriptType = v.network, v.scriptType
+	if _, err := secp256k1.ParsePubKey(k.Key[:]); err != nil {
+		return nil, fmt.Errorf("%w: invalid extended public key: %v", ErrInvalidFormat, err)
+	}
+	return k, nil
+}
+
+// String returns the base58check serialization of the key.
+func (k *ExtendedPublicKey) String() string {
+	data := make([]byte, 0, extendedKeyLength)
+	data = append(data, k.Version[:]...)
+	data = append(data, k.Depth)
+	data = append(data, k.ParentFingerprint[:]...)
+	data = binary.BigEndian.AppendUint32(data, k.ChildNumber)
+	data = append(data, k.ChainCode[:]...)
+	data = append(data, k.Key[:]...)
+	return encodeBase58Check(data)
+}
+
+// Child derives the non-hardened child key at index (CKDpub). Hardened indexes
+// need the private key and fail with ErrInvalidDerivationPath.
+func (k *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= HardenedOffset {
+		return nil, fmt.Errorf("%w: hardened child %d cannot be derived from a public key", ErrInvalidDerivationPath, index-HardenedOffset)
+	}
+	if k.Depth == 0xff {
+		return nil, fmt.Errorf("%w: extended key depth exceeds 255", ErrInvalidDerivationPath)
+	}
+	parent, err := secp256k1.ParsePubKey(k.Key[:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid extended public key: %v", ErrInvalidFormat, err)
+	}
+
+	mac := hmac.New(sha512.New, k.ChainCode[:])
+	mac.Write(k.Key[:])
+	mac.Write(binary.BigEndian.AppendUint32(nil, index))
+	sum := mac.Sum(nil)
+
+	// Child key: parse256(IL)*G + K. IL >= n or the point at infinity make the
+	// index invalid; BIP-32 callers proceed with the next index.
+	var il secp256k1.ModNScalar
+	if overflow := il.SetByteSlice(sum[:32]); overflow {
+		return nil, fmt.Errorf("%w: child %d is invalid, use the next index", ErrInvalidDerivationPath, index)
+	}
+	var p, t, q secp256k1.JacobianPoint
+	parent.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&il, &t)
+	secp256k1.AddNonConst(&t, &p, &q)
+	if (q.X.IsZero() && q.Y.IsZero()) || q.Z.IsZero() {
+		return nil, fmt.Errorf("%w: child %d is invalid, use the next index", ErrInvalidDerivationPath, index)
+	}
+	q.ToAffine()
+
+	child := &ExtendedPublicKey{
+		Version:     k.Version,
+		Network:     k.Network,
+		ScriptType:  k.ScriptType,
+		Depth:       k.Depth + 1,
+		ChildNumber: index,
+		ChainCode:   [32]byte(sum[32:]),
+		Key:         [33]byte(secp256k1.NewPublicKey(&q.X, &q.Y).SerializeCompressed()),
+	}
+	copy(child.ParentFingerprint[:], hash160(k.Key[:]))
+	return child, nil
+}
+
+// DeriveAddress derives the address at change/index below an account-level key
+// (m/purpose'/coin_type'/account'), with the script type implied by the key
+// version. change is 0 for receiving and 1 for change addresses.
+func (k *ExtendedPublicKey) DeriveAddress(change, index uint32) (BIP122AccountID, error) {
+	return k.DeriveAddressWithScript(k.ScriptType, change, index)
+}
+
+// DeriveAddressWithScript is like DeriveAddress with an explicit script type,
+// for keys whose version does not imply how they are used, such as an xpub of
+// a BIP-86 taproot account.
+func (k *ExtendedPublicKey) DeriveAddressWithScript(scriptType ScriptType, change, index uint32) (BIP122AccountID, error) {
+	branch, err := k.Child(change)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := branch.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	return BIP122FromPubkey(k.Network, leaf.Key[:], scriptType)
+}
+
+// DeriveBIP122FromXpub derives the receiving or change address at index from an
+// account-level xpub, ypub or zpub.
+func DeriveBIP122FromXpub(xpub string, change bool, index uint32) (BIP122AccountID, error) {
+	k, err := ParseExtendedPublicKey(xpub)
+	if err != nil {
+		return nil, err
+	}
+	var branch uint32
+	if change {
+		branch = 1
+	}
+	return k.DeriveAddress(branch, index)
+}
@@ -0,0 +1,70 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedPublicKeyChild(t *testing.T) {
+	// BIP-32 test vector 1: m, m/0H and m/0H/1
+	const (
+		m    = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+		m0h  = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+		m0h1 = "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+	)
+	k, err := ParseExtendedPublicKey(m)
+	require.NoError(t, err)
+	assert.Equal(t, BitcoinMainnet, k.Network)
+	assert.Equal(t, ScriptP2PKH, k.ScriptType)
+	assert.Equal(t, m, k.String())
+
+	_, err = k.Child(HardenedOffset)
+	assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "got %v", err)
+
+	k, err = ParseExtendedPublicKey(m0h)
+	require.NoError(t, err)
+	child, err := k.Child(1)
+	require.NoError(t, err)
+	assert.Equal(t, m0h1, child.String())
+	assert.Equal(t, uint8(2), child.Depth)
+}
+
+func TestExtendedPublicKeyDeriveAddress(t *testing.T) {
+	// BIP-84 test vector account m/84'/0'/0'
+	const zpub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	tests := []struct {
+		change bool
+		index  uint32
+		want   string
+	}{
+		{false, 0, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{false, 1, "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g"},
+		{true, 0, "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+	}
+	for _, tt := range tests {
+		account, err := DeriveBIP122FromXpub(zpub, tt.change, tt.index)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, account.Address())
+		assert.Equal(t, BitcoinMainnet, account.Network())
+		assert.Equal(t, ScriptP2WPKH, ScriptTypeOf(account))
+	}
+
+	k, err := ParseExtendedPublicKey(zpub)
+	require.NoError(t, err)
+	taproot, err := k.DeriveAddressWithScript(ScriptP2TR, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, ScriptP2TR, ScriptTypeOf(taproot))
+}
+
+func TestParseExtendedPublicKeyErrors(t *testing.T) {
+	// BIP-32 test vector 1 master private key
+	_, err := ParseExtendedPublicKey("xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ParseExtendedPublicKey("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet9")
+	assert.True(t, errors.Is(err, ErrInvalidChecksum), "got %v", err)
+	_, err = ParseExtendedPublicKey("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
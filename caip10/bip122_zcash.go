@@ -0,0 +1,57 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Zcash transparent address version prefixes (2 bytes, followed by a 20-byte hash160).
+var (
+	zcashMainnetP2PKH = []byte{0x1c, 0xb8} // t1
+	zcashMainnetP2SH  = []byte{0x1c, 0xbd} // t3
+	zcashTestnetP2PKH = []byte{0x1d, 0x25} // tm
+	zcashTestnetP2SH  = []byte{0x1c, 0xba} // t2
+)
+
+// zcashTransparentPayloadLength is the length of a transparent address payload (hash160).
+const zcashTransparentPayloadLength = 20
+
+// ValidateZcashTransparentAddress validates a Zcash transparent (t-addr) address.
+// Mainnet accepts t1 (P2PKH) and t3 (P2SH); testnet accepts tm (P2PKH) and t2 (P2SH).
+// Shielded addresses are not supported.
+func ValidateZcashTransparentAddress(network BIP122Network, address string) error {
+	var versions [][]byte
+	switch network {
+	case ZcashMainnet:
+		versions = [][]byte{zcashMainnetP2PKH, zcashMainnetP2SH}
+	case ZcashTestnet:
+		versions = [][]byte{zcashTestnetP2PKH, zcashTestnetP2SH}
+	default:
+		return fmt.Errorf("%w: %s is not a zcash network", ErrInvalidReference, network)
+	}
+
+	data, err := decodeBase58Check(address)
+	if err != nil {
+		return err
+	}
+	if len(data) != 2+zcashTransparentPayloadLength {
+		return fmt.Errorf("%w: zcash transparent address must decode to %d bytes, got %d",
+			ErrInvalidAddress, 2+zcashTransparentPayloadLength, len(data))
+	}
+	for _, v := range versions {
+		if bytes.Equal(data[:2], v) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: invalid zcash transparent address version for network %s", ErrInvalidAddress, network)
+}
+
+// NewZcashMainnet creates a BIP122AccountID for Zcash mainnet.
+func NewZcashMainnet(address string) BIP122AccountID {
+	return NewBIP122(ZcashMainnet, address)
+}
+
+// NewZcashTestnet creates a BIP122AccountID for Zcash testnet.
+func NewZcashTestnet(address string) BIP122AccountID {
+	return NewBIP122(ZcashTestnet, address)
+}
@@ -0,0 +1,113 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateZcashTransparentAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		network BIP122Network
+		address string
+		wantErr bool
+	}{
+		{
+			name:    "mainnet t1 P2PKH",
+			network: ZcashMainnet,
+			address: "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi",
+		},
+		{
+			name:    "mainnet t3 P2SH",
+			network: ZcashMainnet,
+			address: "t3JZe8uVCra9T1mot8DC99s7GVsDKFy2Xa2",
+		},
+		{
+			name:    "testnet tm P2PKH",
+			network: ZcashTestnet,
+			address: "tm9iNYCVAhLLa4rJtfqqHauR5xL1REdpiDs",
+		},
+		{
+			name:    "testnet t2 P2SH",
+			network: ZcashTestnet,
+			address: "t26YqBabLj2kpZUPd3xCBhVHucMSV83GWSw",
+		},
+		{
+			name:    "testnet address on mainnet",
+			network: ZcashMainnet,
+			address: "tm9iNYCVAhLLa4rJtfqqHauR5xL1REdpiDs",
+			wantErr: true,
+		},
+		{
+			name:    "mainnet address on testnet",
+			network: ZcashTestnet,
+			address: "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi",
+			wantErr: true,
+		},
+		{
+			name:    "bad checksum",
+			network: ZcashMainnet,
+			address: "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCj",
+			wantErr: true,
+		},
+		{
+			name:    "bitcoin address",
+			network: ZcashMainnet,
+			address: "35PBEaofpUeH8VnnNSorM1QZsadrZoQp4N",
+			wantErr: true,
+		},
+		{
+			name:    "invalid base58",
+			network: ZcashMainnet,
+			address: "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fC0",
+			wantErr: true,
+		},
+		{
+			name:    "empty address",
+			network: ZcashMainnet,
+			address: "",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateBIP122Address(tc.network, tc.address)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateBIP122Address(%q, %q) error = %v, wantErr %v", tc.network, tc.address, err, tc.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidAddress) {
+				t.Errorf("expected ErrInvalidAddress, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateZcashTransparentAddressNetwork(t *testing.T) {
+	err := ValidateZcashTransparentAddress(BitcoinMainnet, "t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi")
+	if !errors.Is(err, ErrInvalidReference) {
+		t.Errorf("expected ErrInvalidReference, got %v", err)
+	}
+}
+
+func TestNewZcashMainnet(t *testing.T) {
+	a := NewZcashMainnet("t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi")
+	if a.Network() != ZcashMainnet {
+		t.Errorf("Network: got %q, want %q", a.Network(), ZcashMainnet)
+	}
+	if a.ChainID() != ChainIDZcashMainnet {
+		t.Errorf("ChainID: got %v, want %v", a.ChainID(), ChainIDZcashMainnet)
+	}
+	want := "bip122:00040fe8ec8471911baa1db1266ea15d:t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi"
+	if a.String() != want {
+		t.Errorf("String: got %q, want %q", a.String(), want)
+	}
+	if err := a.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	b := NewZcashTestnet("t1HsdDMzmJfq4vc7T17XYjEkLMLvbgM1fCi")
+	if err := b.Validate(); err == nil {
+		t.Error("expected error for mainnet address on testnet")
+	}
+}
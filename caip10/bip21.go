@@ -0,0 +1,145 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// BIP-21 payment URIs, e.g. "bitcoin:bc1q...?amount=0.001&label=Shop".
+// https://github.com/bitcoin/bips/blob/master/bip-0021.mediawiki
+
+// BIP21Scheme is the URI scheme of BIP-21 payment requests.
+const BIP21Scheme = "bitcoin"
+
+// satoshiDecimals is the number of decimals of a BIP-21 amount.
+const satoshiDecimals = 8
+
+// bip21Networks are the networks BIP-21 addresses are matched against, in order.
+var bip21Networks = []BIP122Network{BitcoinMainnet, BitcoinTestnet}
+
+// BIP21Request is a parsed BIP-21 payment request.
+type BIP21Request struct {
+	Address BIP122AccountID   // recipient; the network is inferred from the address
+	Amount  *big.Int          // amount in satoshis, nil if absent
+	Label   string            // recipient label
+	Message string            // payment description
+	Params  map[string]string // other parameters, e.g. "lightning"
+}
+
+// NewBIP21Payment returns a request to pay amount satoshis to the account.
+func NewBIP21Payment(to BIP122AccountID, amount *big.Int) *BIP21Request {
+	return &BIP21Request{Address: to, Amount: amount}
+}
+
+// ParseBIP21 parses a BIP-21 URI. The network is inferred from the address:
+// Bitcoin mainnet, then testnet. Uppercase bech32 addresses, as used in QR
+// codes, are lower cased. Unknown "req-" parameters are rejected as BIP-21
+// requires.
+func ParseBIP21(s string) (*BIP21Request, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	scheme, rest, ok := strings.Cut(s, ":")
+	if !ok || !strings.EqualFold(scheme, BIP21Scheme) {
+		return nil, fmt.Errorf("%w: bip21: missing %q scheme", ErrInvalidFormat, BIP21Scheme+":")
+	}
+	address, query, _ := strings.Cut(rest, "?")
+	if strings.ToUpper(address) == address {
+		address = strings.ToLower(address)
+	}
+
+	r := &BIP21Request{}
+	for _, network := range bip21Networks {
+		if ValidateBIP122Address(network, address) == nil {
+			r.Address = NewBIP122(network, address)
+			break
+		}
+	}
+	if r.Address == nil {
+		return nil, fmt.Errorf("%w: bip21: not a bitcoin address %q", ErrInvalidAddress, address)
+	}
+
+	if query == "" {
+		return r, nil
+	}
+	for _, pair := range strings.Split(query, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: bip21: invalid parameter %q", ErrInvalidFormat, pair)
+		}
+		value, err := url.QueryUnescape(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bip21: parameter %q: %v", ErrInvalidFormat, key, err)
+		}
+		switch key {
+		case "amount":
+			if r.Amount, err = parseBIP21Amount(value); err != nil {
+				return nil, fmt.Errorf("%w: bip21: parameter %q: %v", ErrInvalidFormat, key, err)
+			}
+		case "label":
+			r.Label = value
+		case "message":
+			r.Message = value
+		default:
+			if strings.HasPrefix(key, "req-") {
+				return nil, fmt.Errorf("%w: bip21: unsupported required parameter %q", ErrInvalidFormat, key)
+			}
+			if r.Params == nil {
+				r.Params = map[string]string{}
+			}
+			r.Params[key] = value
+		}
+	}
+	return r, nil
+}
+
+// String returns the BIP-21 URI of the request. Other parameters are written in
+// key order after amount, label and message.
+func (r *BIP21Request) String() string {
+	if r == nil || r.Address == nil || r.Address.IsZero() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(BIP21Scheme + ":")
+	b.WriteString(r.Address.Address())
+	sep := byte('?')
+	write := func(key, value string) {
+		b.WriteByte(sep)
+		b.WriteString(key + "=" + strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+		sep = '&'
+	}
+	if r.Amount != nil {
+		write("amount", formatBIP21Amount(r.Amount))
+	}
+	if r.Label != "" {
+		write("label", r.Label)
+	}
+	if r.Message != "" {
+		write("message", r.Message)
+	}
+	keys := make([]string, 0, len(r.Params))
+	for k := range r.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		write(k, r.Params[k])
+	}
+	return b.String()
+}
+
+// parseBIP21Amount parses a decimal BTC amount, e.g. "50.00005", into satoshis.
+func parseBIP21Amount(s string) (*big.Int, error) {
+	if strings.HasPrefix(s, "-") {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	return parseDecimalAmount(s, satoshiDecimals)
+}
+
+// formatBIP21Amount formats satoshis as a decimal BTC amount without trailing zeros.
+func formatBIP21Amount(sats *big.Int) string {
+	return formatDecimalAmount(sats, satoshiDecimals)
+}
@@ -0,0 +1,79 @@
+package caip10
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBIP21(t *testing.T) {
+	r, err := ParseBIP21("bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=50.00005&label=Luke-Jr&message=Donation%20for%20project%20xyz")
+	require.NoError(t, err)
+	assert.Equal(t, BitcoinMainnet, r.Address.Network())
+	assert.Equal(t, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", r.Address.Address())
+	assert.Equal(t, "5000005000", r.Amount.String())
+	assert.Equal(t, "Luke-Jr", r.Label)
+	assert.Equal(t, "Donation for project xyz", r.Message)
+	assert.Nil(t, r.Params)
+
+	r, err = ParseBIP21("BITCOIN:TB1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KXPJZSX?lightning=lnbc1")
+	require.NoError(t, err)
+	assert.Equal(t, BitcoinTestnet, r.Address.Network())
+	assert.Equal(t, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", r.Address.Address())
+	assert.Nil(t, r.Amount)
+	assert.Equal(t, map[string]string{"lightning": "lnbc1"}, r.Params)
+
+	r, err = ParseBIP21("bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+	require.NoError(t, err)
+	assert.Equal(t, "bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", r.Address.String())
+}
+
+func TestBIP21String(t *testing.T) {
+	r := NewBIP21Payment(NewBitcoinMainnet("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"), big.NewInt(100000))
+	r.Label = "Coffee shop"
+	r.Params = map[string]string{"z": "1", "lightning": "lnbc1"}
+	uri := r.String()
+	assert.Equal(t, "bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4?amount=0.001&label=Coffee%20shop&lightning=lnbc1&z=1", uri)
+
+	back, err := ParseBIP21(uri)
+	require.NoError(t, err)
+	assert.Equal(t, r, back)
+
+	assert.Equal(t, "bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=50",
+		NewBIP21Payment(NewBitcoinMainnet("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"), big.NewInt(5000000000)).String())
+	assert.Equal(t, "", (*BIP21Request)(nil).String())
+}
+
+func TestParseBIP21Errors(t *testing.T) {
+	tests := []struct {
+		input    string
+		sentinel error
+	}{
+		{"", ErrEmptyValue},
+		{"ethereum:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", ErrInvalidFormat},
+		{"bitcoin:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ErrInvalidAddress},
+		{"bitcoin:LM2WMpR1Rp6j3Sa59cMXMs1SPzj9eXpGc1", ErrInvalidAddress},
+		{"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=1.123456789", ErrInvalidFormat},
+		{"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=1e5", ErrInvalidFormat},
+		{"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?req-somethingyoudontunderstand=50", ErrInvalidFormat},
+		{"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?label", ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		_, err := ParseBIP21(tt.input)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+	}
+}
+
+func TestBIP21Amount(t *testing.T) {
+	for in, want := range map[string]string{"1": "100000000", "0.00000001": "1", ".5": "50000000", "20.3": "2030000000"} {
+		n, err := parseBIP21Amount(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, n.String(), in)
+	}
+	assert.Equal(t, "0.00000001", formatBIP21Amount(big.NewInt(1)))
+	assert.Equal(t, "0", formatBIP21Amount(big.NewInt(0)))
+	assert.Equal(t, "20.3", formatBIP21Amount(big.NewInt(2030000000)))
+}
@@ -0,0 +1,202 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BitcoinCashPrefix is the CashAddr prefix of Bitcoin Cash mainnet addresses.
+const BitcoinCashPrefix = "bitcoincash"
+
+// CashAddrType is the address type encoded in the CashAddr version byte.
+type CashAddrType byte
+
+// CashAddr address types
+const (
+	CashAddrP2PKH CashAddrType = 0 // pay to public key hash, "q..."
+	CashAddrP2SH  CashAddrType = 1 // pay to script hash, "p..."
+)
+
+// String returns the name of the address type.
+func (t CashAddrType) String() string {
+	switch t {
+	case CashAddrP2PKH:
+		return "p2pkh"
+	case CashAddrP2SH:
+		return "p2sh"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(t))
+	}
+}
+
+// cashAddrHashSizes maps the size bits of the version byte to the hash length in bytes.
+var cashAddrHashSizes = [8]int{20, 24, 28, 32, 40, 48, 56, 64}
+
+// legacy base58check versions of Bitcoin Cash addresses, indexed by CashAddrType
+var bitcoinCashLegacyVersions = [2]byte{0x00, 0x05}
+
+// DecodeCashAddr decodes a CashAddr address into its lower case prefix, address type
+// and hash. The prefix may be omitted, in which case BitcoinCashPrefix is assumed.
+//
+// Validation steps:
+//  1. Not mixed case
+//  2. 40-bit polymod checksum matches
+//  3. Version byte has a known type and the hash length matches its size bits
+//
+// https://github.com/bitcoincashorg/bitcoincash.org/blob/master/spec/cashaddr.md
+func DecodeCashAddr(address string) (prefix string, typ CashAddrType, hash []byte, err error) {
+	if !strings.Contains(address, ":") {
+		if address != "" && strings.ToUpper(address) == address {
+			address = strings.ToUpper(BitcoinCashPrefix) + ":" + address
+		} else {
+			address = BitcoinCashPrefix + ":" + address
+		}
+	}
+	prefix, data, err := decodeCashAddr(address)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	payload, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(payload) == 0 {
+		return "", 0, nil, fmt.Errorf("%w: empty cashaddr payload", ErrInvalidAddress)
+	}
+	version, hash := payload[0], payload[1:]
+	if version&0x80 != 0 {
+		return "", 0, nil, fmt.Errorf("%w: invalid cashaddr version byte 0x%02x", ErrInvalidAddress, version)
+	}
+	typ = CashAddrType(version >> 3)
+	if typ != CashAddrP2PKH && typ != CashAddrP2SH {
+		return "", 0, nil, fmt.Errorf("%w: unknown cashaddr type %d", ErrInvalidAddress, typ)
+	}
+	if want := cashAddrHashSizes[version&0x07]; len(hash) != want {
+		return "", 0, nil, fmt.Errorf("%w: cashaddr hash must be %d bytes, got %d", ErrInvalidAddress, want, len(hash))
+	}
+	return prefix, typ, hash, nil
+}
+
+// EncodeCashAddr encodes an address type and hash as a prefixed CashAddr address.
+func EncodeCashAddr(prefix string, typ CashAddrType, hash []byte) (string, error) {
+	if typ != CashAddrP2PKH && typ != CashAddrP2SH {
+		return "", fmt.Errorf("%w: unknown cashaddr type %d", ErrInvalidAddress, typ)
+	}
+	size := -1
+	for i, n := range cashAddrHashSizes {
+		if n == len(hash) {
+			size = i
+			break
+		}
+	}
+	if size < 0 {
+		return "", fmt.Errorf("%w: invalid cashaddr hash length %d", ErrInvalidAddress, len(hash))
+	}
+	data, err := convertBits(append([]byte{byte(typ)<<3 | byte(size)}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return encodeCashAddr(strings.ToLower(prefix), data), nil
+}
+
+// ValidateBitcoinCashAddress validates a Bitcoin Cash mainnet address in CashAddr form
+// (with or without the "bitcoincash:" prefix) or legacy base58check form.
+func ValidateBitcoinCashAddress(address string) error {
+	if len(address) == 0 {
+		return fmt.Errorf("%w: empty address", ErrInvalidAddress)
+	}
+	if isLegacyBitcoinCashAddress(address) {
+		return validateBase58CheckVersion(address, bitcoinCashLegacyVersions[:])
+	}
+	prefix, _, _, err := DecodeCashAddr(address)
+	if err != nil {
+		return err
+	}
+	if prefix != BitcoinCashPrefix {
+		return fmt.Errorf("%w: cashaddr prefix must be %q, got %q", ErrInvalidAddress, BitcoinCashPrefix, prefix)
+	}
+	return nil
+}
+
+// BitcoinCashToLegacy converts a Bitcoin Cash CashAddr address to legacy base58check form.
+// Legacy addresses are validated and returned unchanged.
+func BitcoinCashToLegacy(address string) (string, error) {
+	if err := ValidateBitcoinCashAddress(address); err != nil {
+		return "", err
+	}
+	if isLegacyBitcoinCashAddress(address) {
+		return address, nil
+	}
+	_, typ, hash, _ := DecodeCashAddr(address)
+	if len(hash) != base58CheckHash160Length {
+		return "", fmt.Errorf("%w: %d-byte cashaddr hash has no legacy form", ErrInvalidAddress, len(hash))
+	}
+	return encodeBase58Check(append([]byte{bitcoinCashLegacyVersions[typ]}, hash...)), nil
+}
+
+// BitcoinCashToCashAddr converts a legacy Bitcoin Cash address to prefixed CashAddr form.
+// CashAddr addresses are validated and returned in lower case prefixed form.
+func BitcoinCashToCashAddr(address string) (string, error) {
+	if err := ValidateBitcoinCashAddress(address); err != nil {
+		return "", err
+	}
+	if !isLegacyBitcoinCashAddress(address) {
+		_, typ, hash, _ := DecodeCashAddr(address)
+		return EncodeCashAddr(BitcoinCashPrefix, typ, hash)
+	}
+	data, _ := decodeBase58Check(address)
+	typ := CashAddrP2PKH
+	if data[0] == bitcoinCashLegacyVersions[CashAddrP2SH] {
+		typ = CashAddrP2SH
+	}
+	return EncodeCashAddr(BitcoinCashPrefix, typ, data[1:])
+}
+
+// isLegacyBitcoinCashAddress reports whether address looks like a base58check address.
+// CashAddr payloads start with q or p and are 42 characters without prefix.
+func isLegacyBitcoinCashAddress(address string) bool {
+	return (address[0] == '1' || address[0] == '3') && !strings.Contains(address, ":")
+}
+
+// ToCashAddr returns the account with its address in lower case CashAddr form without
+// the "bitcoincash:" prefix, as CAIP-10 addresses cannot contain a colon.
+// Only Bitcoin Cash mainnet accounts can be converted.
+func (a *bip122AccountID) ToCashAddr() (BIP122AccountID, error) {
+	if a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	if a.network != BitcoinCashMainnet {
+		return nil, fmt.Errorf("%w: cashaddr requires network %s, got %s", ErrInvalidReference, BitcoinCashMainnet, a.network)
+	}
+	address, err := BitcoinCashToCashAddr(a.Address())
+	if err != nil {
+		return nil, err
+	}
+	return NewBIP122(a.network, strings.TrimPrefix(address, BitcoinCashPrefix+":")), nil
+}
+
+// ToLegacy returns the account with its address in legacy base58check form.
+// Only Bitcoin Cash mainnet accounts can be converted.
+func (a *bip122AccountID) ToLegacy() (BIP122AccountID, error) {
+	if a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	if a.network != BitcoinCashMainnet {
+		return nil, fmt.Errorf("%w: legacy conversion requires network %s, got %s", ErrInvalidReference, BitcoinCashMainnet, a.network)
+	}
+	address, err := BitcoinCashToLegacy(a.Address())
+	if err != nil {
+		return nil, err
+	}
+	return NewBIP122(a.network, address), nil
+}
+
+// ToCashAddr returns a with its address in unprefixed CashAddr form.
+func ToCashAddr(a BIP122AccountID) (BIP122AccountID, error) {
+	return bip122Native(a).ToCashAddr()
+}
+
+// ToLegacy returns a with its address in legacy base58check form.
+func ToLegacy(a BIP122AccountID) (BIP122AccountID, error) {
+	return bip122Native(a).ToLegacy()
+}
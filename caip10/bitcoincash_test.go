@@ -0,0 +1,105 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from the CashAddr specification.
+const (
+	testBCHLegacyP2PKH   = "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu"
+	testBCHCashAddrP2PKH = "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"
+	testBCHLegacyP2SH    = "3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC"
+	testBCHCashAddrP2SH  = "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"
+)
+
+func TestDecodeCashAddr(t *testing.T) {
+	prefix, typ, hash, err := DecodeCashAddr(testBCHCashAddrP2PKH)
+	require.NoError(t, err)
+	assert.Equal(t, BitcoinCashPrefix, prefix)
+	assert.Equal(t, CashAddrP2PKH, typ)
+	assert.Equal(t, "76a04053bda0a88bda5177b86a15c3b29f559873", hex.EncodeToString(hash))
+
+	// prefix is optional, upper case is accepted
+	_, typ, hash2, err := DecodeCashAddr("PPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVN0H829PQ")
+	require.NoError(t, err)
+	assert.Equal(t, CashAddrP2SH, typ)
+	assert.Equal(t, hash, hash2)
+
+	tests := []struct {
+		name    string
+		address string
+	}{
+		{"bad checksum", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b"},
+		{"mixed case", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdX6a"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := DecodeCashAddr(tt.address)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
+
+func TestEncodeCashAddr(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	got, err := EncodeCashAddr(BitcoinCashPrefix, CashAddrP2SH, hash)
+	require.NoError(t, err)
+	assert.Equal(t, testBCHCashAddrP2SH, got)
+
+	_, err = EncodeCashAddr(BitcoinCashPrefix, CashAddrP2PKH, hash[:19])
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = EncodeCashAddr(BitcoinCashPrefix, CashAddrType(2), hash)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestBitcoinCashConversion(t *testing.T) {
+	legacy, err := BitcoinCashToLegacy(testBCHCashAddrP2PKH)
+	require.NoError(t, err)
+	assert.Equal(t, testBCHLegacyP2PKH, legacy)
+
+	legacy, err = BitcoinCashToLegacy(testBCHCashAddrP2SH[len("bitcoincash:"):])
+	require.NoError(t, err)
+	assert.Equal(t, testBCHLegacyP2SH, legacy)
+
+	cash, err := BitcoinCashToCashAddr(testBCHLegacyP2SH)
+	require.NoError(t, err)
+	assert.Equal(t, testBCHCashAddrP2SH, cash)
+
+	_, err = BitcoinCashToCashAddr("mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestValidateBitcoinCashAddress(t *testing.T) {
+	for _, address := range []string{testBCHLegacyP2PKH, testBCHCashAddrP2PKH, testBCHCashAddrP2SH[len("bitcoincash:"):]} {
+		assert.NoError(t, ValidateBIP122Address(BitcoinCashMainnet, address), address)
+	}
+
+	err := ValidateBIP122Address(BitcoinCashMainnet, "bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	// matches the character class but has a bad checksum
+	err = ValidateBIP122Address(BitcoinCashMainnet, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestBIP122BitcoinCashAccount(t *testing.T) {
+	a := NewBitcoinCashMainnet(testBCHLegacyP2PKH)
+	cash, err := ToCashAddr(a)
+	require.NoError(t, err)
+	assert.Equal(t, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", cash.Address())
+	require.NoError(t, cash.Validate())
+
+	legacy, err := ToLegacy(cash)
+	require.NoError(t, err)
+	assert.True(t, a.Equal(legacy))
+
+	_, err = ToCashAddr(NewBitcoinMainnet(testBCHLegacyP2PKH))
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	_, err = (*bip122AccountID)(nil).ToLegacy()
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
@@ -0,0 +1,232 @@
+package caip10
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+// BlockID identifies a block on a chain by its hash or height.
+// Format: namespace:reference:block, e.g.
+// "eip155:1:0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6"
+// or "eip155:1:19000000".
+type BlockID struct {
+	ChainID ChainID
+	Block   string // block hash, or decimal height
+}
+
+// NewBlockID creates a BlockID from a block hash or decimal height. Hex hashes
+// are lower cased.
+//
+// Hashes are validated per namespace:
+//   - eip155: 0x followed by 64 hex characters
+//   - bip122: 64 hex characters
+//   - solana: base58 encoding of 32 bytes
+//   - others: CAIP-10 address characters, [-.%a-zA-Z0-9]{1,128}
+func NewBlockID(chainID ChainID, block string) (BlockID, error) {
+	if err := chainID.Validate(); err != nil {
+		return BlockID{}, err
+	}
+	block = normalizeBlock(chainID.Namespace, block)
+	if err := validateBlock(chainID.Namespace, block); err != nil {
+		return BlockID{}, err
+	}
+	return BlockID{ChainID: chainID, Block: block}, nil
+}
+
+// NewBlockIDFromHeight creates a BlockID for the block at height. The chain ID
+// is not validated.
+func NewBlockIDFromHeight(chainID ChainID, height uint64) BlockID {
+	return BlockID{ChainID: chainID, Block: strconv.FormatUint(height, 10)}
+}
+
+// ParseBlockID parses a BlockID string.
+func ParseBlockID(s string) (BlockID, error) {
+	ns, reference, block, err := SplitCAIP10(s)
+	if err != nil {
+		return BlockID{}, err
+	}
+	return NewBlockID(ChainID{Namespace: ns, Reference: reference}, block)
+}
+
+// MustParseBlockID parses a BlockID string and panics if invalid.
+func MustParseBlockID(s string) BlockID {
+	b, err := ParseBlockID(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// ToBlockID creates a BlockID on this chain, see NewBlockID.
+func (c ChainID) ToBlockID(block string) (BlockID, error) {
+	return NewBlockID(c, block)
+}
+
+// IsZero reports whether the BlockID is the zero value.
+func (b BlockID) IsZero() bool {
+	return b.ChainID.IsZero() && b.Block == ""
+}
+
+// Equal reports whether two BlockIDs are equal.
+func (b BlockID) Equal(other BlockID) bool {
+	return b.ChainID.Equal(other.ChainID) && b.Block == other.Block
+}
+
+// Validate checks if the BlockID is valid.
+func (b BlockID) Validate() error {
+	if b.IsZero() {
+		return ErrEmptyValue
+	}
+	if err := b.ChainID.Validate(); err != nil {
+		return err
+	}
+	return validateBlock(b.ChainID.Namespace, b.Block)
+}
+
+// Height returns the block height, if the block is identified by height.
+func (b BlockID) Height() (uint64, bool) {
+	if !isDecimal(b.Block) {
+		return 0, false
+	}
+	h, err := strconv.ParseUint(b.Block, 10, 64)
+	return h, err == nil
+}
+
+// IsHeight reports whether the block is identified by height rather than hash.
+func (b BlockID) IsHeight() bool {
+	_, ok := b.Height()
+	return ok
+}
+
+func (b BlockID) String() string {
+	if b.IsZero() {
+		return ""
+	}
+	return b.ChainID.String() + ":" + b.Block
+}
+
+// normalizeBlock lower cases hex block hashes.
+func normalizeBlock(ns Namespace, block string) string {
+	switch ns {
+	case NamespaceEIP155:
+		if len(block) > 2 && (block[:2] == "0x" || block[:2] == "0X") {
+			return "0x" + strings.ToLower(block[2:])
+		}
+	case NamespaceBIP122:
+		return strings.ToLower(block)
+	}
+	return block
+}
+
+// validateBlock validates a block hash or height per namespace rules.
+func validateBlock(ns Namespace, block string) error {
+	if block == "" {
+		return fmt.Errorf("%w: empty block", ErrInvalidBlock)
+	}
+	if isDecimal(block) {
+		if len(block) > 1 && block[0] == '0' {
+			return fmt.Errorf("%w: height %q has leading zeros", ErrInvalidBlock, block)
+		}
+		if _, err := strconv.ParseUint(block, 10, 64); err != nil {
+			return fmt.Errorf("%w: height %q out of range", ErrInvalidBlock, block)
+		}
+		return nil
+	}
+	switch ns {
+	case NamespaceEIP155:
+		if len(block) != 66 || block[:2] != "0x" || !isHex(block[2:]) {
+			return fmt.Errorf("%w: eip155 block hash must be 0x followed by 64 hex characters, got %q", ErrInvalidBlock, block)
+		}
+	case NamespaceBIP122:
+		if len(block) != 64 || !isHex(block) {
+			return fmt.Errorf("%w: bip122 block hash must be 64 hex characters, got %q", ErrInvalidBlock, block)
+		}
+	case NamespaceSolana:
+		if decoded, err := base58.Decode(block); err != nil || len(decoded) != 32 {
+			return fmt.Errorf("%w: solana block hash must be base58 of 32 bytes, got %q", ErrInvalidBlock, block)
+		}
+	default:
+		if !AddressRegex.MatchString(block) {
+			return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,128}, got %q", ErrInvalidBlock, block)
+		}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BlockID) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BlockID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = BlockID{}
+		return nil
+	}
+	parsed, err := ParseBlockID(string(text))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b BlockID) MarshalBinary() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *BlockID) UnmarshalBinary(data []byte) error {
+	return b.UnmarshalText(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BlockID) MarshalJSON() ([]byte, error) {
+	if b.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = BlockID{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid JSON string for BlockID")
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer.
+func (b BlockID) Value() (driver.Value, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner.
+func (b *BlockID) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return b.UnmarshalText([]byte(v))
+	case []byte:
+		return b.UnmarshalText(v)
+	case nil:
+		*b = BlockID{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into BlockID", src)
+	}
+}
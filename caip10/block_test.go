@@ -0,0 +1,105 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEthBlockHash = "0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6"
+
+func TestParseBlockID(t *testing.T) {
+	valid := []string{
+		"eip155:1:" + testEthBlockHash,
+		"eip155:1:19000000",
+		"eip155:137:0",
+		"bip122:000000000019d6689c085ae165831e93:000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:4sGjMW1sUnHzSxGspuhpqLDx6wiyjNtZAMdL4VZHirAn",
+		"polkadot:91b171bb158e2d3848fa23a9f1c25182:ABCDEF0123",
+	}
+	for _, s := range valid {
+		b, err := ParseBlockID(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, s, b.String())
+		assert.NoError(t, b.Validate())
+	}
+
+	b := MustParseBlockID("eip155:1:0x88E96D4537BEA4D9C05D12549907B32561D3BF31F45AAE734CDC119F13406CB6")
+	assert.Equal(t, testEthBlockHash, b.Block, "hex hashes are lower cased")
+	assert.Equal(t, ChainIDEthereumMainnet, b.ChainID)
+	assert.False(t, b.IsHeight())
+
+	h := MustParseBlockID("eip155:1:19000000")
+	height, ok := h.Height()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(19000000), height)
+	assert.Equal(t, h, NewBlockIDFromHeight(ChainIDEthereumMainnet, 19000000))
+
+	c, err := ChainIDEthereumMainnet.ToBlockID(testEthBlockHash)
+	require.NoError(t, err)
+	assert.True(t, c.Equal(b))
+}
+
+func TestParseBlockIDErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		sentinel error
+	}{
+		{"", ErrEmptyValue},
+		{"eip155:1", ErrInvalidFormat},
+		{"eip155:x:19000000", ErrInvalidReference},
+		{"eip155:1:", ErrInvalidBlock},
+		{"eip155:1:0x1234", ErrInvalidBlock},
+		{"eip155:1:007", ErrInvalidBlock},
+		{"eip155:1:99999999999999999999", ErrInvalidBlock},
+		{"bip122:000000000019d6689c085ae165831e93:0x00", ErrInvalidBlock},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:0OIl", ErrInvalidBlock},
+		{"polkadot:91b171bb158e2d3848fa23a9f1c25182:a/b", ErrInvalidBlock},
+	}
+	for _, tt := range tests {
+		_, err := ParseBlockID(tt.input)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+	}
+	assert.Equal(t, CodeInvalidBlock, ErrorCode(ErrInvalidBlock))
+	assert.True(t, errors.Is(BlockID{}.Validate(), ErrEmptyValue))
+}
+
+func TestBlockIDSerialization(t *testing.T) {
+	b := MustParseBlockID("eip155:1:" + testEthBlockHash)
+
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, `"eip155:1:`+testEthBlockHash+`"`, string(data))
+	var fromJSON BlockID
+	require.NoError(t, json.Unmarshal(data, &fromJSON))
+	assert.Equal(t, b, fromJSON)
+	require.NoError(t, json.Unmarshal([]byte("null"), &fromJSON))
+	assert.True(t, fromJSON.IsZero())
+	assert.Error(t, json.Unmarshal([]byte("1"), &fromJSON))
+
+	bin, err := b.MarshalBinary()
+	require.NoError(t, err)
+	var fromBinary BlockID
+	require.NoError(t, fromBinary.UnmarshalBinary(bin))
+	assert.Equal(t, b, fromBinary)
+
+	v, err := b.Value()
+	require.NoError(t, err)
+	var scanned BlockID
+	require.NoError(t, scanned.Scan(v))
+	assert.Equal(t, b, scanned)
+	require.NoError(t, scanned.Scan([]byte(b.String())))
+	assert.Equal(t, b, scanned)
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+	assert.Error(t, scanned.Scan(1))
+
+	v, err = BlockID{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	data, _ = json.Marshal(BlockID{})
+	assert.Equal(t, `""`, string(data))
+}
@@ -0,0 +1,87 @@
+package caip10
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// BSON support for MongoDB. Accounts and chain IDs are stored as strings; zero
+// values are stored as null. To store an account as a {namespace, reference,
+// address} subdocument instead, store a.ToColumns(); UnmarshalBSONValue reads
+// both forms.
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (a *GenericAccountID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if a.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.String, bsoncore.AppendString(nil, a.String()), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (a *GenericAccountID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	switch t {
+	case bsontype.Null, bsontype.Undefined:
+		*a = GenericAccountID{}
+		return nil
+	case bsontype.EmbeddedDocument:
+		var c AccountIDColumns
+		if err := bson.Unmarshal(data, &c); err != nil {
+			return fmt.Errorf("%w: bson: %v", ErrInvalidFormat, err)
+		}
+		if c.IsZero() {
+			*a = GenericAccountID{}
+			return nil
+		}
+		parsed, err := NewGeneric(Namespace(c.Namespace), c.Reference, c.Address)
+		if err != nil {
+			return err
+		}
+		*a = *parsed
+		return nil
+	}
+	s, err := bsonString(t, data)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*a = GenericAccountID{}
+		return nil
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (c ChainID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if c.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.String, bsoncore.AppendString(nil, c.String()), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (c *ChainID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.Null || t == bsontype.Undefined {
+		*c = ChainID{}
+		return nil
+	}
+	s, err := bsonString(t, data)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+func bsonString(t bsontype.Type, data []byte) (string, error) {
+	if t != bsontype.String {
+		return "", fmt.Errorf("%w: bson: expected string, got %s", ErrInvalidFormat, t)
+	}
+	s, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return "", fmt.Errorf("%w: bson: malformed string", ErrInvalidFormat)
+	}
+	return s, nil
+}
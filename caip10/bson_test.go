@@ -0,0 +1,84 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type bsonWallet struct {
+	Owner *GenericAccountID `bson:"owner"`
+	Chain ChainID           `bson:"chain"`
+}
+
+func TestBSONString(t *testing.T) {
+	in := bsonWallet{
+		Owner: MustNewGeneric("eip155", "1", "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"),
+		Chain: ChainIDPolygon,
+	}
+	data, err := bson.Marshal(in)
+	require.NoError(t, err)
+
+	var raw bson.M
+	require.NoError(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", raw["owner"])
+	assert.Equal(t, "eip155:137", raw["chain"])
+
+	var out bsonWallet
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.True(t, in.Owner.Equal(out.Owner))
+	assert.Equal(t, in.Chain, out.Chain)
+}
+
+func TestBSONSubdocument(t *testing.T) {
+	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	data, err := bson.Marshal(bson.M{"owner": a.ToColumns()})
+	require.NoError(t, err)
+
+	var raw struct {
+		Owner bson.M `bson:"owner"`
+	}
+	require.NoError(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, bson.M{"namespace": "cosmos", "reference": "cosmoshub-3", "address": "cosmos1abc"}, raw.Owner)
+
+	var out bsonWallet
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.True(t, a.Equal(out.Owner))
+}
+
+func TestBSONZero(t *testing.T) {
+	data, err := bson.Marshal(bsonWallet{Owner: &GenericAccountID{}})
+	require.NoError(t, err)
+
+	var raw bson.M
+	require.NoError(t, bson.Unmarshal(data, &raw))
+	assert.Nil(t, raw["owner"])
+	assert.Nil(t, raw["chain"])
+
+	var out bsonWallet
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.True(t, out.Owner.IsZero())
+	assert.True(t, out.Chain.IsZero())
+}
+
+func TestBSONErrors(t *testing.T) {
+	var a GenericAccountID
+	err := a.UnmarshalBSONValue(bsontype.Int32, []byte{1, 0, 0, 0})
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	err = a.UnmarshalBSONValue(bsontype.String, []byte{0xff})
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+
+	data, err := bson.Marshal(bson.M{"owner": "eip155:1:0x12"})
+	require.NoError(t, err)
+	var out bsonWallet
+	err = bson.Unmarshal(data, &out)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	data, err = bson.Marshal(bson.M{"chain": "eip155"})
+	require.NoError(t, err)
+	assert.Error(t, bson.Unmarshal(data, &out))
+}
@@ -0,0 +1,12 @@
+//go:build !caip10_optin
+
+package caip10
+
+// The eip155 and solana parsers are registered by this package for
+// compatibility with callers that do not import caip10/eip155reg and
+// caip10/solanareg. This registration is deprecated and will be removed in the
+// next major version; build with the caip10_optin tag to disable it now.
+func init() {
+	RegisterParser(NewEIP155Parser())
+	RegisterParser(NewSolanaParser())
+}
@@ -0,0 +1,124 @@
+package caip10
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CachingParser memoizes successful Parse results in a fixed-size LRU cache, for
+// workloads that parse the same inputs repeatedly, e.g. an indexer seeing the same
+// few thousand addresses. Failed parses are not cached. It is safe for concurrent use.
+//
+// Cached accounts are shared between callers and must not be modified, e.g. with
+// UnmarshalText or Scan.
+type CachingParser struct {
+	opts []ParseOption
+
+	mu        sync.Mutex
+	capacity  int
+	ll        *list.List // front is most recently used
+	items     map[string]*list.Element
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+type cacheEntry struct {
+	input   string
+	account AccountID
+}
+
+// CacheStats reports the counters of a CachingParser.
+type CacheStats struct {
+	Hits      uint64 // lookups served from the cache
+	Misses    uint64 // lookups that called Parse
+	Evictions uint64 // entries dropped to stay within capacity
+	Len       int    // entries currently cached
+	Capacity  int    // maximum number of entries
+}
+
+// HitRate returns the fraction of lookups served from the cache, or 0 if there were none.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// NewCachingParser creates a CachingParser holding up to size entries. opts are
+// passed to every Parse call; the default ValidationPolicy is read when an input
+// is first parsed. A size below 1 is treated as 1.
+func NewCachingParser(size int, opts ...ParseOption) *CachingParser {
+	size = max(size, 1)
+	return &CachingParser{
+		opts:     opts,
+		capacity: size,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element, size),
+	}
+}
+
+// Parse returns the cached account for s, parsing and caching it on a miss.
+func (c *CachingParser) Parse(s string) (AccountID, error) {
+	c.mu.Lock()
+	if e, ok := c.items[s]; ok {
+		c.ll.MoveToFront(e)
+		c.hits++
+		c.mu.Unlock()
+		return e.Value.(*cacheEntry).account, nil
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	a, err := Parse(s, c.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[s]; ok {
+		// parsed concurrently by another caller
+		c.ll.MoveToFront(e)
+		return e.Value.(*cacheEntry).account, nil
+	}
+	c.items[s] = c.ll.PushFront(&cacheEntry{input: s, account: a})
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).input)
+		c.evictions++
+	}
+	return a, nil
+}
+
+// MustParse is like Parse but panics on error.
+func (c *CachingParser) MustParse(s string) AccountID {
+	a, err := c.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *CachingParser) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Len:       c.ll.Len(),
+		Capacity:  c.capacity,
+	}
+}
+
+// Purge removes all cached entries. Counters are kept.
+func (c *CachingParser) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	clear(c.items)
+}
@@ -0,0 +1,79 @@
+package caip10
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingParser(t *testing.T) {
+	const evm = "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	c := NewCachingParser(2)
+
+	a, err := c.Parse(evm)
+	require.NoError(t, err)
+	b, err := c.Parse(evm)
+	require.NoError(t, err)
+	assert.Same(t, a.(*eip155AccountID), b.(*eip155AccountID), "second parse is served from the cache")
+
+	_, err = c.Parse("not-caip10")
+	assert.Error(t, err)
+	_, err = c.Parse("not-caip10")
+	assert.Error(t, err)
+
+	stats := c.Stats()
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 3, Len: 1, Capacity: 2}, stats)
+	assert.Equal(t, 0.25, stats.HitRate())
+}
+
+func TestCachingParserEviction(t *testing.T) {
+	c := NewCachingParser(2)
+	in := func(i int) string { return fmt.Sprintf("foo:bar:addr%d", i) }
+
+	c.MustParse(in(1))
+	c.MustParse(in(2))
+	c.MustParse(in(1)) // 1 is now most recently used
+	c.MustParse(in(3)) // evicts 2
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.Evictions)
+	assert.Equal(t, 2, stats.Len)
+
+	c.MustParse(in(1))
+	assert.Equal(t, uint64(2), c.Stats().Hits, "1 is still cached")
+	c.MustParse(in(2))
+	assert.Equal(t, uint64(4), c.Stats().Misses, "2 was evicted")
+
+	c.Purge()
+	assert.Equal(t, 0, c.Stats().Len)
+	assert.Panics(t, func() { c.MustParse("bad") })
+}
+
+func TestCachingParserOptions(t *testing.T) {
+	c := NewCachingParser(0, WithAllowedNamespaces(NamespaceEIP155))
+	assert.Equal(t, 1, c.Stats().Capacity)
+	_, err := c.Parse("foo:bar:baz")
+	assert.Error(t, err)
+}
+
+func TestCachingParserConcurrent(t *testing.T) {
+	c := NewCachingParser(8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, err := c.Parse(fmt.Sprintf("foo:bar:addr%d", (i+j)%16))
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	stats := c.Stats()
+	assert.Equal(t, uint64(800), stats.Hits+stats.Misses)
+	assert.LessOrEqual(t, stats.Len, 8)
+}
@@ -0,0 +1,111 @@
+// Package caip10log provides zap fields and zerolog object marshalers for
+// caip10 account IDs, chain IDs and asset IDs. For log/slog, the caip10 types
+// implement slog.LogValuer directly. The package is a separate module, so that
+// importing caip10 does not add zap and zerolog to the build.
+//
+// Accounts are logged as objects with namespace, reference and address fields.
+// Privacy-sensitive deployments can log only the account fingerprint (see
+// caip10.FingerprintOf), which is stable and can be correlated across
+// log lines without revealing the address, per call with Redact or for all
+// calls with SetRedactByDefault.
+package caip10log
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/donutnomad/xchain/caip10"
+)
+
+// Option configures how an account is logged.
+type Option func(*options)
+
+type options struct {
+	redact bool
+	short  bool
+}
+
+// Redact logs only the fingerprint of the account.
+func Redact() Option {
+	return func(o *options) { o.redact = true }
+}
+
+// Unredacted logs the account in full even if SetRedactByDefault is on.
+func Unredacted() Option {
+	return func(o *options) { o.redact = false }
+}
+
+// Short truncates the address as caip10.Format with caip10.DisplayShort does.
+func Short() Option {
+	return func(o *options) { o.short = true }
+}
+
+var redactByDefault atomic.Bool
+
+// SetRedactByDefault sets whether accounts are logged redacted unless
+// Unredacted is given. It is safe for concurrent use.
+func SetRedactByDefault(on bool) {
+	redactByDefault.Store(on)
+}
+
+func newOptions(opts []Option) options {
+	o := options{redact: redactByDefault.Load()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// field is a string field of a logged object.
+type field struct {
+	key, value string
+}
+
+// accountFields returns the fields of an account, none for a zero account.
+func accountFields(a caip10.AccountID, o options) []field {
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	if o.redact {
+		return []field{{"fingerprint", fingerprint(a)}}
+	}
+	address := a.Address()
+	if o.short {
+		address = caip10.Format(a, caip10.DisplayShort)
+	}
+	return []field{
+		{"namespace", string(a.Namespace())},
+		{"reference", a.Reference()},
+		{"address", address},
+	}
+}
+
+// fingerprint formats the account fingerprint as 16 hex digits.
+func fingerprint(a caip10.AccountID) string {
+	return fmt.Sprintf("%016x", caip10.FingerprintOf(a))
+}
+
+func chainIDFields(c caip10.ChainID) []field {
+	if c.IsZero() {
+		return nil
+	}
+	return []field{
+		{"namespace", string(c.Namespace)},
+		{"reference", c.Reference},
+	}
+}
+
+func assetFields(a caip10.AssetID) []field {
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	fields := []field{
+		{"chain_id", a.ChainID().String()},
+		{"asset_namespace", string(a.AssetNamespace())},
+		{"asset_reference", a.AssetReference()},
+	}
+	if id := a.AssetTokenID(); id != "" {
+		fields = append(fields, field{"token_id", id})
+	}
+	return fields
+}
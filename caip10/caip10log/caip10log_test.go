@@ -0,0 +1,76 @@
+package caip10log
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/donutnomad/xchain/caip10"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	testAccount = caip10.MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	testAsset   = caip10.MustParseAssetID("eip155:1/erc721:0x06012c8cf97BEaD5deAe237070F9587f8E7A266d/771769")
+)
+
+func zapLogger(buf *bytes.Buffer) *zap.Logger {
+	enc := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg"})
+	return zap.New(zapcore.NewCore(enc, zapcore.AddSync(buf), zapcore.InfoLevel))
+}
+
+func TestZap(t *testing.T) {
+	var buf bytes.Buffer
+	zapLogger(&buf).Info("transfer",
+		Account("from", testAccount),
+		Account("to", testAccount, Short()),
+		Account("owner", testAccount, Redact()),
+		Account("none", nil),
+		ChainID("chain", caip10.ChainIDPolygon),
+		Asset("asset", testAsset),
+	)
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"msg": "transfer",
+		"from": {"namespace": "eip155", "reference": "1", "address": "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		"to": {"namespace": "eip155", "reference": "1", "address": "0xab16…fcdb"},
+		"owner": {"fingerprint": "%016x"},
+		"none": {},
+		"chain": {"namespace": "eip155", "reference": "137"},
+		"asset": {"chain_id": "eip155:1", "asset_namespace": "erc721", "asset_reference": "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "token_id": "771769"}
+	}`, caip10.FingerprintOf(testAccount)), buf.String())
+}
+
+func TestZerolog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Info().
+		Object("from", ZerologAccount(testAccount)).
+		Object("owner", ZerologAccount(testAccount, Redact())).
+		Object("chain", ZerologChainID(caip10.ChainIDEthereumMainnet)).
+		Object("asset", ZerologAsset(caip10.MustParseAssetID("eip155:1/slip44:60"))).
+		Msg("transfer")
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"level": "info",
+		"message": "transfer",
+		"from": {"namespace": "eip155", "reference": "1", "address": "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		"owner": {"fingerprint": "%016x"},
+		"chain": {"namespace": "eip155", "reference": "1"},
+		"asset": {"chain_id": "eip155:1", "asset_namespace": "slip44", "asset_reference": "60"}
+	}`, caip10.FingerprintOf(testAccount)), buf.String())
+}
+
+func TestRedactByDefault(t *testing.T) {
+	SetRedactByDefault(true)
+	defer SetRedactByDefault(false)
+
+	var buf bytes.Buffer
+	zapLogger(&buf).Info("login", Account("user", testAccount), Account("full", testAccount, Unredacted()))
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"msg": "login",
+		"user": {"fingerprint": "%016x"},
+		"full": {"namespace": "eip155", "reference": "1", "address": "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"}
+	}`, caip10.FingerprintOf(testAccount)), buf.String())
+}
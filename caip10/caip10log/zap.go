@@ -0,0 +1,34 @@
+package caip10log
+
+import (
+	"github.com/donutnomad/xchain/caip10"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// zapObject logs fields as a zap object.
+type zapObject []field
+
+func (o zapObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, f := range o {
+		enc.AddString(f.key, f.value)
+	}
+	return nil
+}
+
+// Account returns a zap field that logs an account as an object:
+//
+//	logger.Info("withdrawal", caip10log.Account("to", account, caip10log.Short()))
+func Account(key string, a caip10.AccountID, opts ...Option) zap.Field {
+	return zap.Object(key, zapObject(accountFields(a, newOptions(opts))))
+}
+
+// ChainID returns a zap field that logs a chain ID as an object.
+func ChainID(key string, c caip10.ChainID) zap.Field {
+	return zap.Object(key, zapObject(chainIDFields(c)))
+}
+
+// Asset returns a zap field that logs an asset ID as an object.
+func Asset(key string, a caip10.AssetID) zap.Field {
+	return zap.Object(key, zapObject(assetFields(a)))
+}
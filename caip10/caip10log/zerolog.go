@@ -0,0 +1,32 @@
+package caip10log
+
+import (
+	"github.com/donutnomad/xchain/caip10"
+	"github.com/rs/zerolog"
+)
+
+// zerologObject logs fields as a zerolog object.
+type zerologObject []field
+
+func (o zerologObject) MarshalZerologObject(e *zerolog.Event) {
+	for _, f := range o {
+		e.Str(f.key, f.value)
+	}
+}
+
+// ZerologAccount returns a zerolog object marshaler for an account:
+//
+//	log.Info().Object("to", caip10log.ZerologAccount(account)).Msg("withdrawal")
+func ZerologAccount(a caip10.AccountID, opts ...Option) zerolog.LogObjectMarshaler {
+	return zerologObject(accountFields(a, newOptions(opts)))
+}
+
+// ZerologChainID returns a zerolog object marshaler for a chain ID.
+func ZerologChainID(c caip10.ChainID) zerolog.LogObjectMarshaler {
+	return zerologObject(chainIDFields(c))
+}
+
+// ZerologAsset returns a zerolog object marshaler for an asset ID.
+func ZerologAsset(a caip10.AssetID) zerolog.LogObjectMarshaler {
+	return zerologObject(assetFields(a))
+}
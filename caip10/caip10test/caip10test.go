@@ -0,0 +1,98 @@
+// Package caip10test provides a conformance corpus of CAIP-2 and CAIP-10 test
+// vectors for the caip10 package, its namespace parsers and downstream
+// implementations.
+//
+// A new namespace parser can be checked against the vectors of its namespace:
+//
+//	func TestParser(t *testing.T) {
+//		caip10test.TestParser(t, &myParser{})
+//	}
+package caip10test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/donutnomad/xchain/caip10"
+)
+
+// Namespaces returns the namespaces with account vectors in sorted order,
+// excluding the empty namespace of the syntax vectors.
+func Namespaces() []caip10.Namespace {
+	out := make([]caip10.Namespace, 0, len(Accounts))
+	for ns := range Accounts {
+		if ns != "" {
+			out = append(out, ns)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// TestParser runs the account vectors of p's namespace against p.Parse. Valid
+// vectors must parse, validate and format back to their input; invalid vectors
+// must fail to parse or validate with their error.
+func TestParser(t *testing.T, p caip10.Parser) {
+	t.Helper()
+	vectors, ok := Accounts[p.Namespace()]
+	if !ok {
+		t.Fatalf("caip10test: no vectors for namespace %q", p.Namespace())
+	}
+	runAccounts(t, vectors, p.Parse)
+}
+
+// TestParse runs all account vectors against parse, such as caip10.Parse, as
+// TestParser does.
+func TestParse(t *testing.T, parse func(string) (caip10.AccountID, error)) {
+	t.Helper()
+	runAccounts(t, Accounts[""], parse)
+	for _, ns := range Namespaces() {
+		t.Run(string(ns), func(t *testing.T) {
+			runAccounts(t, Accounts[ns], parse)
+		})
+	}
+}
+
+// TestParseChainID runs the chain ID vectors against parse, such as
+// caip10.ParseChainID.
+func TestParseChainID(t *testing.T, parse func(string) (caip10.ChainID, error)) {
+	t.Helper()
+	for _, v := range ChainIDs {
+		t.Run(v.Name, func(t *testing.T) {
+			c, err := parse(v.Input)
+			checkErr(t, v.Input, err, v.Err)
+			if err == nil && c.String() != v.Input {
+				t.Errorf("%q: String() = %q", v.Input, c.String())
+			}
+		})
+	}
+}
+
+func runAccounts(t *testing.T, vectors []AccountVector, parse func(string) (caip10.AccountID, error)) {
+	t.Helper()
+	for _, v := range vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			a, err := parse(v.Input)
+			if err == nil {
+				err = a.Validate()
+			}
+			checkErr(t, v.Input, err, v.Err)
+			if err == nil && a.String() != v.Input {
+				t.Errorf("%q: String() = %q", v.Input, a.String())
+			}
+		})
+	}
+}
+
+func checkErr(t *testing.T, input string, err, want error) {
+	t.Helper()
+	switch {
+	case want == nil && err != nil:
+		t.Errorf("%q: unexpected error: %v", input, err)
+	case want != nil && err == nil:
+		t.Errorf("%q: expected error wrapping %v", input, want)
+	case want != nil && !errors.Is(err, want):
+		t.Errorf("%q: error %v does not wrap %v", input, err, want)
+	}
+}
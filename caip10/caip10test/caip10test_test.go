@@ -0,0 +1,23 @@
+package caip10test
+
+import (
+	"testing"
+
+	"github.com/donutnomad/xchain/caip10"
+)
+
+func TestVectors(t *testing.T) {
+	TestParse(t, func(s string) (caip10.AccountID, error) { return caip10.Parse(s) })
+	TestParseChainID(t, caip10.ParseChainID)
+}
+
+func TestRegisteredParsers(t *testing.T) {
+	for _, p := range caip10.DefaultRegistry().Parsers() {
+		t.Run(string(p.Namespace()), func(t *testing.T) {
+			if _, ok := Accounts[p.Namespace()]; !ok {
+				t.Skipf("no vectors for %q", p.Namespace())
+			}
+			TestParser(t, p)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package caip10test
+
+import "github.com/donutnomad/xchain/caip10"
+
+// AccountVector is a CAIP-10 account ID test vector.
+type AccountVector struct {
+	// Name describes the case.
+	Name string
+	// Input is the CAIP-10 string.
+	Input string
+	// Err is the sentinel error that parsing Input must wrap, nil if Input is valid.
+	Err error
+}
+
+// Valid reports whether the vector is a valid account ID.
+func (v AccountVector) Valid() bool {
+	return v.Err == nil
+}
+
+// ChainIDVector is a CAIP-2 chain ID test vector.
+type ChainIDVector struct {
+	// Name describes the case.
+	Name string
+	// Input is the CAIP-2 string.
+	Input string
+	// Err is the sentinel error that parsing Input must wrap, nil if Input is valid.
+	Err error
+}
+
+// Valid reports whether the vector is a valid chain ID.
+func (v ChainIDVector) Valid() bool {
+	return v.Err == nil
+}
+
+// Accounts holds the account ID vectors of each namespace supported by caip10.
+// Vectors under the empty namespace exercise the CAIP-10 syntax shared by all
+// namespaces.
+var Accounts = map[caip10.Namespace][]AccountVector{
+	"": {
+		{"empty", "", caip10.ErrEmptyValue},
+		{"missing address", "eip155:1", caip10.ErrInvalidFormat},
+		{"unregistered namespace", "cosmos:cosmoshub-4:cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0", nil},
+		{"invalid generic reference", "cosmos:abc!def:addr", caip10.ErrInvalidReference},
+		{"empty generic reference", "cosmos::addr", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceEIP155: {
+		{"checksummed", "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil},
+		{"zero address", "eip155:1:0x0000000000000000000000000000000000000000", nil},
+		{"other chain", "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil},
+		{"short address", "eip155:1:0x123", caip10.ErrInvalidAddress},
+		{"non-numeric chain", "eip155:mainnet:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceSolana: {
+		{"mainnet", "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", nil},
+		{"devnet", "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", nil},
+		{"hex address", "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceBIP122: {
+		{"p2pkh", "bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6", nil},
+		{"p2sh", "bip122:000000000019d6689c085ae165831e93:35PBEaofpUeH8VnnNSorM1QZsadrZoQp4N", nil},
+		{"p2wpkh", "bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", nil},
+		{"p2tr", "bip122:000000000019d6689c085ae165831e93:bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", nil},
+		{"bad checksum", "bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p7", caip10.ErrInvalidChecksum},
+		{"testnet address on mainnet", "bip122:000000000019d6689c085ae165831e93:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespacePolkadot: {
+		{"polkadot", "polkadot:91b171bb158e2d3848fa23a9f1c25182:15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", nil},
+		{"uppercase reference", "polkadot:91B171BB158E2D3848FA23A9F1C25182:15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceStellar: {
+		{"pubnet", "stellar:pubnet:GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ", nil},
+		{"bad checksum", "stellar:pubnet:GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGA", caip10.ErrInvalidAddress},
+		{"unknown network", "stellar:mainnet:GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceNEAR: {
+		{"named account", "near:mainnet:alice.near", nil},
+		{"uppercase", "near:mainnet:Alice.near", caip10.ErrInvalidAddress},
+		{"unknown network", "near:betanet:alice.near", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceTON: {
+		{"raw address", "ton:-239:0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", nil},
+		{"short hash", "ton:-239:0:83dfd552", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceCardano: {
+		{"shelley", "cardano:1-764824073:addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", nil},
+		{"bad checksum", "cardano:1-764824073:addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9", caip10.ErrInvalidChecksum},
+		{"byron", "cardano:1-764824073:Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", nil},
+	},
+	caip10.NamespaceAlgorand: {
+		{"zero key", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", nil},
+		{"bad checksum", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKA", caip10.ErrInvalidChecksum},
+		{"short address", "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k:AAAAAAAA", caip10.ErrInvalidAddress},
+		{"network name", "algorand:mainnet:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceFilecoin: {
+		{"id address", "fil:f:f01024", nil},
+		{"id overflow", "fil:f:f09223372036854775808", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceHedera: {
+		{"account", "hedera:mainnet:0.0.123", nil},
+		{"checksum", "hedera:mainnet:0.0.1234567890-zbhlt", nil},
+		{"negative shard", "hedera:mainnet:0.-1.123", caip10.ErrInvalidAddress},
+		{"unknown network", "hedera:betanet:0.0.123", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceAntelope: {
+		{"account name", "antelope:aca376f206b8fc25a6ed44dbdc66547c:eosio", nil},
+		{"uppercase name", "antelope:aca376f206b8fc25a6ed44dbdc66547c:Eosio", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceStacks: {
+		{"mainnet", "stacks:1:SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", nil},
+		{"bad checksum", "stacks:1:SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ8", caip10.ErrInvalidChecksum},
+	},
+	caip10.NamespaceMultiversX: {
+		{"mainnet", "mvx:1:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", nil},
+		{"bitcoin address", "mvx:1:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceFlow: {
+		{"mainnet", "flow:mainnet:0x1654653399040a61", nil},
+		{"short address", "flow:mainnet:0x1654653399040a6", caip10.ErrInvalidAddress},
+		{"unknown network", "flow:canarynet:0x1654653399040a61", caip10.ErrInvalidReference},
+	},
+	caip10.NamespaceKaspa: {
+		{"mainnet", "kaspa:mainnet:kaspa:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajr", nil},
+		{"bad checksum", "kaspa:mainnet:kaspa:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajq", caip10.ErrInvalidAddress},
+		{"short address", "kaspa:mainnet:kaspa:qqqq", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceICP: {
+		{"anonymous principal", "icp:737ba355e855bd4b61279056603e0550:2vxsx-fae", nil},
+		{"canister", "icp:737ba355e855bd4b61279056603e0550:ryjl3-tyaaa-aaaaa-aaaba-cai", nil},
+		{"bad checksum", "icp:737ba355e855bd4b61279056603e0550:2vxsx-fai", caip10.ErrInvalidChecksum},
+	},
+	caip10.NamespaceTron: {
+		{"mainnet", "tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"bad checksum", "tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", caip10.ErrInvalidChecksum},
+		{"bitcoin address", "tron:0x2b6653dc:1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceSui: {
+		{"mainnet", "sui:mainnet:0x02a212de6a9dfa3a69e22387acfbafbb1a9e591bd9d636e7895dcfc8de05f331", nil},
+		{"short address", "sui:mainnet:0x2", caip10.ErrInvalidAddress},
+	},
+	caip10.NamespaceStarknet: {
+		{"mainnet", "starknet:SN_MAIN:0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7", nil},
+		{"field prime", "starknet:SN_MAIN:0x800000000000011000000000000000000000000000000000000000000000001", caip10.ErrInvalidAddress},
+	},
+}
+
+// ChainIDs holds CAIP-2 chain ID vectors.
+var ChainIDs = []ChainIDVector{
+	{"eip155", "eip155:1", nil},
+	{"eip155 non-numeric", "eip155:mainnet", caip10.ErrInvalidReference},
+	{"solana", "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp", nil},
+	{"bip122", "bip122:000000000019d6689c085ae165831e93", nil},
+	{"bip122 uppercase", "bip122:000000000019D6689C085AE165831E93", caip10.ErrInvalidReference},
+	{"hedera", "hedera:mainnet", nil},
+	{"cardano", "cardano:1-764824073", nil},
+	{"ton", "ton:-239", nil},
+	{"tron", "tron:0x2b6653dc", nil},
+	{"starknet", "starknet:SN_SEPOLIA", nil},
+	{"missing reference", "eip155", caip10.ErrInvalidFormat},
+	{"account ID", "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", caip10.ErrInvalidFormat},
+}
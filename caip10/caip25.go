@@ -0,0 +1,324 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// CAIP-25 session namespaces, as used by WalletConnect v2.
+// https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-25.md
+
+// SessionScope is a CAIP-25 scope object: the chains, methods, events and
+// accounts of one namespace (e.g. "eip155") or one chain (e.g. "eip155:1").
+type SessionScope struct {
+	Chains   []ChainID
+	Methods  []string
+	Events   []string
+	Accounts []AccountID
+}
+
+// sessionScopeJSON is the wire form of SessionScope.
+type sessionScopeJSON struct {
+	Chains   []string `json:"chains,omitempty"`
+	Methods  []string `json:"methods"`
+	Events   []string `json:"events"`
+	Accounts []string `json:"accounts,omitempty"`
+}
+
+// MarshalJSON encodes the scope with chains and accounts as CAIP-2/CAIP-10 strings.
+func (s SessionScope) MarshalJSON() ([]byte, error) {
+	v := sessionScopeJSON{
+		Methods: s.Methods,
+		Events:  s.Events,
+	}
+	if v.Methods == nil {
+		v.Methods = []string{}
+	}
+	if v.Events == nil {
+		v.Events = []string{}
+	}
+	for _, c := range s.Chains {
+		v.Chains = append(v.Chains, c.String())
+	}
+	for _, a := range s.Accounts {
+		if a == nil || a.IsZero() {
+			return nil, fmt.Errorf("%w: empty account in session scope", ErrEmptyValue)
+		}
+		v.Accounts = append(v.Accounts, a.String())
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes a scope, parsing accounts with the registered namespace parsers.
+func (s *SessionScope) UnmarshalJSON(data []byte) error {
+	var v sessionScopeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	scope := SessionScope{Methods: v.Methods, Events: v.Events}
+	for _, cs := range v.Chains {
+		c, err := parseAssetChainID(cs)
+		if err != nil {
+			return err
+		}
+		scope.Chains = append(scope.Chains, c)
+	}
+	for _, as := range v.Accounts {
+		a, err := Parse(as)
+		if err != nil {
+			return err
+		}
+		scope.Accounts = append(scope.Accounts, a)
+	}
+	*s = scope
+	return nil
+}
+
+// Merge returns the union of two scopes. Order is preserved and duplicates are dropped.
+func (s SessionScope) Merge(other SessionScope) SessionScope {
+	var out SessionScope
+	for _, c := range slices.Concat(s.Chains, other.Chains) {
+		if !slices.Contains(out.Chains, c) {
+			out.Chains = append(out.Chains, c)
+		}
+	}
+	out.Methods = mergeStrings(s.Methods, other.Methods)
+	out.Events = mergeStrings(s.Events, other.Events)
+	for _, a := range slices.Concat(s.Accounts, other.Accounts) {
+		if a == nil || a.IsZero() {
+			continue
+		}
+		if !slices.ContainsFunc(out.Accounts, a.Equal) {
+			out.Accounts = append(out.Accounts, a)
+		}
+	}
+	return out
+}
+
+// SupportsChain reports whether the scope lists the chain or holds an account on it.
+func (s SessionScope) SupportsChain(c ChainID) bool {
+	if slices.Contains(s.Chains, c) {
+		return true
+	}
+	return slices.ContainsFunc(s.Accounts, func(a AccountID) bool {
+		return a != nil && a.ChainID() == c
+	})
+}
+
+func mergeStrings(a, b []string) []string {
+	var out []string
+	for _, s := range slices.Concat(a, b) {
+		if !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// SessionNamespaces maps a namespace ("eip155") or chain ID ("eip155:1") to its scope.
+// It models the requiredNamespaces, optionalNamespaces and namespaces objects of CAIP-25.
+type SessionNamespaces map[string]SessionScope
+
+// Keys returns the namespace keys in sorted order.
+func (n SessionNamespaces) Keys() []string {
+	keys := make([]string, 0, len(n))
+	for k := range n {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Merge returns the union of two namespace maps; scopes with the same key are merged.
+func (n SessionNamespaces) Merge(other SessionNamespaces) SessionNamespaces {
+	out := make(SessionNamespaces, len(n)+len(other))
+	for k, s := range n {
+		out[k] = SessionScope{}.Merge(s)
+	}
+	for k, s := range other {
+		out[k] = out[k].Merge(s)
+	}
+	return out
+}
+
+// Validate checks every key and scope.
+//
+// Validation steps:
+//  1. Keys are CAIP-2 namespaces or chain IDs
+//  2. Chain-keyed scopes do not list chains
+//  3. Chains and accounts belong to the scope's namespace or chain
+func (n SessionNamespaces) Validate() error {
+	for _, k := range n.Keys() {
+		scope := n[k]
+		if !strings.Contains(k, ":") {
+			if !NamespaceRegex.MatchString(k) {
+				return fmt.Errorf("%w: session key must match [-a-z0-9]{3,8}, got %q", ErrInvalidNamespace, k)
+			}
+			for _, c := range scope.Chains {
+				if string(c.Namespace) != k {
+					return fmt.Errorf("%w: chain %s does not belong to session namespace %q", ErrInvalidNamespace, c, k)
+				}
+			}
+			for _, a := range scope.Accounts {
+				if a == nil || a.IsZero() {
+					return fmt.Errorf("%w: empty account in session namespace %q", ErrEmptyValue, k)
+				}
+				if string(a.Namespace()) != k {
+					return fmt.Errorf("%w: account %s does not belong to session namespace %q", ErrInvalidNamespace, a, k)
+				}
+			}
+			continue
+		}
+
+		c, err := parseAssetChainID(k)
+		if err != nil {
+			return err
+		}
+		if len(scope.Chains) > 0 {
+			return fmt.Errorf("%w: session scope %q is keyed by chain and must not list chains", ErrInvalidFormat, k)
+		}
+		for _, a := range scope.Accounts {
+			if a == nil || a.IsZero() {
+				return fmt.Errorf("%w: empty account in session scope %q", ErrEmptyValue, k)
+			}
+			if a.ChainID() != c {
+				return fmt.Errorf("%w: account %s does not belong to session scope %q", ErrInvalidReference, a, k)
+			}
+		}
+	}
+	return nil
+}
+
+// Accounts returns all accounts of all scopes, without duplicates, in key order.
+func (n SessionNamespaces) Accounts() []AccountID {
+	var merged SessionScope
+	for _, k := range n.Keys() {
+		merged = merged.Merge(SessionScope{Accounts: n[k].Accounts})
+	}
+	return merged.Accounts
+}
+
+// sessionChain is the permissions granted on a single chain.
+type sessionChain struct {
+	methods  []string
+	events   []string
+	accounts int
+}
+
+// chains flattens the namespaces into per-chain permissions. Methods and events of a
+// namespace-keyed scope apply to every chain it lists or holds accounts on.
+func (n SessionNamespaces) chains() map[ChainID]*sessionChain {
+	out := make(map[ChainID]*sessionChain)
+	get := func(c ChainID) *sessionChain {
+		sc, ok := out[c]
+		if !ok {
+			sc = &sessionChain{}
+			out[c] = sc
+		}
+		return sc
+	}
+	for k, scope := range n {
+		var chains []ChainID
+		if strings.Contains(k, ":") {
+			c, err := parseAssetChainID(k)
+			if err != nil {
+				continue
+			}
+			chains = []ChainID{c}
+		} else {
+			chains = scope.Merge(SessionScope{}).Chains
+			for _, a := range scope.Accounts {
+				if a != nil && !a.IsZero() && !slices.Contains(chains, a.ChainID()) {
+					chains = append(chains, a.ChainID())
+				}
+			}
+		}
+		for _, c := range chains {
+			sc := get(c)
+			sc.methods = mergeStrings(sc.methods, scope.Methods)
+			sc.events = mergeStrings(sc.events, scope.Events)
+		}
+		for _, a := range scope.Accounts {
+			if a != nil && !a.IsZero() {
+				get(a.ChainID()).accounts++
+			}
+		}
+	}
+	return out
+}
+
+// Satisfies reports whether these session namespaces, as approved by a wallet,
+// satisfy the required namespaces of a proposal. Every required chain must have at
+// least one account and every required method and event must be granted on it.
+// Returns nil if satisfied, an error wrapping ErrUnsatisfiedNamespaces otherwise.
+func (n SessionNamespaces) Satisfies(proposal SessionProposal) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+	if err := proposal.RequiredNamespaces.Validate(); err != nil {
+		return err
+	}
+	granted := n.chains()
+
+	check := func(c ChainID, required SessionScope) error {
+		sc, ok := granted[c]
+		if !ok || sc.accounts == 0 {
+			return fmt.Errorf("%w: no account for required chain %s", ErrUnsatisfiedNamespaces, c)
+		}
+		for _, m := range required.Methods {
+			if !slices.Contains(sc.methods, m) {
+				return fmt.Errorf("%w: method %q not granted on %s", ErrUnsatisfiedNamespaces, m, c)
+			}
+		}
+		for _, e := range required.Events {
+			if !slices.Contains(sc.events, e) {
+				return fmt.Errorf("%w: event %q not granted on %s", ErrUnsatisfiedNamespaces, e, c)
+			}
+		}
+		return nil
+	}
+
+	for _, k := range proposal.RequiredNamespaces.Keys() {
+		required := proposal.RequiredNamespaces[k]
+		if strings.Contains(k, ":") {
+			c, _ := parseAssetChainID(k)
+			if err := check(c, required); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(required.Chains) == 0 {
+			return fmt.Errorf("%w: required namespace %q lists no chains", ErrUnsatisfiedNamespaces, k)
+		}
+		for _, c := range required.Chains {
+			if err := check(c, required); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SessionProposal is the namespace part of a CAIP-25 session proposal
+// (WalletConnect v2 wc_sessionPropose params).
+type SessionProposal struct {
+	RequiredNamespaces SessionNamespaces `json:"requiredNamespaces"`
+	OptionalNamespaces SessionNamespaces `json:"optionalNamespaces,omitempty"`
+	SessionProperties  map[string]string `json:"sessionProperties,omitempty"`
+}
+
+// Validate checks the required and optional namespaces.
+func (p SessionProposal) Validate() error {
+	if err := p.RequiredNamespaces.Validate(); err != nil {
+		return err
+	}
+	return p.OptionalNamespaces.Validate()
+}
+
+// Namespaces returns the union of the required and optional namespaces.
+func (p SessionProposal) Namespaces() SessionNamespaces {
+	return p.RequiredNamespaces.Merge(p.OptionalNamespaces)
+}
@@ -0,0 +1,138 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// SessionNamespacesBuilder builds the WalletConnect v2 namespaces object of a
+// proposal or an approved session, grouping chains and accounts under their
+// namespace key:
+//
+//	n, err := NewSessionNamespacesBuilder().
+//		AddChains(ChainIDEthereumMainnet, ChainIDPolygon).
+//		AddMethods(NamespaceEIP155, "eth_sendTransaction", "personal_sign").
+//		AddEvents(NamespaceEIP155, "accountsChanged", "chainChanged").
+//		Build()
+//
+// The first invalid input is reported by Build.
+type SessionNamespacesBuilder struct {
+	keys   []Namespace
+	scopes map[Namespace]*SessionScope
+	err    error
+}
+
+// NewSessionNamespacesBuilder returns an empty builder.
+func NewSessionNamespacesBuilder() *SessionNamespacesBuilder {
+	return &SessionNamespacesBuilder{scopes: make(map[Namespace]*SessionScope)}
+}
+
+// scope returns the scope of a namespace, creating it on first use.
+func (b *SessionNamespacesBuilder) scope(ns Namespace) *SessionScope {
+	s, ok := b.scopes[ns]
+	if !ok {
+		s = &SessionScope{}
+		b.scopes[ns] = s
+		b.keys = append(b.keys, ns)
+	}
+	return s
+}
+
+// AddChains adds chains to the scopes of their namespaces.
+func (b *SessionNamespacesBuilder) AddChains(chains ...ChainID) *SessionNamespacesBuilder {
+	for _, c := range chains {
+		if err := c.Validate(); err != nil {
+			b.setErr(err)
+			continue
+		}
+		s := b.scope(c.Namespace)
+		if !slices.Contains(s.Chains, c) {
+			s.Chains = append(s.Chains, c)
+		}
+	}
+	return b
+}
+
+// AddAccounts adds accounts, and the chains they are on, to the scopes of their
+// namespaces.
+func (b *SessionNamespacesBuilder) AddAccounts(accounts ...AccountID) *SessionNamespacesBuilder {
+	for _, a := range accounts {
+		if a == nil || a.IsZero() {
+			b.setErr(fmt.Errorf("%w: empty account in session namespaces", ErrEmptyValue))
+			continue
+		}
+		b.AddChains(a.ChainID())
+		s := b.scope(a.Namespace())
+		if !slices.ContainsFunc(s.Accounts, a.Equal) {
+			s.Accounts = append(s.Accounts, a)
+		}
+	}
+	return b
+}
+
+// AddMethods grants methods on every chain of a namespace.
+func (b *SessionNamespacesBuilder) AddMethods(ns Namespace, methods ...string) *SessionNamespacesBuilder {
+	s := b.scope(ns)
+	s.Methods = mergeStrings(s.Methods, methods)
+	return b
+}
+
+// AddEvents grants events on every chain of a namespace.
+func (b *SessionNamespacesBuilder) AddEvents(ns Namespace, events ...string) *SessionNamespacesBuilder {
+	s := b.scope(ns)
+	s.Events = mergeStrings(s.Events, events)
+	return b
+}
+
+func (b *SessionNamespacesBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Build returns the namespaces. Every namespace must have at least one chain or
+// account.
+func (b *SessionNamespacesBuilder) Build() (SessionNamespaces, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	out := make(SessionNamespaces, len(b.keys))
+	for _, ns := range b.keys {
+		s := b.scopes[ns]
+		if len(s.Chains) == 0 {
+			return nil, fmt.Errorf("%w: session namespace %q has no chains", ErrInvalidFormat, ns)
+		}
+		out[string(ns)] = SessionScope{}.Merge(*s)
+	}
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParseSessionAccounts returns the accounts of an approved WalletConnect v2
+// session. data is either the namespaces object or a session object holding it
+// in its "namespaces" field, such as the params of wc_sessionSettle.
+func ParseSessionAccounts(data []byte) ([]AccountID, error) {
+	var session struct {
+		Namespaces json.RawMessage `json:"namespaces"`
+	}
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	// "namespaces" is too long to be a CAIP-2 namespace key, so its presence marks
+	// a session object.
+	if len(session.Namespaces) > 0 {
+		data = session.Namespaces
+	}
+
+	var n SessionNamespaces
+	if err := json.Unmarshal(data, &n); err != nil {
+		return nil, err
+	}
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+	return n.Accounts(), nil
+}
@@ -0,0 +1,72 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionNamespacesBuilder(t *testing.T) {
+	eth := MustParse("eip155:10:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+
+	n, err := NewSessionNamespacesBuilder().
+		AddChains(ChainIDEthereumMainnet, ChainIDPolygon, ChainIDEthereumMainnet).
+		AddAccounts(eth, sol, eth).
+		AddMethods(NamespaceEIP155, "eth_sendTransaction", "personal_sign").
+		AddEvents(NamespaceEIP155, "accountsChanged", "chainChanged").
+		AddMethods(NamespaceSolana, "solana_signTransaction").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"eip155", "solana"}, n.Keys())
+	assert.Equal(t, []ChainID{ChainIDEthereumMainnet, ChainIDPolygon, ChainIDOptimism}, n["eip155"].Chains)
+	assert.Equal(t, []AccountID{eth}, n["eip155"].Accounts)
+	assert.Equal(t, []ChainID{ChainIDSolanaMainnet}, n["solana"].Chains)
+
+	data, err := json.Marshal(n)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"eip155": {
+			"chains": ["eip155:1", "eip155:137", "eip155:10"],
+			"methods": ["eth_sendTransaction", "personal_sign"],
+			"events": ["accountsChanged", "chainChanged"],
+			"accounts": ["eip155:10:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"]
+		},
+		"solana": {
+			"chains": ["solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp"],
+			"methods": ["solana_signTransaction"],
+			"events": [],
+			"accounts": ["solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"]
+		}
+	}`, string(data))
+
+	_, err = NewSessionNamespacesBuilder().AddMethods(NamespaceEIP155, "personal_sign").Build()
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = NewSessionNamespacesBuilder().AddAccounts(nil).Build()
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+	_, err = NewSessionNamespacesBuilder().AddChains(ChainID{Namespace: "eip155", Reference: "abc"}).Build()
+	assert.Error(t, err)
+}
+
+func TestParseSessionAccounts(t *testing.T) {
+	accounts, err := ParseSessionAccounts([]byte(testSessionJSON))
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	assert.Equal(t, "cosmos:cosmoshub-4:cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0", accounts[0].String())
+	_, ok := accounts[1].(EIP155AccountID)
+	assert.True(t, ok, "got %T", accounts[1])
+
+	settle := `{"relay": {"protocol": "irn"}, "namespaces": ` + testSessionJSON + `, "expiry": 1700000000}`
+	fromSettle, err := ParseSessionAccounts([]byte(settle))
+	require.NoError(t, err)
+	assert.Equal(t, accounts, fromSettle)
+
+	_, err = ParseSessionAccounts([]byte(`[]`))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ParseSessionAccounts([]byte(`{"solana": {"methods": [], "events": [], "accounts": ["eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"]}}`))
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
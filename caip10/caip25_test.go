@@ -0,0 +1,216 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProposalJSON = `{
+	"requiredNamespaces": {
+		"eip155": {
+			"chains": ["eip155:1", "eip155:137"],
+			"methods": ["eth_sendTransaction", "personal_sign"],
+			"events": ["accountsChanged", "chainChanged"]
+		},
+		"cosmos:cosmoshub-4": {
+			"methods": ["cosmos_signDirect"],
+			"events": []
+		}
+	},
+	"optionalNamespaces": {
+		"eip155": {
+			"chains": ["eip155:10"],
+			"methods": ["eth_signTypedData"],
+			"events": []
+		}
+	},
+	"sessionProperties": {"expiry": "2026-12-31T00:00:00Z"}
+}`
+
+const testSessionJSON = `{
+	"eip155": {
+		"methods": ["eth_sendTransaction", "personal_sign", "eth_signTypedData"],
+		"events": ["accountsChanged", "chainChanged"],
+		"accounts": [
+			"eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb",
+			"eip155:137:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"
+		]
+	},
+	"cosmos:cosmoshub-4": {
+		"methods": ["cosmos_signDirect"],
+		"events": [],
+		"accounts": ["cosmos:cosmoshub-4:cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0"]
+	}
+}`
+
+func TestSessionProposalJSON(t *testing.T) {
+	var p SessionProposal
+	require.NoError(t, json.Unmarshal([]byte(testProposalJSON), &p))
+	require.NoError(t, p.Validate())
+
+	eip155 := p.RequiredNamespaces["eip155"]
+	assert.Equal(t, []ChainID{ChainIDEthereumMainnet, ChainIDPolygon}, eip155.Chains)
+	assert.Equal(t, []string{"eth_sendTransaction", "personal_sign"}, eip155.Methods)
+	assert.Equal(t, []string{"cosmos_signDirect"}, p.RequiredNamespaces["cosmos:cosmoshub-4"].Methods)
+	assert.Equal(t, "2026-12-31T00:00:00Z", p.SessionProperties["expiry"])
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	var back SessionProposal
+	require.NoError(t, json.Unmarshal(data, &back))
+	assert.Equal(t, p, back)
+}
+
+func TestSessionScopeJSON(t *testing.T) {
+	var n SessionNamespaces
+	require.NoError(t, json.Unmarshal([]byte(testSessionJSON), &n))
+
+	accounts := n["eip155"].Accounts
+	require.Len(t, accounts, 2)
+	_, ok := accounts[0].(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", accounts[0])
+	assert.Equal(t, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", accounts[0].String())
+
+	data, err := json.Marshal(SessionScope{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"methods":[],"events":[]}`, string(data))
+
+	var s SessionScope
+	err = json.Unmarshal([]byte(`{"methods":[],"events":[],"accounts":["eip155:1:0x123"]}`), &s)
+	assert.Error(t, err)
+	err = json.Unmarshal([]byte(`{"chains":["eip155"],"methods":[],"events":[]}`), &s)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
+
+func TestSessionScopeMerge(t *testing.T) {
+	a := SessionScope{
+		Chains:   []ChainID{ChainIDEthereumMainnet},
+		Methods:  []string{"personal_sign"},
+		Accounts: []AccountID{MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")},
+	}
+	b := SessionScope{
+		Chains:   []ChainID{ChainIDEthereumMainnet, ChainIDPolygon},
+		Methods:  []string{"personal_sign", "eth_sendTransaction"},
+		Events:   []string{"chainChanged"},
+		Accounts: []AccountID{MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")},
+	}
+
+	m := a.Merge(b)
+	assert.Equal(t, []ChainID{ChainIDEthereumMainnet, ChainIDPolygon}, m.Chains)
+	assert.Equal(t, []string{"personal_sign", "eth_sendTransaction"}, m.Methods)
+	assert.Equal(t, []string{"chainChanged"}, m.Events)
+	assert.Len(t, m.Accounts, 1)
+	assert.True(t, m.SupportsChain(ChainIDPolygon))
+	assert.False(t, m.SupportsChain(ChainIDSolanaMainnet))
+
+	n := SessionNamespaces{"eip155": a}.Merge(SessionNamespaces{"eip155": b, "solana": {}})
+	assert.Equal(t, []string{"eip155", "solana"}, n.Keys())
+	assert.Equal(t, m, n["eip155"])
+	assert.Len(t, a.Chains, 1, "merge must not modify its receiver")
+}
+
+func TestSessionNamespacesValidate(t *testing.T) {
+	eth := MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+
+	tests := []struct {
+		name    string
+		n       SessionNamespaces
+		wantErr error
+	}{
+		{"valid namespace key", SessionNamespaces{"eip155": {Chains: []ChainID{ChainIDEthereumMainnet}, Accounts: []AccountID{eth}}}, nil},
+		{"valid chain key", SessionNamespaces{"eip155:1": {Accounts: []AccountID{eth}}}, nil},
+		{"invalid key", SessionNamespaces{"EIP155": {}}, ErrInvalidNamespace},
+		{"invalid chain key", SessionNamespaces{"eip155:abc": {}}, ErrInvalidReference},
+		{"foreign chain", SessionNamespaces{"solana": {Chains: []ChainID{ChainIDEthereumMainnet}}}, ErrInvalidNamespace},
+		{"foreign account", SessionNamespaces{"solana": {Accounts: []AccountID{eth}}}, ErrInvalidNamespace},
+		{"account on other chain", SessionNamespaces{"eip155:137": {Accounts: []AccountID{eth}}}, ErrInvalidReference},
+		{"chains under chain key", SessionNamespaces{"eip155:1": {Chains: []ChainID{ChainIDEthereumMainnet}}}, ErrInvalidFormat},
+		{"nil account", SessionNamespaces{"eip155": {Accounts: []AccountID{nil}}}, ErrEmptyValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.n.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestSessionNamespacesSatisfies(t *testing.T) {
+	var p SessionProposal
+	require.NoError(t, json.Unmarshal([]byte(testProposalJSON), &p))
+	var session SessionNamespaces
+	require.NoError(t, json.Unmarshal([]byte(testSessionJSON), &session))
+
+	require.NoError(t, session.Satisfies(p))
+	assert.Len(t, session.Accounts(), 3)
+
+	t.Run("missing chain account", func(t *testing.T) {
+		s := session.Merge(nil)
+		scope := s["eip155"]
+		scope.Accounts = scope.Accounts[:1]
+		s["eip155"] = scope
+		err := s.Satisfies(p)
+		assert.True(t, errors.Is(err, ErrUnsatisfiedNamespaces), "got %v", err)
+		assert.Contains(t, err.Error(), "eip155:137")
+	})
+
+	t.Run("chain listed without account", func(t *testing.T) {
+		s := session.Merge(SessionNamespaces{"eip155": {Chains: []ChainID{ChainIDArbitrumOne}}})
+		p := SessionProposal{RequiredNamespaces: SessionNamespaces{"eip155": {Chains: []ChainID{ChainIDArbitrumOne}}}}
+		err := s.Satisfies(p)
+		assert.True(t, errors.Is(err, ErrUnsatisfiedNamespaces), "got %v", err)
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		s := session.Merge(nil)
+		scope := s["eip155"]
+		scope.Methods = []string{"personal_sign"}
+		s["eip155"] = scope
+		err := s.Satisfies(p)
+		assert.True(t, errors.Is(err, ErrUnsatisfiedNamespaces), "got %v", err)
+		assert.Contains(t, err.Error(), "eth_sendTransaction")
+	})
+
+	t.Run("missing event", func(t *testing.T) {
+		s := session.Merge(nil)
+		scope := s["eip155"]
+		scope.Events = nil
+		s["eip155"] = scope
+		err := s.Satisfies(p)
+		assert.True(t, errors.Is(err, ErrUnsatisfiedNamespaces), "got %v", err)
+	})
+
+	t.Run("chain scoped grant", func(t *testing.T) {
+		s := SessionNamespaces{
+			"eip155": {
+				Methods:  []string{"personal_sign"},
+				Accounts: []AccountID{MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")},
+			},
+			"eip155:1": {Methods: []string{"eth_sendTransaction"}},
+		}
+		p := SessionProposal{RequiredNamespaces: SessionNamespaces{
+			"eip155:1": {Methods: []string{"personal_sign", "eth_sendTransaction"}},
+		}}
+		assert.NoError(t, s.Satisfies(p))
+	})
+
+	t.Run("optional namespaces are not required", func(t *testing.T) {
+		p := SessionProposal{OptionalNamespaces: SessionNamespaces{"solana": {Chains: []ChainID{ChainIDSolanaMainnet}}}}
+		assert.NoError(t, session.Satisfies(p))
+	})
+
+	t.Run("required namespace without chains", func(t *testing.T) {
+		p := SessionProposal{RequiredNamespaces: SessionNamespaces{"eip155": {}}}
+		err := session.Satisfies(p)
+		assert.True(t, errors.Is(err, ErrUnsatisfiedNamespaces), "got %v", err)
+	})
+}
@@ -0,0 +1,391 @@
+package caip10
+
+import (
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
+)
+
+const NamespaceCardano Namespace = "cardano"
+
+// CardanoNetwork represents a Cardano network (chain reference) in CIP-34 form:
+// <network id>-<network magic>.
+// https://github.com/cardano-foundation/CIPs/tree/master/CIP-0034
+type CardanoNetwork string
+
+// Common Cardano networks
+const (
+	CardanoMainnet CardanoNetwork = "1-764824073"
+	CardanoPreprod CardanoNetwork = "0-1"
+	CardanoPreview CardanoNetwork = "0-2"
+)
+
+// String returns the network reference string.
+func (n CardanoNetwork) String() string {
+	return string(n)
+}
+
+// NetworkID returns the network id encoded in Shelley address headers (1 mainnet, 0 testnets).
+func (n CardanoNetwork) NetworkID() byte {
+	if strings.HasPrefix(string(n), "1-") {
+		return 1
+	}
+	return 0
+}
+
+// NetworkMagic returns the protocol magic of the network.
+func (n CardanoNetwork) NetworkMagic() uint32 {
+	_, magic, _ := strings.Cut(string(n), "-")
+	v, _ := strconv.ParseUint(magic, 10, 32)
+	return uint32(v)
+}
+
+// CardanoAddressType is the address type from the Shelley header nibble.
+// https://github.com/cardano-foundation/CIPs/tree/master/CIP-0019
+type CardanoAddressType byte
+
+const (
+	CardanoAddressBase       CardanoAddressType = iota // payment + stake credential (header 0-3)
+	CardanoAddressPointer                              // payment credential + stake pointer (header 4-5)
+	CardanoAddressEnterprise                           // payment credential only (header 6-7)
+	CardanoAddressByron                                // legacy bootstrap address (header 8)
+	CardanoAddressReward                               // stake credential only (header 14-15)
+)
+
+// String returns the address type name.
+func (t CardanoAddressType) String() string {
+	switch t {
+	case CardanoAddressBase:
+		return "base"
+	case CardanoAddressPointer:
+		return "pointer"
+	case CardanoAddressEnterprise:
+		return "enterprise"
+	case CardanoAddressByron:
+		return "byron"
+	case CardanoAddressReward:
+		return "reward"
+	default:
+		return "unknown"
+	}
+}
+
+// CardanoCredentialHashLength is the length of a blake2b-224 key or script hash.
+const CardanoCredentialHashLength = 28
+
+// CardanoCredential is a payment or stake credential.
+type CardanoCredential struct {
+	Hash     [CardanoCredentialHashLength]byte
+	IsScript bool // script hash if true, verification key hash otherwise
+}
+
+// Bech32 human-readable parts
+const (
+	cardanoHRPAddr      = "addr"
+	cardanoHRPAddrTest  = "addr_test"
+	cardanoHRPStake     = "stake"
+	cardanoHRPStakeTest = "stake_test"
+)
+
+// CardanoAddress is a decoded Cardano address.
+type CardanoAddress struct {
+	Type CardanoAddressType
+	// NetworkID is the header network id; always 0 for Byron addresses.
+	NetworkID byte
+	// Payment is set for base, pointer and enterprise addresses.
+	Payment *CardanoCredential
+	// Stake is set for base and reward addresses.
+	Stake *CardanoCredential
+	// Bytes is the raw address payload.
+	Bytes []byte
+}
+
+// ParseCardanoAddress decodes a Shelley (bech32) or Byron (base58) address.
+//
+// Validation steps:
+//  1. Shelley: bech32 checksum, addr/stake prefix matching the header type and network id,
+//     and exact payload length for the header type
+//  2. Byron: base58 decode, CBOR structure and CRC32 checksum
+func ParseCardanoAddress(address string) (CardanoAddress, error) {
+	if strings.HasPrefix(address, cardanoHRPAddr+"1") || strings.HasPrefix(address, cardanoHRPAddrTest+"1") ||
+		strings.HasPrefix(address, cardanoHRPStake+"1") || strings.HasPrefix(address, cardanoHRPStakeTest+"1") {
+		return parseCardanoShelleyAddress(address)
+	}
+	return parseCardanoByronAddress(address)
+}
+
+func parseCardanoShelleyAddress(address string) (CardanoAddress, error) {
+	var addr CardanoAddress
+	hrp, data, variant, err := decodeBech32(address, 0)
+	if err != nil {
+		return addr, err
+	}
+	if variant != bech32Classic {
+		return addr, fmt.Errorf("%w: cardano addresses use bech32, not bech32m", ErrInvalidAddress)
+	}
+	payload, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return addr, err
+	}
+	if len(payload) == 0 {
+		return addr, fmt.Errorf("%w: empty cardano address", ErrInvalidAddress)
+	}
+
+	header := payload[0]
+	kind := header >> 4
+	addr.NetworkID = header & 0x0f
+	addr.Bytes = payload
+
+	cred := func(offset int, script bool) *CardanoCredential {
+		c := &CardanoCredential{IsScript: script}
+		copy(c.Hash[:], payload[offset:offset+CardanoCredentialHashLength])
+		return c
+	}
+
+	const credLen = 1 + CardanoCredentialHashLength
+	switch {
+	case kind <= 3:
+		addr.Type = CardanoAddressBase
+		if len(payload) != credLen+CardanoCredentialHashLength {
+			return addr, fmt.Errorf("%w: cardano base address must be %d bytes, got %d",
+				ErrInvalidAddress, credLen+CardanoCredentialHashLength, len(payload))
+		}
+		addr.Payment = cred(1, kind&1 != 0)
+		addr.Stake = cred(credLen, kind&2 != 0)
+	case kind == 4 || kind == 5:
+		addr.Type = CardanoAddressPointer
+		if len(payload) <= credLen {
+			return addr, fmt.Errorf("%w: cardano pointer address too short", ErrInvalidAddress)
+		}
+		if err := validateCardanoPointer(payload[credLen:]); err != nil {
+			return addr, err
+		}
+		addr.Payment = cred(1, kind == 5)
+	case kind == 6 || kind == 7:
+		addr.Type = CardanoAddressEnterprise
+		if len(payload) != credLen {
+			return addr, fmt.Errorf("%w: cardano enterprise address must be %d bytes, got %d",
+				ErrInvalidAddress, credLen, len(payload))
+		}
+		addr.Payment = cred(1, kind == 7)
+	case kind == 14 || kind == 15:
+		addr.Type = CardanoAddressReward
+		if len(payload) != credLen {
+			return addr, fmt.Errorf("%w: cardano reward address must be %d bytes, got %d",
+				ErrInvalidAddress, credLen, len(payload))
+		}
+		addr.Stake = cred(1, kind == 15)
+	default:
+		return addr, fmt.Errorf("%w: unsupported cardano address header 0x%02x", ErrInvalidAddress, header)
+	}
+
+	wantHRP := cardanoHRPAddr
+	if addr.Type == CardanoAddressReward {
+		wantHRP = cardanoHRPStake
+	}
+	if addr.NetworkID != 1 {
+		wantHRP = cardanoHRPAddrTest
+		if addr.Type == CardanoAddressReward {
+			wantHRP = cardanoHRPStakeTest
+		}
+	}
+	if hrp != wantHRP {
+		return addr, fmt.Errorf("%w: cardano address prefix %q does not match header (want %q)", ErrInvalidAddress, hrp, wantHRP)
+	}
+	return addr, nil
+}
+
+// validateCardanoPointer checks that a stake pointer holds exactly three
+// variable-length naturals (slot, transaction index, certificate index).
+func validateCardanoPointer(b []byte) error {
+	for n := 0; n < 3; n++ {
+		i := 0
+		for i < len(b) && b[i]&0x80 != 0 {
+			i++
+		}
+		if i >= len(b) {
+			return fmt.Errorf("%w: truncated cardano stake pointer", ErrInvalidAddress)
+		}
+		b = b[i+1:]
+	}
+	if len(b) != 0 {
+		return fmt.Errorf("%w: trailing bytes after cardano stake pointer", ErrInvalidAddress)
+	}
+	return nil
+}
+
+// cardanoByronAddress is the outer CBOR structure of a Byron address:
+// [tag 24(bytes payload), crc32(payload)].
+type cardanoByronAddress struct {
+	_       struct{} `cbor:",toarray"`
+	Payload cbor.Tag
+	CRC     uint32
+}
+
+func parseCardanoByronAddress(address string) (CardanoAddress, error) {
+	var addr CardanoAddress
+	raw, err := base58.Decode(address)
+	if err != nil || len(raw) == 0 {
+		return addr, fmt.Errorf("%w: invalid cardano address encoding", ErrInvalidAddress)
+	}
+
+	var outer cardanoByronAddress
+	if err := cbor.Unmarshal(raw, &outer); err != nil {
+		return addr, fmt.Errorf("%w: invalid cardano byron address structure", ErrInvalidAddress)
+	}
+	payload, ok := outer.Payload.Content.([]byte)
+	if outer.Payload.Number != 24 || !ok {
+		return addr, fmt.Errorf("%w: invalid cardano byron address payload", ErrInvalidAddress)
+	}
+	if crc32.ChecksumIEEE(payload) != outer.CRC {
+		return addr, fmt.Errorf("%w: cardano byron address checksum mismatch", ErrInvalidChecksum)
+	}
+
+	addr.Type = CardanoAddressByron
+	addr.Bytes = raw
+	return addr, nil
+}
+
+// CardanoAccountID is the interface for Cardano account IDs.
+type CardanoAccountID interface {
+	AccountID
+	// Network returns the Cardano network.
+	Network() CardanoNetwork
+	// AddressType returns the address type.
+	AddressType() CardanoAddressType
+	// IsByron returns true for legacy Byron era addresses.
+	IsByron() bool
+	// IsShelley returns true for Shelley era (bech32) addresses.
+	IsShelley() bool
+	// PaymentCredential returns the payment credential, if the address has one.
+	PaymentCredential() (CardanoCredential, bool)
+	// StakeCredential returns the stake credential, if the address has one.
+	StakeCredential() (CardanoCredential, bool)
+}
+
+// Ensure cardanoAccountID implements CardanoAccountID at compile time
+var _ CardanoAccountID = (*cardanoAccountID)(nil)
+
+func init() {
+	RegisterParser(&cardanoParser{})
+}
+
+// cardanoAccountID represents a Cardano account ID per CAIP-10.
+type cardanoAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	addr              CardanoAddress
+}
+
+// NewCardano creates a new CardanoAccountID from a bech32 or Byron address.
+// Shelley addresses must carry the network id of the network.
+func NewCardano(network CardanoNetwork, address string) (CardanoAccountID, error) {
+	if err := validateReference(NamespaceCardano, string(network)); err != nil {
+		return nil, err
+	}
+	addr, err := ParseCardanoAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if addr.Type != CardanoAddressByron && addr.NetworkID != network.NetworkID() {
+		return nil, fmt.Errorf("%w: cardano address network id %d does not match network %s",
+			ErrInvalidAddress, addr.NetworkID, network)
+	}
+	return &cardanoAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceCardano, network.String(), address),
+		addr:             addr,
+	}, nil
+}
+
+// MustNewCardano creates a new CardanoAccountID and panics if invalid.
+func MustNewCardano(network CardanoNetwork, address string) CardanoAccountID {
+	a, err := NewCardano(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Cardano network.
+func (a *cardanoAccountID) Network() CardanoNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return CardanoNetwork(a.Reference())
+}
+
+// AddressType returns the address type.
+func (a *cardanoAccountID) AddressType() CardanoAddressType {
+	if a == nil {
+		return 0
+	}
+	return a.addr.Type
+}
+
+// IsByron returns true for legacy Byron era addresses.
+func (a *cardanoAccountID) IsByron() bool {
+	return !a.IsZero() && a.addr.Type == CardanoAddressByron
+}
+
+// IsShelley returns true for Shelley era (bech32) addresses.
+func (a *cardanoAccountID) IsShelley() bool {
+	return !a.IsZero() && a.addr.Type != CardanoAddressByron
+}
+
+// PaymentCredential returns the payment credential, if the address has one.
+func (a *cardanoAccountID) PaymentCredential() (CardanoCredential, bool) {
+	if a == nil || a.addr.Payment == nil {
+		return CardanoCredential{}, false
+	}
+	return *a.addr.Payment, true
+}
+
+// StakeCredential returns the stake credential, if the address has one.
+func (a *cardanoAccountID) StakeCredential() (CardanoCredential, bool) {
+	if a == nil || a.addr.Stake == nil {
+		return CardanoCredential{}, false
+	}
+	return *a.addr.Stake, true
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *cardanoAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *cardanoAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- cardanoParser ---
+
+type cardanoParser struct{}
+
+func (p *cardanoParser) Namespace() Namespace {
+	return NamespaceCardano
+}
+
+func (p *cardanoParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceCardano {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceCardano, ns)
+	}
+	return NewCardano(CardanoNetwork(ref), addr)
+}
+
+func (p *cardanoParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewCardano(CardanoNetwork(reference), address)
+}
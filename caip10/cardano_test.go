@@ -0,0 +1,130 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// CIP-19 test vectors
+const (
+	cardanoPaymentKeyHash = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	cardanoStakeKeyHash   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	cardanoScriptHash     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+
+	cardanoBase       = "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	cardanoBaseScript = "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh"
+	cardanoPointer    = "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k"
+	cardanoEnterprise = "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+	cardanoReward     = "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"
+	cardanoTestBase   = "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae"
+	cardanoTestReward = "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn"
+	cardanoByron      = "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+)
+
+func TestCardanoAddressTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		network    CardanoNetwork
+		address    string
+		addrType   CardanoAddressType
+		payment    string
+		paymentScr bool
+		stake      string
+	}{
+		{"base", CardanoMainnet, cardanoBase, CardanoAddressBase, cardanoPaymentKeyHash, false, cardanoStakeKeyHash},
+		{"base script", CardanoMainnet, cardanoBaseScript, CardanoAddressBase, cardanoScriptHash, true, cardanoStakeKeyHash},
+		{"pointer", CardanoMainnet, cardanoPointer, CardanoAddressPointer, cardanoPaymentKeyHash, false, ""},
+		{"enterprise", CardanoMainnet, cardanoEnterprise, CardanoAddressEnterprise, cardanoPaymentKeyHash, false, ""},
+		{"reward", CardanoMainnet, cardanoReward, CardanoAddressReward, "", false, cardanoStakeKeyHash},
+		{"testnet base", CardanoPreprod, cardanoTestBase, CardanoAddressBase, cardanoPaymentKeyHash, false, cardanoStakeKeyHash},
+		{"testnet reward", CardanoPreview, cardanoTestReward, CardanoAddressReward, "", false, cardanoStakeKeyHash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Parse("cardano:" + tt.network.String() + ":" + tt.address)
+			require.NoError(t, err)
+
+			ada, ok := a.(CardanoAccountID)
+			require.True(t, ok, "expected CardanoAccountID, got %T", a)
+			assert.Equal(t, tt.network, ada.Network())
+			assert.Equal(t, tt.addrType, ada.AddressType())
+			assert.True(t, ada.IsShelley())
+			assert.False(t, ada.IsByron())
+
+			payment, ok := ada.PaymentCredential()
+			assert.Equal(t, tt.payment != "", ok)
+			if ok {
+				assert.Equal(t, tt.payment, hex.EncodeToString(payment.Hash[:]))
+				assert.Equal(t, tt.paymentScr, payment.IsScript)
+			}
+
+			stake, ok := ada.StakeCredential()
+			assert.Equal(t, tt.stake != "", ok)
+			if ok {
+				assert.Equal(t, tt.stake, hex.EncodeToString(stake.Hash[:]))
+				assert.False(t, stake.IsScript)
+			}
+		})
+	}
+}
+
+func TestCardanoByron(t *testing.T) {
+	a, err := NewCardano(CardanoMainnet, cardanoByron)
+	require.NoError(t, err)
+	assert.True(t, a.IsByron())
+	assert.False(t, a.IsShelley())
+	assert.Equal(t, CardanoAddressByron, a.AddressType())
+	assert.Equal(t, "byron", a.AddressType().String())
+
+	_, ok := a.PaymentCredential()
+	assert.False(t, ok)
+	assert.Equal(t, ChainIDCardanoMainnet, a.ChainID())
+}
+
+func TestCardanoNetwork(t *testing.T) {
+	assert.Equal(t, byte(1), CardanoMainnet.NetworkID())
+	assert.Equal(t, uint32(764824073), CardanoMainnet.NetworkMagic())
+	assert.Equal(t, byte(0), CardanoPreprod.NetworkID())
+	assert.Equal(t, uint32(2), CardanoPreview.NetworkMagic())
+}
+
+func TestCardanoParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "cardano:mainnet:" + cardanoBase, ErrInvalidReference},
+		{"network mismatch", "cardano:0-1:" + cardanoBase, ErrInvalidAddress},
+		{"testnet on mainnet", "cardano:1-764824073:" + cardanoTestBase, ErrInvalidAddress},
+		{"bad checksum", "cardano:1-764824073:addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9", ErrInvalidAddress},
+		{"stake prefix on payment", "cardano:1-764824073:stake1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", ErrInvalidAddress},
+		{"bad byron checksum", "cardano:1-764824073:Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAj", ErrInvalidAddress},
+		{"garbage", "cardano:1-764824073:notanaddress", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestCardanoNilReceiver(t *testing.T) {
+	var a *cardanoAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, CardanoNetwork(""), a.Network())
+	assert.False(t, a.IsByron())
+	assert.False(t, a.IsShelley())
+	_, ok := a.PaymentCredential()
+	assert.False(t, ok)
+	_, ok = a.StakeCredential()
+	assert.False(t, ok)
+}
@@ -0,0 +1,84 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cashAddrChecksumLength is the number of 5-bit checksum values (40 bits).
+const cashAddrChecksumLength = 8
+
+// cashAddrPolymod computes the 40-bit BCH code checksum used by CashAddr
+// and derived address formats (Kaspa).
+// https://github.com/bitcoincashorg/bitcoincash.org/blob/master/spec/cashaddr.md
+func cashAddrPolymod(values []byte) uint64 {
+	generator := [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	c := uint64(1)
+	for _, v := range values {
+		top := c >> 35
+		c = (c&0x07ffffffff)<<5 ^ uint64(v)
+		for i := 0; i < len(generator); i++ {
+			if (top>>uint(i))&1 == 1 {
+				c ^= generator[i]
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// cashAddrPrefixExpand returns the lower 5 bits of each prefix character followed by a zero separator.
+func cashAddrPrefixExpand(prefix string) []byte {
+	out := make([]byte, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		out[i] = prefix[i] & 0x1f
+	}
+	return out
+}
+
+// decodeCashAddr decodes a prefix:payload string into its prefix and 5-bit data values,
+// verifying the checksum. Mixed case is rejected; the result prefix is lower case.
+func decodeCashAddr(s string) (prefix string, data []byte, err error) {
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("%w: mixed case address", ErrInvalidAddress)
+	}
+	i := strings.LastIndexByte(lower, ':')
+	if i < 1 {
+		return "", nil, fmt.Errorf("%w: missing address prefix", ErrInvalidAddress)
+	}
+	prefix, payload := lower[:i], lower[i+1:]
+	if len(payload) < cashAddrChecksumLength {
+		return "", nil, fmt.Errorf("%w: address payload too short", ErrInvalidAddress)
+	}
+
+	values := make([]byte, len(payload))
+	for j := 0; j < len(payload); j++ {
+		v := strings.IndexByte(bech32Charset, payload[j])
+		if v < 0 {
+			return "", nil, fmt.Errorf("%w: invalid character %q", ErrInvalidAddress, payload[j])
+		}
+		values[j] = byte(v)
+	}
+	if cashAddrPolymod(append(cashAddrPrefixExpand(prefix), values...)) != 0 {
+		return "", nil, fmt.Errorf("%w: address checksum mismatch", ErrInvalidChecksum)
+	}
+	return prefix, values[:len(values)-cashAddrChecksumLength], nil
+}
+
+// encodeCashAddr encodes 5-bit data values as prefix:payload with checksum.
+func encodeCashAddr(prefix string, data []byte) string {
+	values := append(cashAddrPrefixExpand(prefix), data...)
+	polymod := cashAddrPolymod(append(values, make([]byte, cashAddrChecksumLength)...))
+
+	var sb strings.Builder
+	sb.Grow(len(prefix) + 1 + len(data) + cashAddrChecksumLength)
+	sb.WriteString(prefix)
+	sb.WriteByte(':')
+	for _, d := range data {
+		sb.WriteByte(bech32Charset[d])
+	}
+	for i := 0; i < cashAddrChecksumLength; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>(5*(cashAddrChecksumLength-1-i)))&31])
+	}
+	return sb.String()
+}
@@ -0,0 +1,52 @@
+package caip10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCashAddrValid(t *testing.T) {
+	// CashAddr specification checksum test vectors
+	tests := []string{
+		"prefix:x64nx6hz",
+		"PREFIX:X64NX6HZ",
+		"p:gpf8m4h7",
+		"bitcoincash:qpzry9x8gf2tvdw0s3jn54khce6mua7lcw20ayyn",
+		"bchtest:testnetaddress4d6njnut",
+		"bchreg:555555555555555555555555555555555555555555555udxmlmrz",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			prefix, data, err := decodeCashAddr(input)
+			require.NoError(t, err)
+			assert.Equal(t, strings.ToLower(input), encodeCashAddr(prefix, data))
+		})
+	}
+}
+
+func TestDecodeCashAddrInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"mixed case", "Prefix:x64nx6hz"},
+		{"missing prefix", "x64nx6hz"},
+		{"empty prefix", ":x64nx6hz"},
+		{"bad checksum", "prefix:x64nx6hx"},
+		{"invalid character", "prefix:x64nx6hb"},
+		{"too short", "prefix:x64nx6h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := decodeCashAddr(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
@@ -114,10 +114,157 @@ var (
 	ChainIDBitcoinTestnet = MustNewBIP122ChainID(BitcoinTestnet)
 )
 
+// Zcash
+var (
+	ChainIDZcashMainnet = MustNewBIP122ChainID(ZcashMainnet)
+	ChainIDZcashTestnet = MustNewBIP122ChainID(ZcashTestnet)
+)
+
+// Polkadot
+var (
+	ChainIDPolkadot = NewPolkadotChainID(PolkadotMainnet)
+	ChainIDKusama   = NewPolkadotChainID(KusamaMainnet)
+	ChainIDWestend  = NewPolkadotChainID(WestendTestnet)
+)
+
+// Stellar
+var (
+	ChainIDStellarPubnet  = NewStellarChainID(StellarPubnet)
+	ChainIDStellarTestnet = NewStellarChainID(StellarTestnet)
+)
+
+// NEAR
+var (
+	ChainIDNEARMainnet = NewNEARChainID(NEARMainnet)
+	ChainIDNEARTestnet = NewNEARChainID(NEARTestnet)
+)
+
+// TON
+var (
+	ChainIDTONMainnet = NewTONChainID(TONMainnet)
+	ChainIDTONTestnet = NewTONChainID(TONTestnet)
+)
+
+// Cardano
+var (
+	ChainIDCardanoMainnet = NewCardanoChainID(CardanoMainnet)
+	ChainIDCardanoPreprod = NewCardanoChainID(CardanoPreprod)
+	ChainIDCardanoPreview = NewCardanoChainID(CardanoPreview)
+)
+
+// Algorand
+var (
+	ChainIDAlgorandMainnet = NewAlgorandChainID(AlgorandMainnet)
+	ChainIDAlgorandTestnet = NewAlgorandChainID(AlgorandTestnet)
+	ChainIDAlgorandBetanet = NewAlgorandChainID(AlgorandBetanet)
+)
+
+// Filecoin
+var (
+	ChainIDFilecoinMainnet = NewFilecoinChainID(FilecoinMainnet)
+	ChainIDFilecoinTestnet = NewFilecoinChainID(FilecoinTestnet)
+)
+
+// Hedera
+var (
+	ChainIDHederaMainnet    = NewHederaChainID(HederaMainnet)
+	ChainIDHederaTestnet    = NewHederaChainID(HederaTestnet)
+	ChainIDHederaPreviewnet = NewHederaChainID(HederaPreviewnet)
+	ChainIDHederaDevnet     = NewHederaChainID(HederaDevnet)
+)
+
+// Antelope
+var (
+	ChainIDEOS   = NewAntelopeChainID(AntelopeEOS)
+	ChainIDWAX   = NewAntelopeChainID(AntelopeWAX)
+	ChainIDTelos = NewAntelopeChainID(AntelopeTelos)
+)
+
+// Stacks
+var (
+	ChainIDStacksMainnet = NewStacksChainID(StacksMainnet)
+	ChainIDStacksTestnet = NewStacksChainID(StacksTestnet)
+)
+
+// MultiversX
+var (
+	ChainIDMultiversXMainnet = NewMultiversXChainID(MultiversXMainnet)
+	ChainIDMultiversXDevnet  = NewMultiversXChainID(MultiversXDevnet)
+	ChainIDMultiversXTestnet = NewMultiversXChainID(MultiversXTestnet)
+)
+
+// Flow
+var (
+	ChainIDFlowMainnet  = NewFlowChainID(FlowMainnet)
+	ChainIDFlowTestnet  = NewFlowChainID(FlowTestnet)
+	ChainIDFlowEmulator = NewFlowChainID(FlowEmulator)
+)
+
+// Kaspa
+var (
+	ChainIDKaspaMainnet   = NewKaspaChainID(KaspaMainnet)
+	ChainIDKaspaTestnet10 = NewKaspaChainID(KaspaTestnet10)
+	ChainIDKaspaTestnet11 = NewKaspaChainID(KaspaTestnet11)
+)
+
+// ICP
+var (
+	ChainIDICPMainnet = NewICPChainID(ICPMainnet)
+)
+
+// Tron
+var (
+	ChainIDTronMainnet = NewTronChainID(TronMainnet)
+	ChainIDTronShasta  = NewTronChainID(TronShasta)
+	ChainIDTronNile    = NewTronChainID(TronNile)
+)
+
 // bip122ReferenceRegex validates BIP122 chain reference.
 // The reference is the first 32 characters of the genesis block hash (hex encoded).
 var bip122ReferenceRegex = regexp.MustCompile(`^[a-f0-9]{32}$`)
 
+// polkadotReferenceRegex validates Polkadot chain reference.
+// The reference is the first 32 characters of the genesis block hash (hex encoded, without 0x).
+var polkadotReferenceRegex = regexp.MustCompile(`^[a-f0-9]{32}$`)
+
+// stellarReferenceRegex validates Stellar chain reference.
+var stellarReferenceRegex = regexp.MustCompile(`^(pubnet|testnet)$`)
+
+// nearReferenceRegex validates NEAR chain reference.
+var nearReferenceRegex = regexp.MustCompile(`^(mainnet|testnet)$`)
+
+// cardanoReferenceRegex validates Cardano chain reference (CIP-34 network id and magic).
+var cardanoReferenceRegex = regexp.MustCompile(`^[01]-[0-9]{1,10}$`)
+
+// algorandReferenceRegex validates Algorand chain reference.
+// The reference is the first 32 characters of the genesis hash (url-safe base64 encoded).
+var algorandReferenceRegex = regexp.MustCompile(`^[-_a-zA-Z0-9]{32}$`)
+
+// filecoinReferenceRegex validates Filecoin chain reference.
+var filecoinReferenceRegex = regexp.MustCompile(`^[ft]$`)
+
+// hederaReferenceRegex validates Hedera chain reference.
+var hederaReferenceRegex = regexp.MustCompile(`^(mainnet|testnet|previewnet|devnet)$`)
+
+// antelopeReferenceRegex validates Antelope chain reference.
+// The reference is the first 32 characters of the chain id (hex encoded).
+var antelopeReferenceRegex = regexp.MustCompile(`^[a-f0-9]{32}$`)
+
+// multiversXReferenceRegex validates MultiversX chain reference.
+var multiversXReferenceRegex = regexp.MustCompile(`^(1|D|T)$`)
+
+// flowReferenceRegex validates Flow chain reference.
+var flowReferenceRegex = regexp.MustCompile(`^(mainnet|testnet|emulator)$`)
+
+// kaspaReferenceRegex validates Kaspa chain reference.
+var kaspaReferenceRegex = regexp.MustCompile(`^(mainnet|testnet-10|testnet-11|devnet|simnet)$`)
+
+// icpReferenceRegex validates ICP chain reference.
+var icpReferenceRegex = regexp.MustCompile(`^[a-f0-9]{32}$`)
+
+// tronReferenceRegex validates Tron chain reference, a lowercase hex chain ID.
+var tronReferenceRegex = regexp.MustCompile(`^0x[a-f0-9]{1,8}$`)
+
 // solanaReferenceRegex validates Solana chain reference.
 // The reference is the first 32 characters of the genesis hash (base58 encoded).
 var solanaReferenceRegex = regexp.MustCompile(`^[1-9A-HJ-NP-Za-km-z]{32}$`)
@@ -142,8 +289,74 @@ func validateReference(ns Namespace, reference string) error {
 		if !bip122ReferenceRegex.MatchString(reference) {
 			return fmt.Errorf("%w: invalid BIP122 block hash, must be 32 lowercase hex characters, got %q", ErrInvalidReference, reference)
 		}
+	case NamespacePolkadot:
+		if !polkadotReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Polkadot genesis hash, must be 32 lowercase hex characters, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceStellar:
+		if !stellarReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Stellar network, must be pubnet or testnet, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceNEAR:
+		if !nearReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid NEAR network, must be mainnet or testnet, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceTON:
+		if _, err := strconv.ParseInt(reference, 10, 32); err != nil {
+			return fmt.Errorf("%w: invalid TON global id %q", ErrInvalidReference, reference)
+		}
+	case NamespaceCardano:
+		if !cardanoReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Cardano network, must be <network id>-<network magic>, got %q", ErrInvalidReference, reference)
+		}
+		if _, err := strconv.ParseUint(reference[2:], 10, 32); err != nil {
+			return fmt.Errorf("%w: invalid Cardano network magic %q", ErrInvalidReference, reference)
+		}
+	case NamespaceAlgorand:
+		if !algorandReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Algorand genesis hash, must be 32 url-safe base64 characters, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceFilecoin, NamespaceFilecoinAlias:
+		if !filecoinReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Filecoin network, must be f or t, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceHedera:
+		if !hederaReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Hedera network, must be mainnet, testnet, previewnet or devnet, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceAntelope:
+		if !antelopeReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Antelope chain id, must be 32 lowercase hex characters, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceStacks:
+		if _, err := strconv.ParseUint(reference, 10, 32); err != nil {
+			return fmt.Errorf("%w: invalid Stacks chain id %q", ErrInvalidReference, reference)
+		}
+	case NamespaceMultiversX:
+		if !multiversXReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid MultiversX chain id, must be 1, D or T, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceFlow:
+		if !flowReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Flow network, must be mainnet, testnet or emulator, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceKaspa:
+		if !kaspaReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Kaspa network, must be mainnet, testnet-10, testnet-11, devnet or simnet, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceICP:
+		if !icpReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid ICP reference, must be 32 lowercase hex characters, got %q", ErrInvalidReference, reference)
+		}
+	case NamespaceTron:
+		if !tronReferenceRegex.MatchString(reference) {
+			return fmt.Errorf("%w: invalid Tron chain id, must be 0x followed by lowercase hex, got %q", ErrInvalidReference, reference)
+		}
 	default:
-		return fmt.Errorf("%w: unknown namespace %q", ErrInvalidNamespace, ns)
+		if g, ok := generatedNamespaces[ns]; ok {
+			return g.validateReference(reference)
+		}
+		return fmt.Errorf("%w: %q", ErrUnknownNamespace, ns)
 	}
 	return nil
 }
@@ -156,6 +369,66 @@ func NewSolanaChainID(network SolanaNetwork) ChainID {
 	return ChainID{Namespace: NamespaceSolana, Reference: network.String()}
 }
 
+func NewPolkadotChainID(network PolkadotNetwork) ChainID {
+	return ChainID{Namespace: NamespacePolkadot, Reference: network.String()}
+}
+
+func NewStellarChainID(network StellarNetwork) ChainID {
+	return ChainID{Namespace: NamespaceStellar, Reference: network.String()}
+}
+
+func NewNEARChainID(network NEARNetwork) ChainID {
+	return ChainID{Namespace: NamespaceNEAR, Reference: network.String()}
+}
+
+func NewTONChainID(network TONNetwork) ChainID {
+	return ChainID{Namespace: NamespaceTON, Reference: network.String()}
+}
+
+func NewCardanoChainID(network CardanoNetwork) ChainID {
+	return ChainID{Namespace: NamespaceCardano, Reference: network.String()}
+}
+
+func NewAlgorandChainID(network AlgorandNetwork) ChainID {
+	return ChainID{Namespace: NamespaceAlgorand, Reference: network.String()}
+}
+
+func NewFilecoinChainID(network FilecoinNetwork) ChainID {
+	return ChainID{Namespace: NamespaceFilecoin, Reference: network.String()}
+}
+
+func NewHederaChainID(network HederaNetwork) ChainID {
+	return ChainID{Namespace: NamespaceHedera, Reference: network.String()}
+}
+
+func NewAntelopeChainID(network AntelopeNetwork) ChainID {
+	return ChainID{Namespace: NamespaceAntelope, Reference: network.String()}
+}
+
+func NewStacksChainID(network StacksNetwork) ChainID {
+	return ChainID{Namespace: NamespaceStacks, Reference: network.String()}
+}
+
+func NewMultiversXChainID(network MultiversXNetwork) ChainID {
+	return ChainID{Namespace: NamespaceMultiversX, Reference: network.String()}
+}
+
+func NewFlowChainID(network FlowNetwork) ChainID {
+	return ChainID{Namespace: NamespaceFlow, Reference: network.String()}
+}
+
+func NewKaspaChainID(network KaspaNetwork) ChainID {
+	return ChainID{Namespace: NamespaceKaspa, Reference: network.String()}
+}
+
+func NewICPChainID(network ICPNetwork) ChainID {
+	return ChainID{Namespace: NamespaceICP, Reference: network.String()}
+}
+
+func NewTronChainID(network TronNetwork) ChainID {
+	return ChainID{Namespace: NamespaceTron, Reference: network.String()}
+}
+
 // NewBIP122ChainID creates a ChainID for BIP122 namespace.
 // blockHash should be the first 32 characters of the genesis block hash (hex encoded).
 func NewBIP122ChainID(blockHash BIP122Network) (ChainID, error) {
@@ -239,6 +512,16 @@ func (c ChainID) MarshalText() ([]byte, error) {
 	return []byte(c.String()), nil
 }
 
+// AppendText implements encoding.TextAppender.
+func (c ChainID) AppendText(b []byte) ([]byte, error) {
+	if c.IsZero() {
+		return b, nil
+	}
+	b = append(b, c.Namespace...)
+	b = append(b, ':')
+	return append(b, c.Reference...), nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (c *ChainID) UnmarshalText(text []byte) error {
 	if len(text) == 0 {
@@ -258,6 +541,11 @@ func (c ChainID) MarshalBinary() ([]byte, error) {
 	return []byte(c.String()), nil
 }
 
+// AppendBinary implements encoding.BinaryAppender.
+func (c ChainID) AppendBinary(b []byte) ([]byte, error) {
+	return c.AppendText(b)
+}
+
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
 func (c *ChainID) UnmarshalBinary(data []byte) error {
 	return c.UnmarshalText(data)
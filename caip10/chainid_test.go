@@ -17,6 +17,8 @@ var (
 	_ encoding.TextUnmarshaler   = (*ChainID)(nil)
 	_ encoding.BinaryMarshaler   = ChainID{}
 	_ encoding.BinaryUnmarshaler = (*ChainID)(nil)
+	_ encoding.TextAppender      = ChainID{}
+	_ encoding.BinaryAppender    = ChainID{}
 	_ json.Marshaler             = ChainID{}
 	_ json.Unmarshaler           = (*ChainID)(nil)
 	_ driver.Valuer              = ChainID{}
@@ -262,6 +264,25 @@ func TestChainID_TextMarshalZeroValue(t *testing.T) {
 	assert.Equal(t, "", string(data))
 }
 
+func TestChainID_AppendText(t *testing.T) {
+	c := ChainID{Namespace: NamespaceEIP155, Reference: "137"}
+	data, err := c.AppendText([]byte("chain="))
+	require.NoError(t, err)
+	assert.Equal(t, "chain=eip155:137", string(data))
+
+	data, err = c.AppendBinary(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:137", string(data))
+
+	data, err = ChainID{}.AppendText([]byte("x"))
+	require.NoError(t, err)
+	assert.Equal(t, "x", string(data))
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() { _, _ = c.AppendText(buf) })
+	assert.Zero(t, allocs)
+}
+
 func TestChainID_TextUnmarshalEmpty(t *testing.T) {
 	var c ChainID
 	err := c.UnmarshalText([]byte(""))
@@ -0,0 +1,166 @@
+package caip10
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ChainlistURL is the ethereum-lists chain registry export.
+// https://github.com/ethereum-lists/chains
+const ChainlistURL = "https://chainid.network/chains.json"
+
+// chainlistEntry is one chain of the ethereum-lists export; unused fields are omitted.
+type chainlistEntry struct {
+	Name           string   `json:"name"`
+	ShortName      string   `json:"shortName"`
+	ChainID        uint64   `json:"chainId"`
+	RPC            []string `json:"rpc"`
+	InfoURL        string   `json:"infoURL"`
+	Status         string   `json:"status"`
+	NativeCurrency struct {
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Decimals uint8  `json:"decimals"`
+	} `json:"nativeCurrency"`
+	Explorers []struct {
+		URL string `json:"url"`
+	} `json:"explorers"`
+	Faucets []string `json:"faucets"`
+	Slip44  uint32   `json:"slip44"`
+}
+
+// chainlistTestnetWords mark testnets by name, e.g. "Sepolia" or "Base Goerli Testnet".
+var chainlistTestnetWords = []string{"testnet", "devnet", "sepolia", "goerli", "holesky", "hoodi"}
+
+// isTestnet reports whether the entry looks like a testnet: it uses the shared
+// testnet coin type, has faucets, or has a testnet name.
+func (e chainlistEntry) isTestnet() bool {
+	if e.Slip44 == uint32(SLIP44Testnet) || len(e.Faucets) > 0 {
+		return true
+	}
+	name := strings.ToLower(e.Name)
+	for _, word := range chainlistTestnetWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseChainlist decodes an ethereum-lists chains JSON array into eip155 chain metadata.
+// RPC URLs containing API key placeholders (e.g. "${INFURA_API_KEY}") are dropped.
+// Chains with faucets, the testnet coin type or a testnet name are marked Testnet.
+func ParseChainlist(r io.Reader) ([]ChainMetadata, error) {
+	var entries []chainlistEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("%w: chainlist: %v", ErrInvalidFormat, err)
+	}
+
+	out := make([]ChainMetadata, 0, len(entries))
+	for _, e := range entries {
+		meta := ChainMetadata{
+			ChainID:   NewEIP155ChainID(e.ChainID),
+			Name:      e.Name,
+			ShortName: e.ShortName,
+			NativeCurrency: NativeCurrency{
+				Name:     e.NativeCurrency.Name,
+				Symbol:   e.NativeCurrency.Symbol,
+				Decimals: e.NativeCurrency.Decimals,
+			},
+			InfoURL:    e.InfoURL,
+			Deprecated: e.Status == "deprecated",
+			Testnet:    e.isTestnet(),
+		}
+		for _, rpc := range e.RPC {
+			if !strings.Contains(rpc, "${") {
+				meta.RPC = append(meta.RPC, rpc)
+			}
+		}
+		for _, x := range e.Explorers {
+			meta.Explorers = append(meta.Explorers, x.URL)
+		}
+		out = append(out, meta)
+	}
+	return out, nil
+}
+
+// ImportChainlist parses an ethereum-lists chains JSON array and registers the chain
+// metadata and EIP-3770 short names. Entries with an invalid chain ID or short name
+// keep what they could register. Returns the number of chains registered.
+func ImportChainlist(r io.Reader) (int, error) {
+	chains, err := ParseChainlist(r)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, meta := range chains {
+		if err := RegisterChainMetadata(meta); err != nil {
+			continue
+		}
+		n++
+		if meta.ShortName != "" {
+			_ = RegisterEIP3770ShortName(meta.ShortName, meta.ChainID)
+		}
+	}
+	return n, nil
+}
+
+// ImportChainlistFile imports an ethereum-lists chains JSON file.
+func ImportChainlistFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return ImportChainlist(f)
+}
+
+// ImportChainlistURL downloads and imports an ethereum-lists chains JSON document,
+// such as ChainlistURL. A nil client uses http.DefaultClient.
+func ImportChainlistURL(ctx context.Context, client *http.Client, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("chainlist: unexpected HTTP status %s", resp.Status)
+	}
+	return ImportChainlist(resp.Body)
+}
+
+// RefreshChainlist imports url immediately and then every interval until ctx is done.
+// Import errors are passed to onError (if non-nil) and do not stop the refresh.
+// It blocks; run it in its own goroutine.
+func RefreshChainlist(ctx context.Context, client *http.Client, url string, interval time.Duration, onError func(error)) {
+	refresh := func() {
+		if _, err := ImportChainlistURL(ctx, client, url); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+	}
+	refresh()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refresh()
+		}
+	}
+}
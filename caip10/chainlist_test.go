@@ -0,0 +1,147 @@
+package caip10
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChainlistJSON is an excerpt of the ethereum-lists export with made-up chain IDs.
+const testChainlistJSON = `[
+	{
+		"name": "Test Mainnet",
+		"chain": "TST",
+		"rpc": ["https://mainnet.infura.io/v3/${INFURA_API_KEY}", "https://rpc.test.example"],
+		"faucets": [],
+		"nativeCurrency": {"name": "Test Ether", "symbol": "TETH", "decimals": 18},
+		"infoURL": "https://test.example",
+		"shortName": "tstmain",
+		"chainId": 990001,
+		"networkId": 990001,
+		"slip44": 60,
+		"explorers": [{"name": "testscan", "url": "https://scan.test.example", "standard": "EIP3091"}]
+	},
+	{
+		"name": "Old Testnet",
+		"chain": "TST",
+		"rpc": [],
+		"nativeCurrency": {"name": "Old Ether", "symbol": "OETH", "decimals": 18},
+		"shortName": "tstold",
+		"chainId": 990002,
+		"status": "deprecated"
+	},
+	{
+		"name": "Bad Short Name",
+		"chain": "TST",
+		"rpc": [],
+		"nativeCurrency": {"name": "Bad", "symbol": "BAD", "decimals": 18},
+		"shortName": "has space",
+		"chainId": 990003
+	}
+]`
+
+func TestParseChainlist(t *testing.T) {
+	chains, err := ParseChainlist(strings.NewReader(testChainlistJSON))
+	require.NoError(t, err)
+	require.Len(t, chains, 3)
+
+	assert.Equal(t, ChainMetadata{
+		ChainID:        NewEIP155ChainID(990001),
+		Name:           "Test Mainnet",
+		ShortName:      "tstmain",
+		NativeCurrency: NativeCurrency{Name: "Test Ether", Symbol: "TETH", Decimals: 18},
+		RPC:            []string{"https://rpc.test.example"},
+		Explorers:      []string{"https://scan.test.example"},
+		InfoURL:        "https://test.example",
+	}, chains[0])
+	assert.True(t, chains[1].Deprecated)
+	assert.True(t, chains[1].Testnet)
+	assert.False(t, chains[2].Testnet)
+
+	_, err = ParseChainlist(strings.NewReader(`{"chainId": 1}`))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
+
+func TestImportChainlist(t *testing.T) {
+	n, err := ImportChainlist(strings.NewReader(testChainlistJSON))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	meta, ok := LookupChainMetadata(NewEIP155ChainID(990001))
+	require.True(t, ok)
+	assert.Equal(t, "Test Mainnet", meta.Name)
+
+	a, err := ParseEIP3770("tstmain:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:990001:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", a.String())
+
+	_, ok = LookupEIP3770ShortName("has space")
+	assert.False(t, ok)
+	_, ok = LookupChainMetadata(NewEIP155ChainID(990003))
+	assert.True(t, ok, "metadata is registered even if the short name is invalid")
+}
+
+func TestImportChainlistFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	require.NoError(t, os.WriteFile(path, []byte(testChainlistJSON), 0o600))
+
+	n, err := ImportChainlistFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, err = ImportChainlistFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestImportChainlistURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chains.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testChainlistJSON))
+	}))
+	defer srv.Close()
+
+	n, err := ImportChainlistURL(context.Background(), nil, srv.URL+"/chains.json")
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, err = ImportChainlistURL(context.Background(), nil, srv.URL+"/missing.json")
+	assert.Error(t, err)
+}
+
+func TestRefreshChainlist(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(testChainlistJSON))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var errs atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		RefreshChainlist(ctx, nil, srv.URL, 10*time.Millisecond, func(error) { errs.Add(1) })
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return requests.Load() >= 3 }, 5*time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, int32(1), errs.Load())
+}
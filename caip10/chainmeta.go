@@ -0,0 +1,187 @@
+package caip10
+
+import (
+	"slices"
+	"sort"
+	"sync"
+)
+
+// NativeCurrency describes the native currency of a chain.
+type NativeCurrency struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// ChainMetadata is descriptive information about a chain.
+type ChainMetadata struct {
+	ChainID        ChainID
+	Name           string // e.g. "Ethereum Mainnet"
+	ShortName      string // EIP-3770 short name, e.g. "eth"
+	NativeCurrency NativeCurrency
+	RPC            []string
+	Explorers      []string
+	InfoURL        string
+	Deprecated     bool
+	Testnet        bool
+}
+
+var (
+	chainMetadataMu sync.RWMutex
+	chainMetadata   = map[ChainID]ChainMetadata{}
+)
+
+// RegisterChainMetadata registers or replaces the metadata of a chain.
+func RegisterChainMetadata(meta ChainMetadata) error {
+	if err := validateAssetChainID(meta.ChainID); err != nil {
+		return err
+	}
+	meta.RPC = slices.Clone(meta.RPC)
+	meta.Explorers = slices.Clone(meta.Explorers)
+
+	chainMetadataMu.Lock()
+	defer chainMetadataMu.Unlock()
+	chainMetadata[meta.ChainID] = meta
+	return nil
+}
+
+// LookupChainMetadata returns the metadata registered for a chain.
+func LookupChainMetadata(chainID ChainID) (ChainMetadata, bool) {
+	chainMetadataMu.RLock()
+	defer chainMetadataMu.RUnlock()
+	meta, ok := chainMetadata[chainID]
+	if !ok {
+		return ChainMetadata{}, false
+	}
+	meta.RPC = slices.Clone(meta.RPC)
+	meta.Explorers = slices.Clone(meta.Explorers)
+	return meta, true
+}
+
+// RegisteredChains returns the chains with registered metadata of a namespace,
+// or of all namespaces if namespace is empty, sorted by their string form.
+func RegisteredChains(namespace Namespace) []ChainID {
+	chainMetadataMu.RLock()
+	defer chainMetadataMu.RUnlock()
+	var out []ChainID
+	for c := range chainMetadata {
+		if namespace == "" || c.Namespace == namespace {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].String() < out[j].String() })
+	return out
+}
+
+// chainNetworkTypes classifies well-known chains; true marks a testnet.
+var chainNetworkTypes = map[ChainID]bool{
+	ChainIDEthereumMainnet:  false,
+	ChainIDEthereumSepolia:  true,
+	ChainIDEthereumHoodi:    true,
+	ChainIDArbitrumOne:      false,
+	ChainIDArbitrumNova:     false,
+	ChainIDArbitrumSepolia:  true,
+	ChainIDOptimism:         false,
+	ChainIDOptimismSepolia:  true,
+	ChainIDBase:             false,
+	ChainIDBaseSepolia:      true,
+	ChainIDPolygon:          false,
+	ChainIDPolygonAmoy:      true,
+	ChainIDPolygonZkEVM:     false,
+	ChainIDZkSyncEra:        false,
+	ChainIDZkSyncEraSepolia: true,
+	ChainIDLinea:            false,
+	ChainIDLineaSepolia:     true,
+	ChainIDScroll:           false,
+	ChainIDScrollSepolia:    true,
+	ChainIDBSC:              false,
+	ChainIDBSCTestnet:       true,
+	ChainIDOpBNB:            false,
+	ChainIDOpBNBTestnet:     true,
+	ChainIDAvalanche:        false,
+	ChainIDAvalancheFuji:    true,
+	ChainIDFantom:           false,
+	ChainIDGnosis:           false,
+	ChainIDCelo:             false,
+
+	ChainIDSolanaMainnet: false,
+	ChainIDSolanaDevnet:  true,
+	ChainIDSolanaTestnet: true,
+
+	ChainIDZcashMainnet: false,
+	ChainIDZcashTestnet: true,
+
+	ChainIDPolkadot: false,
+	ChainIDKusama:   false,
+	ChainIDWestend:  true,
+
+	ChainIDStellarPubnet:         false,
+	ChainIDStellarTestnet:        true,
+	ChainIDNEARMainnet:           false,
+	ChainIDNEARTestnet:           true,
+	ChainIDTONMainnet:            false,
+	ChainIDTONTestnet:            true,
+	ChainIDCardanoMainnet:        false,
+	ChainIDCardanoPreprod:        true,
+	ChainIDCardanoPreview:        true,
+	ChainIDAlgorandMainnet:       false,
+	ChainIDAlgorandTestnet:       true,
+	ChainIDAlgorandBetanet:       true,
+	ChainIDFilecoinMainnet:       false,
+	ChainIDFilecoinTestnet:       true,
+	ChainIDHederaMainnet:         false,
+	ChainIDHederaTestnet:         true,
+	ChainIDHederaPreviewnet:      true,
+	ChainIDHederaDevnet:          true,
+	ChainIDEOS:                   false,
+	ChainIDWAX:                   false,
+	ChainIDTelos:                 false,
+	ChainIDStacksMainnet:         false,
+	ChainIDStacksTestnet:         true,
+	ChainIDMultiversXMainnet:     false,
+	ChainIDMultiversXDevnet:      true,
+	ChainIDMultiversXTestnet:     true,
+	ChainIDFlowMainnet:           false,
+	ChainIDFlowTestnet:           true,
+	ChainIDFlowEmulator:          true,
+	ChainIDKaspaMainnet:          false,
+	ChainIDKaspaTestnet10:        true,
+	ChainIDKaspaTestnet11:        true,
+	NewKaspaChainID(KaspaDevnet): true,
+	NewKaspaChainID(KaspaSimnet): true,
+	ChainIDICPMainnet:            false,
+	ChainIDTronMainnet:           false,
+	ChainIDTronShasta:            true,
+	ChainIDTronNile:              true,
+}
+
+// networkType classifies a chain as testnet or mainnet. Well-known chains are
+// built in, bip122 chains are classified by their SLIP-44 coin type, and other
+// chains by their registered ChainMetadata. ok is false for unknown chains.
+func (c ChainID) networkType() (testnet, ok bool) {
+	if testnet, ok := chainNetworkTypes[c]; ok {
+		return testnet, true
+	}
+	if c.Namespace == NamespaceBIP122 {
+		if coinType, ok := chainCoinTypes[c]; ok {
+			return coinType == SLIP44Testnet, true
+		}
+	}
+	if meta, ok := LookupChainMetadata(c); ok {
+		return meta.Testnet, true
+	}
+	return false, false
+}
+
+// IsTestnet reports whether the chain is a known testnet, devnet or local network.
+func (c ChainID) IsTestnet() bool {
+	testnet, ok := c.networkType()
+	return ok && testnet
+}
+
+// IsMainnet reports whether the chain is a known production network. Chains that
+// are neither built in nor registered are neither mainnet nor testnet.
+func (c ChainID) IsMainnet() bool {
+	testnet, ok := c.networkType()
+	return ok && !testnet
+}
@@ -0,0 +1,73 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterChainMetadata(t *testing.T) {
+	chainID := ChainID{Namespace: "testmeta", Reference: "1"}
+	meta := ChainMetadata{
+		ChainID:        chainID,
+		Name:           "Test Chain",
+		NativeCurrency: NativeCurrency{Name: "Test", Symbol: "TST", Decimals: 9},
+		RPC:            []string{"https://rpc.test"},
+	}
+	require.NoError(t, RegisterChainMetadata(meta))
+
+	got, ok := LookupChainMetadata(chainID)
+	require.True(t, ok)
+	assert.Equal(t, meta, got)
+
+	// registered and returned slices are copies
+	meta.RPC[0] = "changed"
+	got.RPC[0] = "changed"
+	again, _ := LookupChainMetadata(chainID)
+	assert.Equal(t, []string{"https://rpc.test"}, again.RPC)
+
+	assert.Equal(t, []ChainID{chainID}, RegisteredChains("testmeta"))
+
+	_, ok = LookupChainMetadata(ChainID{Namespace: "testmeta", Reference: "2"})
+	assert.False(t, ok)
+
+	err := RegisterChainMetadata(ChainMetadata{ChainID: ChainID{Namespace: "eip155", Reference: "abc"}})
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+}
+
+func TestChainIDNetworkType(t *testing.T) {
+	tests := []struct {
+		chainID          ChainID
+		mainnet, testnet bool
+	}{
+		{ChainIDEthereumMainnet, true, false},
+		{ChainIDBaseSepolia, false, true},
+		{ChainIDSolanaMainnet, true, false},
+		{ChainIDSolanaDevnet, false, true},
+		{ChainIDBitcoinMainnet, true, false},
+		{ChainIDBitcoinTestnet, false, true},
+		{MustNewBIP122ChainID(DogecoinTestnet), false, true},
+		{MustNewBIP122ChainID(LitecoinMainnet), true, false},
+		{ChainIDZcashTestnet, false, true},
+		{ChainIDTronNile, false, true},
+		{ChainIDHederaPreviewnet, false, true},
+		{NewEIP155ChainID(990123), false, false},
+		{ChainID{}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainID.String(), func(t *testing.T) {
+			assert.Equal(t, tt.mainnet, tt.chainID.IsMainnet())
+			assert.Equal(t, tt.testnet, tt.chainID.IsTestnet())
+		})
+	}
+
+	// Other chains are classified by their metadata
+	testnet := NewEIP155ChainID(990124)
+	require.NoError(t, RegisterChainMetadata(ChainMetadata{ChainID: testnet, Testnet: true}))
+	assert.True(t, testnet.IsTestnet())
+	mainnet := NewEIP155ChainID(990125)
+	require.NoError(t, RegisterChainMetadata(ChainMetadata{ChainID: mainnet}))
+	assert.True(t, mainnet.IsMainnet())
+}
@@ -0,0 +1,158 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ChainPatternWildcard matches any run of characters in a ChainPattern reference.
+const ChainPatternWildcard = "*"
+
+// chainPatternReferenceRegex is ReferenceRegex extended with the wildcard character.
+var chainPatternReferenceRegex = regexp.MustCompile(`^[-_a-zA-Z0-9*]{1,32}$`)
+
+// ChainPattern matches CAIP-2 chain IDs by namespace and a reference that may
+// contain wildcards, e.g. "eip155:*" or "cosmos:cosmoshub-*".
+// Format: namespace:reference_pattern
+type ChainPattern struct {
+	Namespace Namespace
+	Reference string
+}
+
+// ParseChainPattern parses a chain pattern string.
+// The namespace must be literal; "*" in the reference matches any run of characters.
+func ParseChainPattern(s string) (ChainPattern, error) {
+	ns, ref, err := SplitCAIP2(s)
+	if err != nil {
+		return ChainPattern{}, err
+	}
+	p := ChainPattern{Namespace: Namespace(ns), Reference: ref}
+	if err := p.Validate(); err != nil {
+		return ChainPattern{}, err
+	}
+	return p, nil
+}
+
+// MustParseChainPattern parses a chain pattern and panics if invalid.
+func MustParseChainPattern(s string) ChainPattern {
+	p, err := ParseChainPattern(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IsZero reports whether the ChainPattern is the zero value.
+func (p ChainPattern) IsZero() bool {
+	return p.Namespace == "" && p.Reference == ""
+}
+
+// Validate checks the namespace and reference pattern syntax.
+func (p ChainPattern) Validate() error {
+	if p.IsZero() {
+		return ErrEmptyValue
+	}
+	if !NamespaceRegex.MatchString(string(p.Namespace)) {
+		return fmt.Errorf("%w: must match [-a-z0-9]{3,8}, got %q", ErrInvalidNamespace, p.Namespace)
+	}
+	if !chainPatternReferenceRegex.MatchString(p.Reference) {
+		return fmt.Errorf("%w: pattern must match [-_a-zA-Z0-9*]{1,32}, got %q", ErrInvalidReference, p.Reference)
+	}
+	return nil
+}
+
+// IsWildcard reports whether the reference contains a wildcard.
+func (p ChainPattern) IsWildcard() bool {
+	return strings.Contains(p.Reference, ChainPatternWildcard)
+}
+
+// ChainID returns the chain ID of a pattern without wildcards.
+func (p ChainPattern) ChainID() (ChainID, bool) {
+	if p.IsZero() || p.IsWildcard() {
+		return ChainID{}, false
+	}
+	return ChainID{Namespace: p.Namespace, Reference: p.Reference}, true
+}
+
+// Matches reports whether the chain ID matches the pattern.
+func (p ChainPattern) Matches(c ChainID) bool {
+	if p.IsZero() || c.IsZero() || c.Namespace != p.Namespace {
+		return false
+	}
+	return matchWildcard(p.Reference, c.Reference)
+}
+
+// MatchesAccount reports whether the account's chain matches the pattern.
+func (p ChainPattern) MatchesAccount(a AccountID) bool {
+	if a == nil || a.IsZero() {
+		return false
+	}
+	return p.Matches(a.ChainID())
+}
+
+// matchWildcard reports whether s matches pattern, where "*" matches any run of characters.
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, ChainPatternWildcard)
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(s, first) {
+		return false
+	}
+	s = s[len(first):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, last)
+}
+
+func (p ChainPattern) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	return string(p.Namespace) + ":" + p.Reference
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p ChainPattern) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *ChainPattern) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = ChainPattern{}
+		return nil
+	}
+	parsed, err := ParseChainPattern(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p ChainPattern) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *ChainPattern) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = ChainPattern{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: expected JSON string", ErrInvalidFormat)
+	}
+	return p.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,98 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainPatternMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		chain   ChainID
+		want    bool
+	}{
+		{"eip155:*", ChainIDEthereumMainnet, true},
+		{"eip155:*", ChainIDArbitrumOne, true},
+		{"eip155:*", ChainIDSolanaMainnet, false},
+		{"eip155:1", ChainIDEthereumMainnet, true},
+		{"eip155:1", ChainIDArbitrumOne, false},
+		{"eip155:4217*", ChainIDArbitrumNova, true},
+		{"eip155:4217*", ChainIDArbitrumOne, false},
+		{"eip155:*614", ChainIDArbitrumSepolia, true},
+		{"cosmos:cosmoshub-*", ChainID{Namespace: "cosmos", Reference: "cosmoshub-4"}, true},
+		{"cosmos:cosmoshub-*", ChainID{Namespace: "cosmos", Reference: "osmosis-1"}, false},
+		{"cosmos:*-*", ChainID{Namespace: "cosmos", Reference: "osmosis-1"}, true},
+		{"cosmos:*-*", ChainID{Namespace: "cosmos", Reference: "osmosis"}, false},
+		{"cosmos:a*a", ChainID{Namespace: "cosmos", Reference: "a"}, false},
+		{"eip155:*", ChainID{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.chain.String(), func(t *testing.T) {
+			p, err := ParseChainPattern(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p.Matches(tt.chain))
+		})
+	}
+}
+
+func TestChainPatternMatchesAccount(t *testing.T) {
+	p := MustParseChainPattern("eip155:*")
+	assert.True(t, p.MatchesAccount(MustParse("eip155:137:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")))
+	assert.False(t, p.MatchesAccount(MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")))
+	assert.False(t, p.MatchesAccount(nil))
+	assert.False(t, p.MatchesAccount(&GenericAccountID{}))
+}
+
+func TestParseChainPatternInvalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"", ErrEmptyValue},
+		{"eip155", ErrInvalidFormat},
+		{"*:1", ErrInvalidNamespace},
+		{"eip155:", ErrInvalidReference},
+		{"eip155:1/2", ErrInvalidReference},
+		{"eip155:1:2", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseChainPattern(tt.input)
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestChainPatternChainID(t *testing.T) {
+	c, ok := MustParseChainPattern("eip155:1").ChainID()
+	assert.True(t, ok)
+	assert.Equal(t, ChainIDEthereumMainnet, c)
+
+	_, ok = MustParseChainPattern("eip155:*").ChainID()
+	assert.False(t, ok)
+	assert.True(t, MustParseChainPattern("eip155:*").IsWildcard())
+}
+
+func TestChainPatternJSON(t *testing.T) {
+	type policy struct {
+		Allow []ChainPattern `json:"allow"`
+	}
+	var p policy
+	require.NoError(t, json.Unmarshal([]byte(`{"allow":["eip155:*","cosmos:cosmoshub-*"]}`), &p))
+	assert.Equal(t, []ChainPattern{
+		{Namespace: NamespaceEIP155, Reference: "*"},
+		{Namespace: "cosmos", Reference: "cosmoshub-*"},
+	}, p.Allow)
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"allow":["eip155:*","cosmos:cosmoshub-*"]}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"allow":[1]}`), &p))
+}
@@ -0,0 +1,272 @@
+package caip10
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/mr-tron/base58"
+)
+
+// binaryVersionCompact marks the compact binary format. The first byte of the
+// length-prefixed format written by MarshalBinary is the namespace length (at most
+// NamespaceMaxLen), so versions have the high bit set and never collide with it.
+const binaryVersionCompact byte = 0x81
+
+// compactNamespaces enumerates the well-known namespaces of the compact format.
+// Codes are part of the wire format: append new namespaces, never reorder or reuse.
+// Code 0 means the namespace follows as a length-prefixed string.
+var compactNamespaces = []Namespace{
+	1:  NamespaceEIP155,
+	2:  NamespaceSolana,
+	3:  NamespaceBIP122,
+	4:  "cosmos",
+	5:  NamespacePolkadot,
+	6:  NamespaceAlgorand,
+	7:  NamespaceAntelope,
+	8:  NamespaceCardano,
+	9:  NamespaceFilecoin,
+	10: NamespaceFlow,
+	11: NamespaceHedera,
+	12: NamespaceICP,
+	13: NamespaceKaspa,
+	14: NamespaceMultiversX,
+	15: NamespaceNEAR,
+	16: NamespaceStacks,
+	17: NamespaceStellar,
+	18: NamespaceTON,
+	19: NamespaceTron,
+}
+
+// Reference encodings of the compact format (high nibble of the kind byte).
+const (
+	compactRefString  = 0x0 // length-prefixed string
+	compactRefUvarint = 0x1 // eip155 chain ID as uvarint
+)
+
+// Address encodings of the compact format (low nibble of the kind byte).
+const (
+	compactAddrString   = 0x0 // length-prefixed string
+	compactAddrEIP55    = 0x1 // 20 raw bytes, EIP-55 checksummed hex
+	compactAddrLowerHex = 0x2 // 20 raw bytes, lowercase hex
+	compactAddrBase58   = 0x3 // 32 raw bytes, base58
+)
+
+// MarshalBinaryCompact encodes an account with GenericAccountID.MarshalBinaryCompact.
+func MarshalBinaryCompact(a AccountID) ([]byte, error) {
+	if m, ok := a.(interface{ MarshalBinaryCompact() ([]byte, error) }); ok {
+		return m.MarshalBinaryCompact()
+	}
+	return genericOf(a).MarshalBinaryCompact()
+}
+
+// MarshalBinaryCompact encodes the account in a compact binary format, about half
+// the size of MarshalBinary for EVM accounts: well-known namespaces take one byte,
+// eip155 chain IDs are varints, and EVM and Solana addresses are stored as raw bytes.
+// The encoding is lossless, including the address casing.
+//
+// UnmarshalBinary reads both this format and the one written by MarshalBinary,
+// which are told apart by a leading version byte.
+func (a *GenericAccountID) MarshalBinaryCompact() ([]byte, error) {
+	if a.IsZero() {
+		return []byte{binaryVersionCompact}, nil
+	}
+
+	buf := make([]byte, 0, 3+len(a.namespace)+len(a.reference)+len(a.address))
+	buf = append(buf, binaryVersionCompact)
+
+	code := compactNamespaceCode(a.namespace)
+	buf = append(buf, code)
+	if code == 0 {
+		buf = appendCompactString(buf, string(a.namespace))
+	}
+
+	kindAt := len(buf)
+	buf = append(buf, 0)
+
+	refKind := byte(compactRefString)
+	if chainID, ok := compactChainID(a.namespace, a.reference); ok {
+		refKind = compactRefUvarint
+		buf = binary.AppendUvarint(buf, chainID)
+	} else {
+		buf = appendCompactString(buf, a.reference)
+	}
+
+	addrKind, raw := compactAddress(a.namespace, a.address)
+	if addrKind == compactAddrString {
+		buf = appendCompactString(buf, a.address)
+	} else {
+		buf = append(buf, raw...)
+	}
+
+	buf[kindAt] = refKind<<4 | addrKind
+	return buf, nil
+}
+
+// unmarshalBinaryCompact decodes data written by MarshalBinaryCompact.
+func (a *GenericAccountID) unmarshalBinaryCompact(data []byte) error {
+	if len(data) == 1 {
+		*a = GenericAccountID{}
+		return nil
+	}
+	r := compactReader{data: data[1:]}
+
+	var namespace Namespace
+	code := r.byte()
+	switch {
+	case code == 0:
+		namespace = Namespace(r.string())
+	case int(code) < len(compactNamespaces):
+		namespace = compactNamespaces[code]
+	default:
+		return fmt.Errorf("%w: unknown compact namespace code %d", ErrInvalidFormat, code)
+	}
+
+	kind := r.byte()
+	var reference string
+	switch kind >> 4 {
+	case compactRefString:
+		reference = r.string()
+	case compactRefUvarint:
+		reference = strconv.FormatUint(r.uvarint(), 10)
+	default:
+		return fmt.Errorf("%w: unknown compact reference kind %d", ErrInvalidFormat, kind>>4)
+	}
+
+	var address string
+	switch kind & 0x0f {
+	case compactAddrString:
+		address = r.string()
+	case compactAddrEIP55:
+		address = ecommon.BytesToAddress(r.bytes(ecommon.AddressLength)).Hex()
+	case compactAddrLowerHex:
+		address = "0x" + hex.EncodeToString(r.bytes(ecommon.AddressLength))
+	case compactAddrBase58:
+		address = base58.Encode(r.bytes(32))
+	default:
+		return fmt.Errorf("%w: unknown compact address kind %d", ErrInvalidFormat, kind&0x0f)
+	}
+
+	if r.err != nil {
+		return r.err
+	}
+	if len(r.data) != 0 {
+		return fmt.Errorf("%w: binary data length mismatch", ErrInvalidFormat)
+	}
+
+	parsed, err := NewGeneric(namespace, reference, address)
+	if err != nil {
+		return err
+	}
+	*a = *parsed
+	return nil
+}
+
+func compactNamespaceCode(ns Namespace) byte {
+	for i, n := range compactNamespaces {
+		if i > 0 && n == ns {
+			return byte(i)
+		}
+	}
+	return 0
+}
+
+// compactChainID returns the eip155 chain ID if the reference is its canonical
+// decimal form and fits in a uint64.
+func compactChainID(ns Namespace, reference string) (uint64, bool) {
+	if ns != NamespaceEIP155 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(reference, 10, 64)
+	if err != nil || strconv.FormatUint(v, 10) != reference {
+		return 0, false
+	}
+	return v, true
+}
+
+// compactAddress returns the raw encoding of EVM and Solana addresses that can be
+// restored exactly, or compactAddrString otherwise.
+func compactAddress(ns Namespace, address string) (byte, []byte) {
+	switch ns {
+	case NamespaceEIP155:
+		if len(address) != 2+2*ecommon.AddressLength || !strings.HasPrefix(address, "0x") {
+			break
+		}
+		raw, err := hex.DecodeString(address[2:])
+		if err != nil {
+			break
+		}
+		if address == ecommon.BytesToAddress(raw).Hex() {
+			return compactAddrEIP55, raw
+		}
+		if address == "0x"+hex.EncodeToString(raw) {
+			return compactAddrLowerHex, raw
+		}
+	case NamespaceSolana:
+		raw, err := base58.Decode(address)
+		if err == nil && len(raw) == 32 && base58.Encode(raw) == address {
+			return compactAddrBase58, raw
+		}
+	}
+	return compactAddrString, nil
+}
+
+func appendCompactString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// compactReader consumes compact binary data, recording the first error.
+type compactReader struct {
+	data []byte
+	err  error
+}
+
+func (r *compactReader) fail() {
+	if r.err == nil {
+		r.err = fmt.Errorf("%w: binary data too short", ErrInvalidFormat)
+	}
+	r.data = nil
+}
+
+func (r *compactReader) byte() byte {
+	if len(r.data) < 1 {
+		r.fail()
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+func (r *compactReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.fail()
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *compactReader) bytes(n int) []byte {
+	if len(r.data) < n {
+		r.fail()
+		return make([]byte, n)
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *compactReader) string() string {
+	n := r.uvarint()
+	if n > uint64(len(r.data)) {
+		r.fail()
+		return ""
+	}
+	return string(r.bytes(int(n)))
+}
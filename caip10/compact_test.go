@@ -0,0 +1,106 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalBinaryCompactRoundTrip(t *testing.T) {
+	inputs := []string{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb",
+		"eip155:1:0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB",
+		"eip155:42161:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"eip155:01:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"eip155:99999999999999999999999:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:11111111111111111111111111111111",
+		"bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6",
+		"cosmos:cosmoshub-3:cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0",
+		"chainstd:8c3444cf8970a9e4:0x1234",
+	}
+	for _, s := range inputs {
+		a := MustNewGeneric(mustSplit(t, s))
+		data, err := a.MarshalBinaryCompact()
+		require.NoError(t, err, s)
+		assert.Equal(t, binaryVersionCompact, data[0], s)
+
+		var b GenericAccountID
+		require.NoError(t, b.UnmarshalBinary(data), s)
+		assert.Equal(t, s, b.String())
+	}
+}
+
+func TestMarshalBinaryCompactSize(t *testing.T) {
+	a := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	legacy, err := a.MarshalBinary()
+	require.NoError(t, err)
+	compact, err := MarshalBinaryCompact(a)
+	require.NoError(t, err)
+	assert.Len(t, compact, 24) // version, namespace, kind, chain ID, address
+	assert.Less(t, 2*len(compact), len(legacy))
+
+	s := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	legacy, err = s.MarshalBinary()
+	require.NoError(t, err)
+	compact, err = MarshalBinaryCompact(s)
+	require.NoError(t, err)
+	assert.Less(t, len(compact), len(legacy))
+}
+
+func TestUnmarshalBinaryLegacyFormat(t *testing.T) {
+	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	legacy, err := a.MarshalBinary()
+	require.NoError(t, err)
+
+	var b GenericAccountID
+	require.NoError(t, b.UnmarshalBinary(legacy))
+	assert.True(t, a.Equal(&b))
+}
+
+func TestMarshalBinaryCompactZero(t *testing.T) {
+	data, err := (&GenericAccountID{}).MarshalBinaryCompact()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersionCompact}, data)
+
+	var nilID *GenericAccountID
+	data, err = nilID.MarshalBinaryCompact()
+	require.NoError(t, err)
+
+	b := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	require.NoError(t, b.UnmarshalBinary(data))
+	assert.True(t, b.IsZero())
+}
+
+func TestUnmarshalBinaryCompactErrors(t *testing.T) {
+	a := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	valid, err := MarshalBinaryCompact(a)
+	require.NoError(t, err)
+
+	tests := map[string][]byte{
+		"unknown version":   {0x82, 1, 0x11, 1},
+		"unknown namespace": {binaryVersionCompact, 0xfe, 0x00, 1, 'a', 1, 'b'},
+		"unknown ref kind":  {binaryVersionCompact, 1, 0x71, 1},
+		"unknown addr kind": {binaryVersionCompact, 1, 0x1f, 1},
+		"truncated":         valid[:len(valid)-1],
+		"trailing bytes":    append(append([]byte{}, valid...), 0),
+		"long string":       {binaryVersionCompact, 0, 0x7f, 'a'},
+		"invalid account":   {binaryVersionCompact, 4, 0x00, 1, '!', 1, 'b'},
+	}
+	for name, data := range tests {
+		var b GenericAccountID
+		err := b.UnmarshalBinary(data)
+		assert.Error(t, err, name)
+		assert.True(t, errors.Is(err, ErrInvalidFormat) || errors.Is(err, ErrInvalidReference), "%s: got %v", name, err)
+	}
+}
+
+func mustSplit(t *testing.T, s string) (Namespace, string, string) {
+	t.Helper()
+	ns, ref, addr, err := SplitCAIP10(s)
+	require.NoError(t, err)
+	return ns, ref, addr
+}
@@ -0,0 +1,89 @@
+package caip10
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to or after b.
+//
+// Accounts are ordered by namespace, then by reference, then by address. eip155
+// references are compared numerically (eip155:2 sorts before eip155:10), all other
+// components byte-wise. Addresses are compared in their AccountKey form, so Compare
+// returns 0 exactly when the accounts have equal keys. nil and zero accounts sort first.
+func Compare(a, b AccountID) int {
+	ka, kb := KeyOf(a), KeyOf(b)
+	if c := ka.ChainKey().ChainID().Compare(kb.ChainKey().ChainID()); c != 0 {
+		return c
+	}
+	return strings.Compare(ka.Address, kb.Address)
+}
+
+// Less reports whether a sorts before b, as defined by Compare.
+func Less(a, b AccountID) bool {
+	return Compare(a, b) < 0
+}
+
+// SortAccountIDs sorts accounts in place in the order defined by Compare.
+func SortAccountIDs(accounts []AccountID) {
+	slices.SortFunc(accounts, Compare)
+}
+
+// SortChainIDs sorts chain IDs in place in the order defined by ChainID.Compare.
+func SortChainIDs(chains []ChainID) {
+	slices.SortFunc(chains, ChainID.Compare)
+}
+
+// Compare returns -1, 0 or +1 depending on whether c sorts before, equal to or after
+// other. Chain IDs are ordered by namespace, then by reference; eip155 references are
+// compared numerically. The zero ChainID sorts first.
+func (c ChainID) Compare(other ChainID) int {
+	if n := strings.Compare(string(c.Namespace), string(other.Namespace)); n != 0 {
+		return n
+	}
+	if c.Namespace == NamespaceEIP155 {
+		return compareDecimal(c.Reference, other.Reference)
+	}
+	return strings.Compare(c.Reference, other.Reference)
+}
+
+// Less reports whether c sorts before other, as defined by ChainID.Compare.
+func (c ChainID) Less(other ChainID) bool {
+	return c.Compare(other) < 0
+}
+
+// compareDecimal orders decimal strings numerically. Leading zeros are ignored;
+// strings that are not all digits fall back to byte-wise order after the numbers.
+func compareDecimal(a, b string) int {
+	da, db := isDecimal(a), isDecimal(b)
+	if !da || !db {
+		if da != db {
+			if da {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	}
+	ta, tb := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if n := cmp.Compare(len(ta), len(tb)); n != 0 {
+		return n
+	}
+	if n := strings.Compare(ta, tb); n != 0 {
+		return n
+	}
+	return strings.Compare(a, b)
+}
+
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
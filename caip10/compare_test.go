@@ -0,0 +1,70 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	addr := "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"
+	mainnet := MustParse("eip155:1:" + addr)
+	polygon := MustParse("eip155:137:" + addr)
+	optimism := MustParse("eip155:10:" + addr)
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	generic := newGenericUnchecked(NamespaceEIP155, "1", "0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB")
+
+	assert.Equal(t, 0, Compare(mainnet, generic), "equal keys compare equal")
+	assert.Equal(t, -1, Compare(optimism, polygon), "eip155 references compare numerically")
+	assert.Equal(t, 1, Compare(sol, mainnet))
+	assert.Equal(t, -1, Compare(nil, mainnet))
+	assert.Equal(t, 0, Compare(nil, &GenericAccountID{}))
+	assert.True(t, Less(mainnet, optimism))
+	assert.False(t, Less(mainnet, generic))
+
+	accounts := []AccountID{sol, polygon, nil, optimism, mainnet}
+	SortAccountIDs(accounts)
+	assert.Equal(t, []AccountID{nil, mainnet, optimism, polygon, sol}, accounts)
+}
+
+func TestChainIDCompare(t *testing.T) {
+	chains := []ChainID{
+		ChainIDSolanaMainnet,
+		ChainIDArbitrumOne,
+		ChainIDBitcoinMainnet,
+		ChainIDPolygon,
+		{},
+		ChainIDEthereumMainnet,
+	}
+	SortChainIDs(chains)
+	assert.Equal(t, []ChainID{
+		{},
+		ChainIDBitcoinMainnet,
+		ChainIDEthereumMainnet,
+		ChainIDPolygon,
+		ChainIDArbitrumOne,
+		ChainIDSolanaMainnet,
+	}, chains)
+
+	assert.Equal(t, 0, ChainIDEthereumMainnet.Compare(NewEIP155ChainID(1)))
+	assert.True(t, NewEIP155ChainID(9).Less(NewEIP155ChainID(10)))
+	assert.False(t, NewEIP155ChainID(10).Less(NewEIP155ChainID(9)))
+}
+
+func TestCompareDecimal(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1", "1", 0},
+		{"2", "10", -1},
+		{"100", "99", 1},
+		{"007", "7", -1}, // numerically equal, tie broken byte-wise
+		{"1", "abc", -1},
+		{"abc", "1", 1},
+		{"abc", "abd", -1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, compareDecimal(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
@@ -0,0 +1,62 @@
+package caip10
+
+import (
+	"encoding/binary"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// Contract addresses of CREATE and CREATE2 deployments.
+// https://eips.ethereum.org/EIPS/eip-1014
+
+// ComputeCreate2 returns the account of a contract deployed by deployer on
+// chainID with CREATE2, keccak256(0xff ++ deployer ++ salt ++ initCodeHash)[12:].
+// The account can be registered before the contract is deployed.
+func ComputeCreate2[C eip155ChainID](chainID C, deployer ecommon.Address, salt, initCodeHash [32]byte) (EIP155AccountID, error) {
+	account := NewEIP155(chainID, create2Address(deployer, salt, initCodeHash[:]))
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ComputeCreate returns the account of a contract deployed by deployer on
+// chainID with CREATE in a transaction or call with the given nonce,
+// keccak256(rlp([deployer, nonce]))[12:].
+func ComputeCreate[C eip155ChainID](chainID C, deployer ecommon.Address, nonce uint64) (EIP155AccountID, error) {
+	account := NewEIP155(chainID, createAddress(deployer, nonce))
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// create2Address returns the CREATE2 address
+// keccak256(0xff ++ deployer ++ salt ++ initCodeHash)[12:].
+func create2Address(deployer ecommon.Address, salt [32]byte, initCodeHash []byte) ecommon.Address {
+	return ecommon.BytesToAddress(keccak256([]byte{0xff}, deployer[:], salt[:], initCodeHash)[12:])
+}
+
+// createAddress returns the CREATE address keccak256(rlp([deployer, nonce]))[12:].
+func createAddress(deployer ecommon.Address, nonce uint64) ecommon.Address {
+	// RLP of the nonce: a single byte below 0x80, 0x80 for zero, otherwise a
+	// length prefix and the big-endian bytes without leading zeros.
+	var nonceRLP []byte
+	switch {
+	case nonce == 0:
+		nonceRLP = []byte{0x80}
+	case nonce < 0x80:
+		nonceRLP = []byte{byte(nonce)}
+	default:
+		be := binary.BigEndian.AppendUint64(nil, nonce)
+		for len(be) > 0 && be[0] == 0 {
+			be = be[1:]
+		}
+		nonceRLP = append([]byte{0x80 + byte(len(be))}, be...)
+	}
+	// The list is at most 30 bytes, so its prefix is a single byte.
+	payloadLen := 1 + ecommon.AddressLength + len(nonceRLP)
+	list := append([]byte{0xc0 + byte(payloadLen), 0x80 + ecommon.AddressLength}, deployer[:]...)
+	list = append(list, nonceRLP...)
+	return ecommon.BytesToAddress(keccak256(list)[12:])
+}
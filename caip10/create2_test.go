@@ -0,0 +1,60 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCreate2(t *testing.T) {
+	// EIP-1014 examples
+	tests := []struct {
+		deployer string
+		salt     string
+		initCode string
+		want     string
+	}{
+		{"0x0000000000000000000000000000000000000000", "00", "00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "00", "00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0xdeadbeef00000000000000000000000000000000", "000000000000000000000000feed000000000000000000000000000000000000", "00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0x0000000000000000000000000000000000000000", "00", "deadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			var salt [32]byte
+			s, _ := hex.DecodeString(tt.salt)
+			copy(salt[32-len(s):], s)
+			code, _ := hex.DecodeString(tt.initCode)
+
+			account, err := ComputeCreate2(1, ecommon.HexToAddress(tt.deployer), salt, [32]byte(keccak256(code)))
+			require.NoError(t, err)
+			assert.Equal(t, "eip155:1:"+tt.want, account.String())
+		})
+	}
+}
+
+func TestComputeCreate(t *testing.T) {
+	deployer := ecommon.HexToAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	tests := []struct {
+		nonce uint64
+		want  string
+	}{
+		{0, "0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d"},
+		{1, "0x343c43a37d37dff08ae8c4a11544c718abb4fcf8"},
+		{2, "0xf778b86fa74e846c4f0a1fbd1335fe81c00a0c91"},
+		{3, "0xfffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c"},
+	}
+	for _, tt := range tests {
+		account, err := ComputeCreate(137, deployer, tt.nonce)
+		require.NoError(t, err)
+		assert.Equal(t, ecommon.HexToAddress(tt.want), account.Account(), "nonce %d", tt.nonce)
+		assert.Equal(t, ChainIDPolygon, account.ChainID())
+	}
+
+	// Multi-byte nonces use a length prefix
+	assert.NotEqual(t, createAddress(deployer, 0x80), createAddress(deployer, 0x7f))
+	assert.NotEqual(t, createAddress(deployer, 1<<56), createAddress(deployer, 1<<48))
+}
@@ -0,0 +1,136 @@
+package caip10
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BIP-32 hierarchical deterministic wallet paths.
+// https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
+
+// DerivationPurpose is the purpose level of a derivation path, selecting the
+// address type derived below it.
+type DerivationPurpose uint32
+
+// Derivation purposes
+const (
+	PurposeBIP44 DerivationPurpose = 44 // legacy P2PKH, and the default of non-bitcoin chains
+	PurposeBIP49 DerivationPurpose = 49 // P2SH-wrapped segwit
+	PurposeBIP84 DerivationPurpose = 84 // native segwit
+	PurposeBIP86 DerivationPurpose = 86 // taproot
+)
+
+// HardenedOffset is added to a path component to derive a hardened child.
+const HardenedOffset = 1 << 31
+
+// DerivationPath is a BIP-44 style path m/purpose'/coin_type'/account'/change/index.
+type DerivationPath struct {
+	Purpose  DerivationPurpose
+	CoinType SLIP44CoinType
+	Account  uint32
+	Change   uint32 // 0 for receiving, 1 for change addresses
+	Index    uint32
+}
+
+// NewDerivationPath returns the path of an address on a chain, with the coin
+// type from CoinTypeFor. BIP-49, BIP-84 and BIP-86 are only valid on bip122
+// chains.
+func NewDerivationPath(chainID ChainID, purpose DerivationPurpose, account, change, index uint32) (DerivationPath, error) {
+	coinType, ok := CoinTypeFor(chainID)
+	if !ok {
+		return DerivationPath{}, fmt.Errorf("%w: no known coin type for chain %q", ErrInvalidDerivationPath, chainID)
+	}
+	if purpose != PurposeBIP44 && chainID.Namespace != NamespaceBIP122 {
+		return DerivationPath{}, fmt.Errorf("%w: purpose %d is only defined for bip122 chains", ErrInvalidDerivationPath, purpose)
+	}
+	p := DerivationPath{Purpose: purpose, CoinType: coinType, Account: account, Change: change, Index: index}
+	if err := p.Validate(); err != nil {
+		return DerivationPath{}, err
+	}
+	return p, nil
+}
+
+// ParseDerivationPath parses a path such as "m/84'/0'/0'/0/5". Hardened levels
+// may be marked with ' or h.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 6 || parts[0] != "m" {
+		return DerivationPath{}, fmt.Errorf("%w: expected m/purpose'/coin_type'/account'/change/index, got %q", ErrInvalidDerivationPath, s)
+	}
+	var levels [5]uint32
+	for i, part := range parts[1:] {
+		digits, hardened := strings.CutSuffix(part, "'")
+		if !hardened {
+			digits, hardened = strings.CutSuffix(part, "h")
+		}
+		if hardened != (i < 3) {
+			return DerivationPath{}, fmt.Errorf("%w: level %d of %q must be %s", ErrInvalidDerivationPath, i+1, s, hardenedName(i < 3))
+		}
+		if !isDecimal(digits) {
+			return DerivationPath{}, fmt.Errorf("%w: invalid level %q", ErrInvalidDerivationPath, part)
+		}
+		n, err := strconv.ParseUint(digits, 10, 31)
+		if err != nil {
+			return DerivationPath{}, fmt.Errorf("%w: level %q out of range", ErrInvalidDerivationPath, part)
+		}
+		levels[i] = uint32(n)
+	}
+	p := DerivationPath{
+		Purpose:  DerivationPurpose(levels[0]),
+		CoinType: SLIP44CoinType(levels[1]),
+		Account:  levels[2],
+		Change:   levels[3],
+		Index:    levels[4],
+	}
+	if err := p.Validate(); err != nil {
+		return DerivationPath{}, err
+	}
+	return p, nil
+}
+
+func hardenedName(hardened bool) string {
+	if hardened {
+		return "hardened"
+	}
+	return "not hardened"
+}
+
+// Validate checks the purpose, the change level and that every level is below
+// HardenedOffset.
+func (p DerivationPath) Validate() error {
+	switch p.Purpose {
+	case PurposeBIP44, PurposeBIP49, PurposeBIP84, PurposeBIP86:
+	default:
+		return fmt.Errorf("%w: unsupported purpose %d", ErrInvalidDerivationPath, p.Purpose)
+	}
+	if p.Change > 1 {
+		return fmt.Errorf("%w: change must be 0 or 1, got %d", ErrInvalidDerivationPath, p.Change)
+	}
+	if uint32(p.CoinType) >= HardenedOffset || p.Account >= HardenedOffset || p.Index >= HardenedOffset {
+		return fmt.Errorf("%w: level out of range", ErrInvalidDerivationPath)
+	}
+	return nil
+}
+
+// ChainID returns the mainnet chain of the path's coin type, see ChainForCoinType.
+func (p DerivationPath) ChainID() (ChainID, bool) {
+	return ChainForCoinType(p.CoinType)
+}
+
+// Components returns the path levels as BIP-32 child numbers, with HardenedOffset
+// added to the hardened levels.
+func (p DerivationPath) Components() []uint32 {
+	return []uint32{
+		uint32(p.Purpose) + HardenedOffset,
+		uint32(p.CoinType) + HardenedOffset,
+		p.Account + HardenedOffset,
+		p.Change,
+		p.Index,
+	}
+}
+
+// String returns the path, e.g. "m/44'/60'/0'/0/0".
+func (p DerivationPath) String() string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", p.Purpose, p.CoinType, p.Account, p.Change, p.Index)
+}
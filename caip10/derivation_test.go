@@ -0,0 +1,75 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDerivationPath(t *testing.T) {
+	tests := []struct {
+		chainID ChainID
+		purpose DerivationPurpose
+		want    string
+	}{
+		{ChainIDEthereumMainnet, PurposeBIP44, "m/44'/60'/0'/0/0"},
+		{ChainIDBase, PurposeBIP44, "m/44'/60'/0'/0/0"},
+		{ChainIDSolanaMainnet, PurposeBIP44, "m/44'/501'/0'/0/0"},
+		{ChainIDBitcoinMainnet, PurposeBIP84, "m/84'/0'/0'/0/0"},
+		{ChainIDBitcoinTestnet, PurposeBIP49, "m/49'/1'/0'/0/0"},
+		{MustNewBIP122ChainID(LitecoinMainnet), PurposeBIP86, "m/86'/2'/0'/0/0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			p, err := NewDerivationPath(tt.chainID, tt.purpose, 0, 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p.String())
+		})
+	}
+
+	p, err := NewDerivationPath(ChainIDEthereumMainnet, PurposeBIP44, 2, 1, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/60'/2'/1/7", p.String())
+	assert.Equal(t, []uint32{44 + HardenedOffset, 60 + HardenedOffset, 2 + HardenedOffset, 1, 7}, p.Components())
+
+	_, err = NewDerivationPath(ChainIDEthereumMainnet, PurposeBIP84, 0, 0, 0)
+	assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "got %v", err)
+	_, err = NewDerivationPath(ChainIDEthereumMainnet, PurposeBIP44, 0, 2, 0)
+	assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "got %v", err)
+	_, err = NewDerivationPath(ChainIDEthereumMainnet, PurposeBIP44, HardenedOffset, 0, 0)
+	assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "got %v", err)
+	_, err = NewDerivationPath(MustNewBIP122ChainID("00000000000000000000000000000000"), PurposeBIP44, 0, 0, 0)
+	assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "got %v", err)
+}
+
+func TestParseDerivationPath(t *testing.T) {
+	p, err := ParseDerivationPath("m/84'/0'/3'/1/42")
+	require.NoError(t, err)
+	assert.Equal(t, DerivationPath{Purpose: PurposeBIP84, CoinType: SLIP44Bitcoin, Account: 3, Change: 1, Index: 42}, p)
+	chainID, ok := p.ChainID()
+	assert.True(t, ok)
+	assert.Equal(t, ChainIDBitcoinMainnet, chainID)
+
+	p, err = ParseDerivationPath("m/44h/60h/0h/0/0")
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/60'/0'/0/0", p.String())
+
+	for _, s := range []string{
+		"",
+		"44'/60'/0'/0/0",
+		"m/44'/60'/0'/0",
+		"m/44'/60'/0'/0/0/0",
+		"m/44/60'/0'/0/0",
+		"m/44'/60'/0'/0'/0",
+		"m/45'/60'/0'/0/0",
+		"m/44'/60'/0'/0/x",
+		"m/44'/60'/0'/0/+1",
+		"m/44'/60'/0'/0/2147483648",
+		"m/44'/60'/0'/2/0",
+	} {
+		_, err := ParseDerivationPath(s)
+		assert.True(t, errors.Is(err, ErrInvalidDerivationPath), "%q: got %v", s, err)
+	}
+}
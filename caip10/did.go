@@ -0,0 +1,41 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DIDPKHPrefix is the prefix of did:pkh identifiers.
+// https://github.com/w3c-ccg/did-pkh/blob/main/did-pkh-method-draft.md
+const DIDPKHPrefix = "did:pkh:"
+
+// ParseDIDPKH parses a did:pkh identifier (e.g. "did:pkh:eip155:1:0xab...")
+// into an AccountID. The method-specific identifier must be a valid CAIP-10
+// account ID; DID URLs with paths, queries or fragments are rejected.
+func ParseDIDPKH(did string) (AccountID, error) {
+	if len(did) == 0 {
+		return nil, ErrEmptyValue
+	}
+	if !strings.HasPrefix(did, DIDPKHPrefix) {
+		return nil, fmt.Errorf("%w: missing %q prefix", ErrInvalidFormat, DIDPKHPrefix)
+	}
+	id := did[len(DIDPKHPrefix):]
+	if strings.ContainsAny(id, "/?#") {
+		return nil, fmt.Errorf("%w: did:pkh must not contain a path, query or fragment", ErrInvalidFormat)
+	}
+	return Parse(id)
+}
+
+// MustParseDIDPKH parses a did:pkh identifier and panics if invalid.
+func MustParseDIDPKH(did string) AccountID {
+	a, err := ParseDIDPKH(did)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// IsDIDPKH reports whether s has the did:pkh prefix.
+func IsDIDPKH(s string) bool {
+	return strings.HasPrefix(s, DIDPKHPrefix)
+}
@@ -0,0 +1,66 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDIDPKHRoundTrip(t *testing.T) {
+	tests := []string{
+		"did:pkh:eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a",
+		"did:pkh:solana:4sGjMW1sUnHzSxGspuhpqLDx6wiyjNtZ:CKg5d12Jhpej1JqtmxLJgaFqqeYjxgPqToJ4LBdvG9Ev",
+		"did:pkh:bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6",
+		"did:pkh:tezos:NetXdQprcVkpaWU:tz1TzrmTBSuiVHV2VfMnGRMYvTEPCP42oSM8",
+	}
+
+	for _, did := range tests {
+		t.Run(did, func(t *testing.T) {
+			a, err := ParseDIDPKH(did)
+			require.NoError(t, err)
+			assert.Equal(t, did[len(DIDPKHPrefix):], a.String())
+			assert.Equal(t, did, ToDID(a))
+		})
+	}
+}
+
+func TestDIDPKHNativeType(t *testing.T) {
+	a, err := ParseDIDPKH("did:pkh:eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a")
+	require.NoError(t, err)
+	_, ok := a.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", a)
+	assert.True(t, IsDIDPKH(ToDID(a)))
+}
+
+func TestParseDIDPKHInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"empty", "", ErrEmptyValue},
+		{"other method", "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", ErrInvalidFormat},
+		{"bare caip10", "eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a", ErrInvalidFormat},
+		{"fragment", "did:pkh:eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a#blockchainAccountId", ErrInvalidFormat},
+		{"path", "did:pkh:eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a/path", ErrInvalidFormat},
+		{"invalid reference", "did:pkh:eip155:mainnet:0xB9C5714089478a327F09197987f16f9E5d936E8a", ErrInvalidReference},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDIDPKH(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+
+	assert.Panics(t, func() { MustParseDIDPKH("did:key:abc") })
+}
+
+func TestToDIDZeroValue(t *testing.T) {
+	var a *GenericAccountID
+	assert.Equal(t, "", a.ToDID())
+	assert.Equal(t, "", (&GenericAccountID{}).ToDID())
+}
@@ -0,0 +1,74 @@
+package caip10
+
+import "strings"
+
+// DisplayStyle selects how Format renders an account for UIs and logs.
+type DisplayStyle int
+
+const (
+	DisplayShort     DisplayStyle = iota // truncated address, e.g. 0xab16…fcdb
+	DisplayShortFull                     // chain ID and truncated address, e.g. eip155:1:0xab16…fcdb
+	DisplayAddress                       // full address
+	DisplayFull                          // full CAIP-10 string
+)
+
+const (
+	displayKeep      = 4  // characters kept on each side of the ellipsis
+	displayMinLength = 16 // addresses no longer than this are never truncated
+	displayEllipsis  = "…"
+)
+
+// Format renders an account in the given display style. The address is truncated
+// in the middle, keeping the first and last 4 characters after any well-known
+// prefix: "0x" for hex addresses and the human-readable part of bech32 addresses,
+// e.g. bc1q…f3t4. Human-readable names (Antelope, Hedera and named NEAR accounts)
+// are never truncated. A nil or zero account renders as "".
+func Format(a AccountID, style DisplayStyle) string {
+	if a == nil || a.IsZero() {
+		return ""
+	}
+	switch style {
+	case DisplayShortFull:
+		return a.ChainID().String() + ":" + shortenAddress(a.Namespace(), a.Address())
+	case DisplayAddress:
+		return a.Address()
+	case DisplayFull:
+		return a.String()
+	default:
+		return shortenAddress(a.Namespace(), a.Address())
+	}
+}
+
+// Short returns the truncated address, e.g. 0xab16…fcdb. See Format.
+func (a *GenericAccountID) Short() string {
+	return Format(a, DisplayShort)
+}
+
+// shortenAddress truncates an address in the middle per namespace rules.
+func shortenAddress(ns Namespace, address string) string {
+	switch ns {
+	case NamespaceAntelope, NamespaceHedera:
+		return address
+	case NamespaceNEAR:
+		if !IsNEARImplicitAccount(address) {
+			return address
+		}
+	}
+	prefix := displayPrefix(address)
+	body := address[len(prefix):]
+	if len(body) <= displayMinLength {
+		return address
+	}
+	return prefix + body[:displayKeep] + displayEllipsis + body[len(body)-displayKeep:]
+}
+
+// displayPrefix returns the part of an address kept before the truncated body.
+func displayPrefix(address string) string {
+	if len(address) > 2 && (strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X")) {
+		return address[:2]
+	}
+	if hrp, _, _, err := decodeBech32(address, 0); err == nil {
+		return address[:len(hrp)+1]
+	}
+	return ""
+}
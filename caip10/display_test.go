@@ -0,0 +1,40 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	evm := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	assert.Equal(t, "0xab16…fcdb", Format(evm, DisplayShort))
+	assert.Equal(t, "eip155:1:0xab16…fcdb", Format(evm, DisplayShortFull))
+	assert.Equal(t, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", Format(evm, DisplayAddress))
+	assert.Equal(t, evm.String(), Format(evm, DisplayFull))
+	assert.Equal(t, "0xab16…fcdb", Format(evm, DisplayShort))
+
+	assert.Equal(t, "", Format(nil, DisplayFull))
+	assert.Equal(t, "", (*GenericAccountID)(nil).Short())
+}
+
+func TestShortPerNamespace(t *testing.T) {
+	tests := []struct {
+		ns        Namespace
+		reference string
+		address   string
+		want      string
+	}{
+		{NamespaceSolana, "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp", "7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", "7S3P…AaKv"},
+		{NamespaceBIP122, "000000000019d6689c085ae165831e93", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "bc1qw50…f3t4"},
+		{NamespaceHedera, "mainnet", "0.0.1234567", "0.0.1234567"},
+		{NamespaceAntelope, "aca376f206b8fc25a6ed44dbdc66547c", "eosio.token", "eosio.token"},
+		{NamespaceNEAR, "mainnet", "alice-and-bob-and-carol.near", "alice-and-bob-and-carol.near"},
+		{NamespaceNEAR, "mainnet", "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de", "9879…d6de"},
+		{NamespaceEIP155, "1", "0x1234", "0x1234"},
+	}
+	for _, tt := range tests {
+		a := newGenericUnchecked(tt.ns, tt.reference, tt.address)
+		assert.Equal(t, tt.want, a.Short(), tt.address)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/donutnomad/eths/ecommon"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const NamespaceEIP155 Namespace = "eip155"
@@ -36,8 +37,10 @@ type EIP155AccountID interface {
 // Ensure eip155AccountID implements EIP155AccountID at compile time
 var _ EIP155AccountID = (*eip155AccountID)(nil)
 
-func init() {
-	RegisterParser(&eip155Parser{})
+// NewEIP155Parser returns the parser of eip155 account IDs, registered by
+// package eip155reg.
+func NewEIP155Parser() Parser {
+	return &eip155Parser{}
 }
 
 // eip155AccountID represents an Ethereum account ID per CAIP-10.
@@ -62,6 +65,11 @@ func NewEIP155[C eip155ChainID](chainID C, address ecommon.Address) EIP155Accoun
 	}
 }
 
+// NewEIP155FromGeth creates a new EIP155AccountID from a go-ethereum address.
+func NewEIP155FromGeth[C eip155ChainID](chainID C, address common.Address) EIP155AccountID {
+	return NewEIP155(chainID, ecommon.Address(address))
+}
+
 // NewEIP155FromHex creates a new EIP155AccountID from a chain ID and hex address string.
 func NewEIP155FromHex[C eip155ChainID](chainID C, hexAddress string) EIP155AccountID {
 	id, err := NewEIP155FromHexValidation(chainID, hexAddress)
@@ -75,7 +83,7 @@ func NewEIP155FromHexValidation[C eip155ChainID](chainID C, hexAddress string) (
 	hexAddress = strings.TrimPrefix(strings.ToLower(hexAddress), "0x")
 	decodeString, err := hex.DecodeString(hexAddress)
 	if err != nil {
-		return nil, fmt.Errorf("hex decode %s, failed: %w", hexAddress, err)
+		return nil, fmt.Errorf("hex decode %s, failed: %w: %w", hexAddress, ErrInvalidAddress, err)
 	}
 	if len(decodeString) != ecommon.AddressLength {
 		return nil, fmt.Errorf("hex decode %s, length, failed: %w", hexAddress, ErrInvalidAddress)
@@ -84,6 +92,57 @@ func NewEIP155FromHexValidation[C eip155ChainID](chainID C, hexAddress string) (
 	return NewEIP155(chainID, addr), nil
 }
 
+// NewEIP155FromHexStrict is like NewEIP155FromHexValidation but also rejects
+// mixed-case addresses whose casing is not a valid EIP-55 checksum.
+// All-lowercase and all-uppercase addresses carry no checksum and are accepted.
+func NewEIP155FromHexStrict[C eip155ChainID](chainID C, hexAddress string) (EIP155AccountID, error) {
+	id, err := NewEIP155FromHexValidation(chainID, hexAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateEIP55Checksum(hexAddress); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// ValidateEIP55Checksum checks the EIP-55 mixed-case checksum of a hex address.
+// All-lowercase and all-uppercase addresses carry no checksum and are accepted.
+// https://eips.ethereum.org/EIPS/eip-55
+func ValidateEIP55Checksum(hexAddress string) error {
+	if !ecommon.IsHexAddress(hexAddress) {
+		return fmt.Errorf("%w: not a hex address %q", ErrInvalidAddress, hexAddress)
+	}
+	digits := strings.TrimPrefix(strings.TrimPrefix(hexAddress, "0x"), "0X")
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return nil
+	}
+	if want := ecommon.HexToAddress(hexAddress).Hex(); digits != want[2:] {
+		return fmt.Errorf("%w: EIP-55 checksum mismatch, expected %s", ErrInvalidChecksum, want)
+	}
+	return nil
+}
+
+// ParseEIP155Strict parses an eip155 CAIP-10 string, rejecting addresses with an
+// invalid EIP-55 checksum. See NewEIP155FromHexStrict.
+func ParseEIP155Strict(s string) (EIP155AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceEIP155 {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceEIP155, ns)
+	}
+	id, err := newEIP155FromReference(ref, addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateEIP55Checksum(addr); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
 // newEIP155FromReference creates EIP155AccountID from string reference (used by parser).
 func newEIP155FromReference(reference, hexAddress string) (EIP155AccountID, error) {
 	chainID, ok := new(big.Int).SetString(reference, 10)
@@ -101,6 +160,44 @@ func (a *eip155AccountID) Account() ecommon.Address {
 	return a.ethAddr
 }
 
+// GethAccount returns the address as a go-ethereum common.Address.
+func (a *eip155AccountID) GethAccount() common.Address {
+	if a == nil {
+		return common.Address{}
+	}
+	return common.Address(a.ethAddr)
+}
+
+// ChecksumAddress returns the EIP-55 checksummed hex address.
+func (a *eip155AccountID) ChecksumAddress() string {
+	if a == nil {
+		return ""
+	}
+	return a.ethAddr.Hex()
+}
+
+// eip155Native returns a as an *eip155AccountID, converting other EIP155AccountID
+// implementations. Nil and zero accounts return nil.
+func eip155Native(a EIP155AccountID) *eip155AccountID {
+	if n, ok := a.(*eip155AccountID); ok {
+		return n
+	}
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	return NewEIP155(a.EIP155ChainID(), a.Account()).(*eip155AccountID)
+}
+
+// GethAccount returns the address of a as a go-ethereum common.Address.
+func GethAccount(a EIP155AccountID) common.Address {
+	return eip155Native(a).GethAccount()
+}
+
+// ChecksumAddress returns the EIP-55 checksummed hex address of a.
+func ChecksumAddress(a EIP155AccountID) string {
+	return eip155Native(a).ChecksumAddress()
+}
+
 // EIP155ChainID returns the chain ID as *big.Int.
 func (a *eip155AccountID) EIP155ChainID() *big.Int {
 	if a == nil || a.chainID == nil {
@@ -142,6 +239,15 @@ func (a *eip155AccountID) Equal(other AccountID) bool {
 	return a.GenericAccountID.Equal(other)
 }
 
+// Format implements fmt.Formatter; %+v and %#v include the numeric chain ID.
+func (a *eip155AccountID) Format(f fmt.State, verb rune) {
+	if a == nil || a.GenericAccountID == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	formatAccount(f, verb, a, formatField{"chainID", a.EIP155ChainID()})
+}
+
 // --- eip155Parser ---
 
 type eip155Parser struct{}
@@ -0,0 +1,81 @@
+package caip10
+
+import (
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// maxEIP155Precompile is the highest precompile address on Ethereum mainnet:
+// 0x01-0x09 up to Istanbul, 0x0a (KZG point evaluation) since Cancun and
+// 0x0b-0x11 (BLS12-381) since Prague.
+const maxEIP155Precompile = 0x11
+
+// eip155BurnAddresses are addresses without a known private key that are
+// commonly used to burn tokens, besides the zero address.
+var eip155BurnAddresses = map[ecommon.Address]struct{}{
+	ecommon.HexToAddress("0x000000000000000000000000000000000000dEaD"): {},
+	ecommon.HexToAddress("0xdEAD000000000000000042069420694206942069"): {},
+	ecommon.HexToAddress("0xdead000000000000000000000000000000000000"): {},
+}
+
+// IsZeroAddress reports whether the account is the zero address 0x000...000.
+func (a *eip155AccountID) IsZeroAddress() bool {
+	return a != nil && a.ethAddr == ecommon.Address{}
+}
+
+// IsPrecompile reports whether the account is an Ethereum precompiled contract,
+// 0x01 through 0x11. Chain-specific precompiles, such as those of Arbitrum, are
+// not included.
+func (a *eip155AccountID) IsPrecompile() bool {
+	if a == nil {
+		return false
+	}
+	for _, b := range a.ethAddr[:ecommon.AddressLength-1] {
+		if b != 0 {
+			return false
+		}
+	}
+	last := a.ethAddr[ecommon.AddressLength-1]
+	return last >= 1 && last <= maxEIP155Precompile
+}
+
+// IsBurnAddress reports whether the account is the zero address or a common
+// burn address such as 0x000...dEaD.
+func (a *eip155AccountID) IsBurnAddress() bool {
+	if a == nil {
+		return false
+	}
+	if a.IsZeroAddress() {
+		return true
+	}
+	_, ok := eip155BurnAddresses[a.ethAddr]
+	return ok
+}
+
+// WellKnownContract returns the metadata of the ERC-20 token registered at the
+// account's address and chain, e.g. WETH or USDC. See RegisterTokenMetadata.
+func (a *eip155AccountID) WellKnownContract() (TokenMetadata, bool) {
+	if a.IsZero() || a.chainID == nil {
+		return TokenMetadata{}, false
+	}
+	return LookupTokenMetadata(NewERC20Asset(a.chainID, a.ethAddr))
+}
+
+// IsZeroAddress reports whether a is the zero address.
+func IsZeroAddress(a EIP155AccountID) bool {
+	return eip155Native(a).IsZeroAddress()
+}
+
+// IsPrecompile reports whether a is an Ethereum precompiled contract.
+func IsPrecompile(a EIP155AccountID) bool {
+	return eip155Native(a).IsPrecompile()
+}
+
+// IsBurnAddress reports whether a is the zero address or a common burn address.
+func IsBurnAddress(a EIP155AccountID) bool {
+	return eip155Native(a).IsBurnAddress()
+}
+
+// WellKnownContract returns the metadata of the token registered at a.
+func WellKnownContract(a EIP155AccountID) (TokenMetadata, bool) {
+	return eip155Native(a).WellKnownContract()
+}
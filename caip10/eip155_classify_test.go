@@ -0,0 +1,62 @@
+package caip10
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEIP155Classify(t *testing.T) {
+	tests := []struct {
+		address    string
+		zero       bool
+		precompile bool
+		burn       bool
+	}{
+		{"0x0000000000000000000000000000000000000000", true, false, true},
+		{"0x0000000000000000000000000000000000000001", false, true, false},
+		{"0x000000000000000000000000000000000000000a", false, true, false},
+		{"0x0000000000000000000000000000000000000011", false, true, false},
+		{"0x0000000000000000000000000000000000000012", false, false, false},
+		{"0x0000000000000000000000000000000000000101", false, false, false},
+		{"0x000000000000000000000000000000000000dEaD", false, false, true},
+		{"0xdEAD000000000000000042069420694206942069", false, false, true},
+		{"0xdEad000000000000000000000000000000000000", false, false, true},
+		{"0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			a := NewEIP155FromHex(1, tt.address)
+			assert.Equal(t, tt.zero, IsZeroAddress(a), "IsZeroAddress")
+			assert.Equal(t, tt.precompile, IsPrecompile(a), "IsPrecompile")
+			assert.Equal(t, tt.burn, IsBurnAddress(a), "IsBurnAddress")
+		})
+	}
+
+	var a *eip155AccountID
+	assert.False(t, a.IsZeroAddress())
+	assert.False(t, a.IsPrecompile())
+	assert.False(t, a.IsBurnAddress())
+}
+
+func TestEIP155WellKnownContract(t *testing.T) {
+	weth := MustParse("eip155:1:0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2").(EIP155AccountID)
+	meta, ok := WellKnownContract(weth)
+	require.True(t, ok)
+	assert.Equal(t, "WETH", meta.Symbol)
+
+	usdc := NewEIP155FromHex(8453, "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	meta, ok = WellKnownContract(usdc)
+	require.True(t, ok)
+	assert.Equal(t, "USDC", meta.Symbol)
+
+	// same address on another chain
+	_, ok = WellKnownContract(usdc.SetChainID(big.NewInt(1)))
+	assert.False(t, ok)
+
+	var a *eip155AccountID
+	_, ok = a.WellKnownContract()
+	assert.False(t, ok)
+}
@@ -2,10 +2,12 @@ package caip10
 
 import (
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/donutnomad/eths/ecommon"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/fxamacker/cbor/v2"
 )
 
@@ -91,6 +93,26 @@ func TestEIP155NewFromAddress(t *testing.T) {
 	}
 }
 
+func TestEIP155Geth(t *testing.T) {
+	addr := common.HexToAddress("0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+
+	a := NewEIP155FromGeth(137, addr)
+	if got := a.String(); got != "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb" {
+		t.Errorf("String: got %q", got)
+	}
+	if GethAccount(a) != addr {
+		t.Errorf("GethAccount: got %s, want %s", GethAccount(a), addr)
+	}
+	if !a.Equal(NewEIP155FromHex(137, addr.Hex())) {
+		t.Errorf("NewEIP155FromGeth(%s) != NewEIP155FromHex", addr)
+	}
+
+	var nilID *eip155AccountID
+	if got := nilID.GethAccount(); got != (common.Address{}) {
+		t.Errorf("GethAccount on nil: got %s", got)
+	}
+}
+
 func TestEIP155JSON(t *testing.T) {
 	a := NewEIP155FromHex(1, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
 
@@ -372,3 +394,47 @@ func TestEIP155MaxChainID(t *testing.T) {
 		t.Errorf("chain ID should be capped to max value")
 	}
 }
+
+func TestEIP155Strict(t *testing.T) {
+	valid := []string{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb",
+		"eip155:1:0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB",
+	}
+	for _, s := range valid {
+		a, err := ParseEIP155Strict(s)
+		if err != nil {
+			t.Errorf("ParseEIP155Strict(%q): unexpected error %v", s, err)
+			continue
+		}
+		if got := ChecksumAddress(a); got != "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb" {
+			t.Errorf("ChecksumAddress: got %q", got)
+		}
+	}
+
+	invalid := []string{
+		"eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", // bad checksum
+		"eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfc",   // too short
+		"solana:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb",
+	}
+	for _, s := range invalid {
+		if _, err := ParseEIP155Strict(s); err == nil {
+			t.Errorf("ParseEIP155Strict(%q): expected error", s)
+		}
+	}
+
+	if _, err := NewEIP155FromHexStrict(1, "0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("NewEIP155FromHexStrict: got %v, want ErrInvalidAddress", err)
+	}
+	// the lenient constructor still accepts any casing
+	if _, err := NewEIP155FromHexValidation(1, "0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"); err != nil {
+		t.Errorf("NewEIP155FromHexValidation: unexpected error %v", err)
+	}
+}
+
+func TestEIP155ChecksumAddressNil(t *testing.T) {
+	var a *eip155AccountID
+	if got := a.ChecksumAddress(); got != "" {
+		t.Errorf("ChecksumAddress on nil: got %q", got)
+	}
+}
@@ -0,0 +1,15 @@
+// Package eip155reg registers the eip155 parser of package caip10, so that
+// eip155 account IDs are parsed as caip10.EIP155AccountID:
+//
+//	import _ "github.com/donutnomad/xchain/caip10/eip155reg"
+//
+// caip10 itself still registers the parser unless built with the caip10_optin
+// tag. That registration is deprecated; import this package to keep eip155
+// parsing when it is removed.
+package eip155reg
+
+import "github.com/donutnomad/xchain/caip10"
+
+func init() {
+	caip10.RegisterParser(caip10.NewEIP155Parser())
+}
@@ -0,0 +1,20 @@
+package eip155reg_test
+
+import (
+	"testing"
+
+	"github.com/donutnomad/xchain/caip10"
+	"github.com/donutnomad/xchain/caip10/caip10test"
+	_ "github.com/donutnomad/xchain/caip10/eip155reg"
+)
+
+func TestRegistered(t *testing.T) {
+	a, err := caip10.Parse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := a.(caip10.EIP155AccountID); !ok {
+		t.Errorf("Parse() = %T, want caip10.EIP155AccountID", a)
+	}
+	caip10test.TestParser(t, caip10.NewEIP155Parser())
+}
@@ -0,0 +1,135 @@
+package caip10
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// EIP-3770 chain-specific addresses: shortName:address (e.g. "eth:0xab16...").
+// https://eips.ethereum.org/EIPS/eip-3770
+
+// EIP3770ShortNameRegex validates EIP-3770 chain short names.
+var EIP3770ShortNameRegex = regexp.MustCompile(`^[-_a-zA-Z0-9]{1,32}$`)
+
+// defaultEIP3770ShortNames are the short names from the ethereum-lists chain registry
+// (https://github.com/ethereum-lists/chains) of well-known networks.
+var defaultEIP3770ShortNames = []struct {
+	shortName string
+	chainID   ChainID
+}{
+	{"eth", ChainIDEthereumMainnet},
+	{"gor", NewEIP155ChainID(5)},
+	{"sep", ChainIDEthereumSepolia},
+	{"oeth", ChainIDOptimism},
+	{"arb1", ChainIDArbitrumOne},
+	{"arb-nova", ChainIDArbitrumNova},
+	{"arb-sep", ChainIDArbitrumSepolia},
+	{"base", ChainIDBase},
+	{"basesep", ChainIDBaseSepolia},
+	{"matic", ChainIDPolygon},
+	{"zksync", ChainIDZkSyncEra},
+	{"linea", ChainIDLinea},
+	{"scr", ChainIDScroll},
+	{"bnb", ChainIDBSC},
+	{"avax", ChainIDAvalanche},
+	{"gno", ChainIDGnosis},
+}
+
+var (
+	eip3770Mu         sync.RWMutex
+	eip3770ShortNames = map[string]ChainID{}
+	eip3770ChainNames = map[ChainID]string{}
+)
+
+func init() {
+	for _, d := range defaultEIP3770ShortNames {
+		eip3770ShortNames[d.shortName] = d.chainID
+		eip3770ChainNames[d.chainID] = d.shortName
+	}
+}
+
+// RegisterEIP3770ShortName registers or replaces the short name of an eip155 chain.
+// The short name becomes the chain's preferred name for FormatEIP3770.
+func RegisterEIP3770ShortName(shortName string, chainID ChainID) error {
+	if !EIP3770ShortNameRegex.MatchString(shortName) {
+		return fmt.Errorf("%w: eip3770 short name must match [-_a-zA-Z0-9]{1,32}, got %q", ErrInvalidFormat, shortName)
+	}
+	if chainID.Namespace != NamespaceEIP155 {
+		return fmt.Errorf("%w: eip3770 short names apply to %q chains, got %q", ErrInvalidNamespace, NamespaceEIP155, chainID.Namespace)
+	}
+	if err := chainID.Validate(); err != nil {
+		return err
+	}
+
+	eip3770Mu.Lock()
+	defer eip3770Mu.Unlock()
+	if old, ok := eip3770ShortNames[shortName]; ok && eip3770ChainNames[old] == shortName {
+		delete(eip3770ChainNames, old)
+	}
+	eip3770ShortNames[shortName] = chainID
+	eip3770ChainNames[chainID] = shortName
+	return nil
+}
+
+// LookupEIP3770ShortName returns the chain registered under a short name.
+func LookupEIP3770ShortName(shortName string) (ChainID, bool) {
+	eip3770Mu.RLock()
+	defer eip3770Mu.RUnlock()
+	c, ok := eip3770ShortNames[shortName]
+	return c, ok
+}
+
+// EIP3770ShortName returns the short name registered for a chain.
+func EIP3770ShortName(chainID ChainID) (string, bool) {
+	eip3770Mu.RLock()
+	defer eip3770Mu.RUnlock()
+	s, ok := eip3770ChainNames[chainID]
+	return s, ok
+}
+
+// ParseEIP3770 parses a chain-specific address such as "eth:0xab16...".
+func ParseEIP3770(s string) (EIP155AccountID, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	shortName, address, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing eip3770 short name separator", ErrInvalidFormat)
+	}
+	chainID, ok := LookupEIP3770ShortName(shortName)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown eip3770 short name %q", ErrInvalidReference, shortName)
+	}
+	if !strings.HasPrefix(address, "0x") {
+		return nil, fmt.Errorf("%w: eip3770 address must start with 0x", ErrInvalidAddress)
+	}
+	return newEIP155FromReference(chainID.Reference, address)
+}
+
+// MustParseEIP3770 parses a chain-specific address and panics if invalid.
+func MustParseEIP3770(s string) EIP155AccountID {
+	a, err := ParseEIP3770(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// FormatEIP3770 returns the chain-specific address form, e.g. "eth:0xab16...".
+func (a *eip155AccountID) FormatEIP3770() (string, error) {
+	if a.IsZero() {
+		return "", ErrEmptyValue
+	}
+	shortName, ok := EIP3770ShortName(a.ChainID())
+	if !ok {
+		return "", fmt.Errorf("%w: no eip3770 short name registered for %s", ErrInvalidReference, a.ChainID())
+	}
+	return shortName + ":" + a.Address(), nil
+}
+
+// FormatEIP3770 returns the chain-specific address form of a, e.g. "eth:0xab16...".
+func FormatEIP3770(a EIP155AccountID) (string, error) {
+	return eip155Native(a).FormatEIP3770()
+}
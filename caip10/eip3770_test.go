@@ -0,0 +1,92 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEIP3770(t *testing.T) {
+	const addr = "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	tests := []struct {
+		input  string
+		want   string
+		format string
+	}{
+		{"eth:" + addr, "eip155:1:" + addr, "eth:" + addr},
+		{"gor:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", "eip155:5:" + addr, "gor:" + addr},
+		{"arb1:" + addr, "eip155:42161:" + addr, "arb1:" + addr},
+		{"matic:" + addr, "eip155:137:" + addr, "matic:" + addr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			a, err := ParseEIP3770(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.String())
+
+			formatted, err := FormatEIP3770(a)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, formatted)
+		})
+	}
+}
+
+func TestParseEIP3770Invalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"", ErrEmptyValue},
+		{"0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ErrInvalidFormat},
+		{"nope:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ErrInvalidReference},
+		{"eth:ab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ErrInvalidAddress},
+		{"eth:0x1234", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseEIP3770(tt.input)
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestRegisterEIP3770ShortName(t *testing.T) {
+	chainID := NewEIP155ChainID(999999991)
+	a := NewEIP155FromHex(999999991, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+
+	_, err := FormatEIP3770(a)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+
+	require.NoError(t, RegisterEIP3770ShortName("tst", chainID))
+	c, ok := LookupEIP3770ShortName("tst")
+	assert.True(t, ok)
+	assert.Equal(t, chainID, c)
+
+	s, err := FormatEIP3770(a)
+	require.NoError(t, err)
+	assert.Equal(t, "tst:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", s)
+
+	// re-registering a short name moves it to the new chain
+	other := NewEIP155ChainID(999999992)
+	require.NoError(t, RegisterEIP3770ShortName("tst", other))
+	_, ok = EIP3770ShortName(chainID)
+	assert.False(t, ok)
+	name, ok := EIP3770ShortName(other)
+	assert.True(t, ok)
+	assert.Equal(t, "tst", name)
+
+	err = RegisterEIP3770ShortName("bad name", chainID)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	err = RegisterEIP3770ShortName("sol", ChainIDSolanaMainnet)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestFormatEIP3770Zero(t *testing.T) {
+	var a *eip155AccountID
+	_, err := a.FormatEIP3770()
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
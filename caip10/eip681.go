@@ -0,0 +1,205 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// EIP-681 transaction request URIs, e.g.
+// "ethereum:0xToken@1/transfer?address=0xRecipient&uint256=1e6".
+// https://eips.ethereum.org/EIPS/eip-681
+
+// EIP681Scheme is the URI scheme of EIP-681 requests.
+const EIP681Scheme = "ethereum"
+
+// EIP681Request is a parsed EIP-681 transaction request.
+type EIP681Request struct {
+	Target   EIP155AccountID // recipient of a payment, or the contract called
+	Pay      bool            // the "pay-" prefix was present
+	Function string          // contract function, e.g. "transfer"; empty for a payment
+	Value    *big.Int        // native amount in wei, nil if absent
+	GasLimit *big.Int        // nil if absent
+	GasPrice *big.Int        // nil if absent
+	Params   []EIP681Param   // function parameters, in order
+}
+
+// EIP681Param is a function parameter of an EIP-681 request, keyed by its ABI
+// type, e.g. {Type: "address", Value: "0xab16..."}.
+type EIP681Param struct {
+	Type  string
+	Value string
+}
+
+// NewEIP681Payment returns a request to send value wei to the account.
+func NewEIP681Payment(to EIP155AccountID, value *big.Int) *EIP681Request {
+	return &EIP681Request{Target: to, Value: value}
+}
+
+// NewEIP681ERC20Transfer returns a request to transfer amount of an ERC-20 token
+// to the recipient, on the token's chain.
+func NewEIP681ERC20Transfer(token EIP155AccountID, to ecommon.Address, amount *big.Int) *EIP681Request {
+	return &EIP681Request{
+		Target:   token,
+		Function: "transfer",
+		Params: []EIP681Param{
+			{Type: "address", Value: to.Hex()},
+			{Type: "uint256", Value: amount.String()},
+		},
+	}
+}
+
+// ParseEIP681 parses an EIP-681 URI. The target must be a hex address; ENS names
+// are rejected. Without an "@chain_id" the request is for Ethereum mainnet.
+// Numbers in value, gas, gasLimit and gasPrice may use scientific notation,
+// e.g. "2.014e18", but must be non-negative integers.
+func ParseEIP681(s string) (*EIP681Request, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	scheme, rest, ok := strings.Cut(s, ":")
+	if !ok || !strings.EqualFold(scheme, EIP681Scheme) {
+		return nil, fmt.Errorf("%w: eip681: missing %q scheme", ErrInvalidFormat, EIP681Scheme+":")
+	}
+
+	r := &EIP681Request{}
+	rest, r.Pay = strings.CutPrefix(rest, "pay-")
+	rest, query, _ := strings.Cut(rest, "?")
+	rest, r.Function, _ = strings.Cut(rest, "/")
+	address, reference, hasChain := strings.Cut(rest, "@")
+	if !hasChain {
+		reference = ChainIDEthereumMainnet.Reference
+	} else if !isDecimal(reference) {
+		return nil, fmt.Errorf("%w: eip681: chain ID must be decimal, got %q", ErrInvalidReference, reference)
+	}
+	if !strings.HasPrefix(address, "0x") {
+		return nil, fmt.Errorf("%w: eip681: target must be a hex address, got %q", ErrInvalidAddress, address)
+	}
+	target, err := newEIP155FromReference(reference, address)
+	if err != nil {
+		return nil, err
+	}
+	r.Target = target
+
+	if query == "" {
+		return r, nil
+	}
+	for _, pair := range strings.Split(query, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: eip681: invalid parameter %q", ErrInvalidFormat, pair)
+		}
+		if value, err = url.QueryUnescape(value); err != nil {
+			return nil, fmt.Errorf("%w: eip681: parameter %q: %v", ErrInvalidFormat, key, err)
+		}
+		switch key {
+		case "value":
+			r.Value, err = parseEIP681Number(value)
+		case "gas", "gasLimit":
+			r.GasLimit, err = parseEIP681Number(value)
+		case "gasPrice":
+			r.GasPrice, err = parseEIP681Number(value)
+		default:
+			r.Params = append(r.Params, EIP681Param{Type: key, Value: value})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: eip681: parameter %q: %v", ErrInvalidFormat, key, err)
+		}
+	}
+	return r, nil
+}
+
+// String returns the EIP-681 URI of the request. The chain ID is always included.
+func (r *EIP681Request) String() string {
+	if r == nil || r.Target == nil || r.Target.IsZero() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(EIP681Scheme + ":")
+	if r.Pay {
+		b.WriteString("pay-")
+	}
+	b.WriteString(r.Target.Address())
+	b.WriteString("@" + r.Target.Reference())
+	if r.Function != "" {
+		b.WriteString("/" + r.Function)
+	}
+	sep := byte('?')
+	write := func(key, value string) {
+		b.WriteByte(sep)
+		b.WriteString(key + "=" + url.QueryEscape(value))
+		sep = '&'
+	}
+	for _, p := range r.Params {
+		write(p.Type, p.Value)
+	}
+	if r.Value != nil {
+		write("value", r.Value.String())
+	}
+	if r.GasLimit != nil {
+		write("gasLimit", r.GasLimit.String())
+	}
+	if r.GasPrice != nil {
+		write("gasPrice", r.GasPrice.String())
+	}
+	return b.String()
+}
+
+// ERC20Transfer returns the recipient and amount of an ERC-20 "transfer" request.
+// ok is false if the request is not a well-formed transfer.
+func (r *EIP681Request) ERC20Transfer() (to EIP155AccountID, amount *big.Int, ok bool) {
+	if r == nil || r.Target == nil || r.Function != "transfer" {
+		return nil, nil, false
+	}
+	var address, value string
+	for _, p := range r.Params {
+		switch p.Type {
+		case "address":
+			address = p.Value
+		case "uint256":
+			value = p.Value
+		}
+	}
+	to, err := newEIP155FromReference(r.Target.Reference(), address)
+	if err != nil {
+		return nil, nil, false
+	}
+	if amount, err = parseEIP681Number(value); err != nil {
+		return nil, nil, false
+	}
+	return to, amount, true
+}
+
+// parseEIP681Number parses a non-negative integer in EIP-681 number syntax:
+// digits with an optional fraction and exponent, e.g. "1", "2.014e18".
+func parseEIP681Number(s string) (*big.Int, error) {
+	mantissa, exp, hasExp := strings.Cut(strings.ToLower(s), "e")
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	if (whole == "" && frac == "") || (whole != "" && !isDecimal(whole)) || (frac != "" && !isDecimal(frac)) {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	shift := -len(frac)
+	if hasExp && exp != "" {
+		if !isDecimal(exp) || len(exp) > 4 {
+			return nil, fmt.Errorf("invalid exponent in %q", s)
+		}
+		e, _ := strconv.Atoi(exp)
+		shift += e
+	}
+	digits := whole + frac
+	if shift < 0 {
+		cut := len(digits) + shift
+		if strings.Trim(digits[cut:], "0") != "" {
+			return nil, fmt.Errorf("%q is not an integer", s)
+		}
+		digits = digits[:cut]
+	} else {
+		digits += strings.Repeat("0", shift)
+	}
+	n, _ := new(big.Int).SetString("0"+digits, 10)
+	return n, nil
+}
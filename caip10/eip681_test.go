@@ -0,0 +1,104 @@
+package caip10
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	eip681Token     = "0x89205A3A3b2A69De6Dbf7f01ED13B2108B2c43e7"
+	eip681Recipient = "0x8e23Ee67d1332aD560396262C48ffbB01F93D052"
+)
+
+func TestParseEIP681Payment(t *testing.T) {
+	r, err := ParseEIP681("ethereum:0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359?value=2.014e18")
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1:0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", r.Target.String())
+	assert.False(t, r.Pay)
+	assert.Empty(t, r.Function)
+	assert.Equal(t, "2014000000000000000", r.Value.String())
+	assert.Nil(t, r.GasLimit)
+	assert.Empty(t, r.Params)
+
+	r, err = ParseEIP681("ethereum:pay-0xfb6916095ca1df60bb79Ce92ce3ea74c37c5d359@137?value=1&gas=21000&gasPrice=50e9")
+	require.NoError(t, err)
+	assert.True(t, r.Pay)
+	assert.Equal(t, ChainIDPolygon, r.Target.ChainID())
+	assert.Equal(t, "1", r.Value.String())
+	assert.Equal(t, "21000", r.GasLimit.String())
+	assert.Equal(t, "50000000000", r.GasPrice.String())
+}
+
+func TestParseEIP681Transfer(t *testing.T) {
+	r, err := ParseEIP681("ethereum:" + eip681Token + "@1/transfer?address=" + eip681Recipient + "&uint256=1e6")
+	require.NoError(t, err)
+	assert.Equal(t, "transfer", r.Function)
+	assert.Equal(t, []EIP681Param{{"address", eip681Recipient}, {"uint256", "1e6"}}, r.Params)
+
+	to, amount, ok := r.ERC20Transfer()
+	require.True(t, ok)
+	assert.Equal(t, "eip155:1:"+eip681Recipient, to.String())
+	assert.Equal(t, "1000000", amount.String())
+
+	payment, err := ParseEIP681("ethereum:" + eip681Recipient)
+	require.NoError(t, err)
+	_, _, ok = payment.ERC20Transfer()
+	assert.False(t, ok)
+}
+
+func TestEIP681String(t *testing.T) {
+	token := NewEIP155FromHex(1, eip681Token)
+	r := NewEIP681ERC20Transfer(token, ecommon.HexToAddress(eip681Recipient), big.NewInt(1000000))
+	uri := r.String()
+	assert.Equal(t, "ethereum:"+eip681Token+"@1/transfer?address="+eip681Recipient+"&uint256=1000000", uri)
+
+	back, err := ParseEIP681(uri)
+	require.NoError(t, err)
+	assert.Equal(t, r.Params, back.Params)
+	assert.True(t, token.Equal(back.Target))
+
+	p := NewEIP681Payment(NewEIP155FromHex(137, eip681Recipient), big.NewInt(5))
+	p.Pay = true
+	p.GasLimit = big.NewInt(21000)
+	assert.Equal(t, "ethereum:pay-"+eip681Recipient+"@137?value=5&gasLimit=21000", p.String())
+
+	assert.Equal(t, "", (*EIP681Request)(nil).String())
+}
+
+func TestParseEIP681Errors(t *testing.T) {
+	tests := []struct {
+		input    string
+		sentinel error
+	}{
+		{"", ErrEmptyValue},
+		{"bitcoin:" + eip681Recipient, ErrInvalidFormat},
+		{"ethereum:vitalik.eth", ErrInvalidAddress},
+		{"ethereum:0x1234", ErrInvalidAddress},
+		{"ethereum:" + eip681Recipient + "@x", ErrInvalidReference},
+		{"ethereum:" + eip681Recipient + "?value=1.5", ErrInvalidFormat},
+		{"ethereum:" + eip681Recipient + "?value=-1", ErrInvalidFormat},
+		{"ethereum:" + eip681Recipient + "?value", ErrInvalidFormat},
+		{"ethereum:" + eip681Recipient + "?uint256=%zz", ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		_, err := ParseEIP681(tt.input)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+	}
+}
+
+func TestParseEIP681Number(t *testing.T) {
+	for in, want := range map[string]string{"0": "0", "1": "1", "1e18": "1000000000000000000", "2.5e1": "25", "1.50e1": "15", ".5e1": "5", "007": "7", "1E3": "1000"} {
+		n, err := parseEIP681Number(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, n.String(), in)
+	}
+	for _, in := range []string{"", ".", "e5", "1.5", "1e-3", "0x10", "1e99999"} {
+		_, err := parseEIP681Number(in)
+		assert.Error(t, err, in)
+	}
+}
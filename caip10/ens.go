@@ -0,0 +1,238 @@
+package caip10
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+	"golang.org/x/crypto/sha3"
+)
+
+// ENS (Ethereum Name Service) name resolution.
+// https://docs.ens.domains/resolution
+
+// ENSRegistryAddress is the ENS registry address on Ethereum mainnet and its testnets.
+var ENSRegistryAddress = ecommon.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+// ENS function selectors
+var (
+	ensSelectorResolver     = []byte{0x01, 0x78, 0xb8, 0xbf} // resolver(bytes32)
+	ensSelectorAddr         = []byte{0x3b, 0x3b, 0x57, 0xde} // addr(bytes32)
+	ensSelectorAddrCoinType = []byte{0xf1, 0xcb, 0x7e, 0x06} // addr(bytes32,uint256)
+	ensSelectorName         = []byte{0x69, 0x1f, 0x34, 0x31} // name(bytes32)
+)
+
+// ensEVMCoinTypeFlag marks an ENSIP-11 coin type as an EVM chain ID.
+// The flag alone is the default coin type shared by all EVM chains (ENSIP-19).
+const ensEVMCoinTypeFlag = 0x80000000
+
+// ENSNamehash computes the EIP-137 namehash of a name.
+// Names must already be normalized (ENSIP-15); labels are hashed as given.
+func ENSNamehash(name string) [32]byte {
+	var node [32]byte
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		label := keccak256([]byte(labels[i]))
+		node = [32]byte(keccak256(node[:], label))
+	}
+	return node
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// Ensure ENSResolver implements Resolver at compile time
+var _ Resolver = (*ENSResolver)(nil)
+
+// ENSResolver resolves ENS names through an EthCaller connected to Ethereum mainnet
+// (or a testnet with the ENS registry deployed at ENSRegistryAddress).
+//
+// Resolution on other eip155 chains uses the ENSIP-11 chain coin type, falling back to
+// the ENSIP-19 default EVM address. Offchain (CCIP-Read) resolvers are not supported.
+type ENSResolver struct {
+	caller   EthCaller
+	registry ecommon.Address
+}
+
+// NewENSResolver creates an ENSResolver using the mainnet registry.
+func NewENSResolver(caller EthCaller) *ENSResolver {
+	return &ENSResolver{caller: caller, registry: ENSRegistryAddress}
+}
+
+// NewENSResolverFromURL creates an ENSResolver using an eth JSON-RPC endpoint.
+func NewENSResolverFromURL(url string) *ENSResolver {
+	return NewENSResolver(NewEthJSONRPC(url))
+}
+
+// WithRegistry returns a copy of the resolver using a different registry address.
+func (r *ENSResolver) WithRegistry(registry ecommon.Address) *ENSResolver {
+	return &ENSResolver{caller: r.caller, registry: registry}
+}
+
+// Resolve returns the address of an ENS name on an eip155 chain.
+func (r *ENSResolver) Resolve(ctx context.Context, name string, chainID ChainID) (AccountID, error) {
+	if chainID.Namespace != NamespaceEIP155 {
+		return nil, fmt.Errorf("%w: ens resolves %q chains, got %q", ErrInvalidNamespace, NamespaceEIP155, chainID)
+	}
+	id, ok := new(big.Int).SetString(chainID.Reference, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid chain ID %q", ErrInvalidReference, chainID.Reference)
+	}
+	name = strings.ToLower(name)
+	node := ENSNamehash(name)
+	resolver, err := r.resolver(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	if resolver == (ecommon.Address{}) {
+		return nil, fmt.Errorf("%w: ens name %q has no resolver", ErrNameNotFound, name)
+	}
+
+	var addr []byte
+	if id.Cmp(big.NewInt(1)) == 0 {
+		out, err := r.caller.CallContract(ctx, resolver, ensCallData(ensSelectorAddr, node[:]))
+		if err != nil {
+			return nil, err
+		}
+		addr, err = abiAddress(out)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !id.IsUint64() || id.Uint64() >= ensEVMCoinTypeFlag {
+			return nil, fmt.Errorf("%w: chain ID %s has no ens coin type", ErrInvalidReference, id)
+		}
+		for _, coinType := range []uint64{ensEVMCoinTypeFlag | id.Uint64(), ensEVMCoinTypeFlag} {
+			addr, err = r.addrForCoinType(ctx, resolver, node, coinType)
+			if err != nil {
+				return nil, err
+			}
+			if len(addr) > 0 {
+				break
+			}
+		}
+	}
+	if len(addr) == 0 || bytes.Equal(addr, make([]byte, ecommon.AddressLength)) {
+		return nil, fmt.Errorf("%w: ens name %q has no address on %s", ErrNameNotFound, name, chainID)
+	}
+	if len(addr) != ecommon.AddressLength {
+		return nil, fmt.Errorf("%w: ens name %q has a %d byte address", ErrInvalidAddress, name, len(addr))
+	}
+	return NewEIP155(id, ecommon.BytesToAddress(addr)), nil
+}
+
+// ReverseResolve returns the primary ENS name of an eip155 account. The reverse record
+// is read from the mainnet reverse registrar and verified by forward resolution.
+func (r *ENSResolver) ReverseResolve(ctx context.Context, account AccountID) (string, error) {
+	if account == nil || account.IsZero() {
+		return "", ErrEmptyValue
+	}
+	if account.Namespace() != NamespaceEIP155 {
+		return "", fmt.Errorf("%w: ens resolves %q accounts, got %q", ErrInvalidNamespace, NamespaceEIP155, account.Namespace())
+	}
+	addr := ecommon.HexToAddress(account.Address())
+	node := ENSNamehash(hex.EncodeToString(addr[:]) + ".addr.reverse")
+	resolver, err := r.resolver(ctx, node)
+	if err != nil {
+		return "", err
+	}
+	if resolver == (ecommon.Address{}) {
+		return "", fmt.Errorf("%w: no ens reverse record for %s", ErrNameNotFound, addr.Hex())
+	}
+	out, err := r.caller.CallContract(ctx, resolver, ensCallData(ensSelectorName, node[:]))
+	if err != nil {
+		return "", err
+	}
+	name, err := abiBytes(out)
+	if err != nil {
+		return "", err
+	}
+	if len(name) == 0 {
+		return "", fmt.Errorf("%w: no ens reverse record for %s", ErrNameNotFound, addr.Hex())
+	}
+
+	forward, err := r.Resolve(ctx, string(name), ChainIDEthereumMainnet)
+	if err != nil {
+		return "", err
+	}
+	if ecommon.HexToAddress(forward.Address()) != addr {
+		return "", fmt.Errorf("%w: ens name %q does not resolve back to %s", ErrNameNotFound, name, addr.Hex())
+	}
+	return string(name), nil
+}
+
+func (r *ENSResolver) resolver(ctx context.Context, node [32]byte) (ecommon.Address, error) {
+	out, err := r.caller.CallContract(ctx, r.registry, ensCallData(ensSelectorResolver, node[:]))
+	if err != nil {
+		return ecommon.Address{}, err
+	}
+	addr, err := abiAddress(out)
+	if err != nil {
+		return ecommon.Address{}, err
+	}
+	return ecommon.BytesToAddress(addr), nil
+}
+
+func (r *ENSResolver) addrForCoinType(ctx context.Context, resolver ecommon.Address, node [32]byte, coinType uint64) ([]byte, error) {
+	var word [32]byte
+	binary.BigEndian.PutUint64(word[24:], coinType)
+	out, err := r.caller.CallContract(ctx, resolver, ensCallData(ensSelectorAddrCoinType, node[:], word[:]))
+	if err != nil {
+		return nil, err
+	}
+	return abiBytes(out)
+}
+
+// ensCallData concatenates a selector and 32-byte ABI words.
+func ensCallData(selector []byte, words ...[]byte) []byte {
+	data := append([]byte{}, selector...)
+	for _, w := range words {
+		data = append(data, w...)
+	}
+	return data
+}
+
+// abiAddress decodes an ABI encoded address return value.
+func abiAddress(out []byte) ([]byte, error) {
+	if len(out) < 32 {
+		return nil, fmt.Errorf("%w: short abi address result (%d bytes)", ErrInvalidFormat, len(out))
+	}
+	return out[12:32], nil
+}
+
+// abiBytes decodes an ABI encoded bytes or string return value.
+func abiBytes(out []byte) ([]byte, error) {
+	return abiBytesAt(out, 0)
+}
+
+// abiBytesAt decodes the ABI encoded bytes or string whose offset is the word
+// at index of a tuple.
+func abiBytesAt(out []byte, index int) ([]byte, error) {
+	head := 32 * index
+	if len(out) < head+64 {
+		return nil, fmt.Errorf("%w: short abi bytes result (%d bytes)", ErrInvalidFormat, len(out))
+	}
+	offset := new(big.Int).SetBytes(out[head : head+32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(out)-32) {
+		return nil, fmt.Errorf("%w: invalid abi bytes offset", ErrInvalidFormat)
+	}
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(out[start-32 : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(out))-start {
+		return nil, fmt.Errorf("%w: invalid abi bytes length", ErrInvalidFormat)
+	}
+	return out[start : start+length.Uint64()], nil
+}
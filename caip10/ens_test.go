@@ -0,0 +1,169 @@
+package caip10
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEthCaller answers eth_call requests from a table keyed by contract and calldata.
+type fakeEthCaller struct {
+	results map[string][]byte
+}
+
+func (f *fakeEthCaller) key(to ecommon.Address, data []byte) string {
+	return to.Hex() + ":" + hex.EncodeToString(data)
+}
+
+func (f *fakeEthCaller) set(to ecommon.Address, data, result []byte) {
+	if f.results == nil {
+		f.results = map[string][]byte{}
+	}
+	f.results[f.key(to, data)] = result
+}
+
+func (f *fakeEthCaller) CallContract(_ context.Context, to ecommon.Address, data []byte) ([]byte, error) {
+	if out, ok := f.results[f.key(to, data)]; ok {
+		return out, nil
+	}
+	// unset records behave like an empty return value
+	return make([]byte, 64), nil
+}
+
+func abiEncodeAddress(a ecommon.Address) []byte {
+	out := make([]byte, 32)
+	copy(out[12:], a[:])
+	return out
+}
+
+func abiEncodeBytes(b []byte) []byte {
+	out := make([]byte, 64+(len(b)+31)/32*32)
+	out[31] = 0x20
+	binary.BigEndian.PutUint64(out[56:64], uint64(len(b)))
+	copy(out[64:], b)
+	return out
+}
+
+func coinTypeWord(coinType uint64) []byte {
+	w := make([]byte, 32)
+	binary.BigEndian.PutUint64(w[24:], coinType)
+	return w
+}
+
+func TestENSNamehash(t *testing.T) {
+	// EIP-137 test vectors
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"eth", "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae"},
+		{"foo.eth", "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := ENSNamehash(tt.name)
+			assert.Equal(t, tt.want, hex.EncodeToString(node[:]))
+		})
+	}
+}
+
+func TestENSSelectors(t *testing.T) {
+	assert.Equal(t, keccak256([]byte("resolver(bytes32)"))[:4], ensSelectorResolver)
+	assert.Equal(t, keccak256([]byte("addr(bytes32)"))[:4], ensSelectorAddr)
+	assert.Equal(t, keccak256([]byte("addr(bytes32,uint256)"))[:4], ensSelectorAddrCoinType)
+	assert.Equal(t, keccak256([]byte("name(bytes32)"))[:4], ensSelectorName)
+}
+
+func newTestENS(t *testing.T) (*ENSResolver, ecommon.Address) {
+	t.Helper()
+	resolver := ecommon.HexToAddress("0x231b0Ee14048e9dCcD1d247744d114a4EB5E8E63")
+	vitalik := ecommon.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	base := ecommon.HexToAddress("0x00000000000000000000000000000000000b45e0")
+
+	f := &fakeEthCaller{}
+	node := ENSNamehash("vitalik.eth")
+	f.set(ENSRegistryAddress, ensCallData(ensSelectorResolver, node[:]), abiEncodeAddress(resolver))
+	f.set(resolver, ensCallData(ensSelectorAddr, node[:]), abiEncodeAddress(vitalik))
+	f.set(resolver, ensCallData(ensSelectorAddrCoinType, node[:], coinTypeWord(0x80000000|8453)), abiEncodeBytes(base[:]))
+	f.set(resolver, ensCallData(ensSelectorAddrCoinType, node[:], coinTypeWord(0x80000000)), abiEncodeBytes(vitalik[:]))
+
+	reverse := ENSNamehash("d8da6bf26964af9d7eed9e03e53415d37aa96045.addr.reverse")
+	f.set(ENSRegistryAddress, ensCallData(ensSelectorResolver, reverse[:]), abiEncodeAddress(resolver))
+	f.set(resolver, ensCallData(ensSelectorName, reverse[:]), abiEncodeBytes([]byte("vitalik.eth")))
+
+	// reverse record claiming a name that resolves elsewhere
+	liar := ecommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	liarNode := ENSNamehash("1111111111111111111111111111111111111111.addr.reverse")
+	f.set(ENSRegistryAddress, ensCallData(ensSelectorResolver, liarNode[:]), abiEncodeAddress(resolver))
+	f.set(resolver, ensCallData(ensSelectorName, liarNode[:]), abiEncodeBytes([]byte("vitalik.eth")))
+
+	return NewENSResolver(f), liar
+}
+
+func TestENSResolve(t *testing.T) {
+	r, _ := newTestENS(t)
+	ctx := context.Background()
+
+	a, err := r.Resolve(ctx, "vitalik.eth", ChainIDEthereumMainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1:0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", a.String())
+	_, ok := a.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", a)
+
+	a, err = r.Resolve(ctx, "Vitalik.eth", ChainIDBase)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:8453:0x00000000000000000000000000000000000b45e0", a.String())
+
+	// falls back to the default EVM address
+	a, err = r.Resolve(ctx, "vitalik.eth", ChainIDOptimism)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:10:0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", a.String())
+
+	_, err = r.Resolve(ctx, "nobody.eth", ChainIDEthereumMainnet)
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.Resolve(ctx, "vitalik.eth", ChainIDSolanaMainnet)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	_, err = r.Resolve(ctx, "vitalik.eth", NewEIP155ChainID(1<<32))
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+}
+
+func TestENSReverseResolve(t *testing.T) {
+	r, liar := newTestENS(t)
+	ctx := context.Background()
+
+	name, err := r.ReverseResolve(ctx, MustParse("eip155:1:0xd8da6bf26964af9d7eed9e03e53415d37aa96045"))
+	require.NoError(t, err)
+	assert.Equal(t, "vitalik.eth", name)
+
+	_, err = r.ReverseResolve(ctx, NewEIP155(1, liar))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.ReverseResolve(ctx, NewEIP155FromHex(1, "0x2222222222222222222222222222222222222222"))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.ReverseResolve(ctx, nil)
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
+
+func TestENSResolverERC7828(t *testing.T) {
+	r, _ := newTestENS(t)
+	a, err := ResolveERC7828(context.Background(), "vitalik.eth@ethereum", r.Resolve)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1:0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", a.String())
+}
+
+func TestABIBytesInvalid(t *testing.T) {
+	_, err := abiBytes(make([]byte, 10))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+
+	out := abiEncodeBytes([]byte("vitalik.eth"))
+	out[63] = 0xff // length past the end
+	_, err = abiBytes(out)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
@@ -0,0 +1,63 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// ERC-6551 token bound accounts.
+// https://eips.ethereum.org/EIPS/eip-6551
+
+// ERC6551RegistryAddress is the canonical ERC-6551 registry (v0.3), deployed at
+// the same address on every chain.
+var ERC6551RegistryAddress = ecommon.HexToAddress("0x000000006551c19487814612e58FE06813775758")
+
+// ERC-1167 minimal proxy around the account implementation, followed by the
+// ABI encoded (salt, chainId, tokenContract, tokenId) footer.
+var (
+	erc6551ProxyPrefix = []byte{
+		0x3d, 0x60, 0xad, 0x80, 0x60, 0x0a, 0x3d, 0x39, 0x81, 0xf3,
+		0x36, 0x3d, 0x3d, 0x37, 0x3d, 0x3d, 0x3d, 0x36, 0x3d, 0x73,
+	}
+	erc6551ProxySuffix = []byte{
+		0x5a, 0xf4, 0x3d, 0x82, 0x80, 0x3e, 0x90, 0x3d, 0x91, 0x60, 0x2b, 0x57, 0xfd, 0x5b, 0xf3,
+	}
+)
+
+// ComputeERC6551Account returns the token bound account of an ERC-721 token
+// created by registry on chainID, as the registry's account function does. The
+// account chain may differ from the chain of the token; the token chain and
+// contract are taken from nft, which must have a token ID. Use
+// ERC6551RegistryAddress for the canonical registry.
+func ComputeERC6551Account[C eip155ChainID](chainID C, registry, implementation ecommon.Address, nft ERC721AssetID, salt [32]byte) (EIP155AccountID, error) {
+	if nft == nil || nft.IsZero() {
+		return nil, fmt.Errorf("%w: token bound account requires an NFT", ErrEmptyValue)
+	}
+	tokenID := nft.TokenID()
+	if tokenID == nil {
+		return nil, fmt.Errorf("%w: token bound account requires a token ID, got collection %s", ErrInvalidTokenID, nft)
+	}
+	code := erc6551CreationCode(implementation, salt, nft.EIP155ChainID(), nft.Contract(), tokenID)
+	account := NewEIP155(chainID, create2Address(registry, salt, keccak256(code)))
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// erc6551CreationCode returns the account creation code deployed by the registry.
+func erc6551CreationCode(implementation ecommon.Address, salt [32]byte, chainID *big.Int, tokenContract ecommon.Address, tokenID *big.Int) []byte {
+	var footer [128]byte
+	copy(footer[:32], salt[:])
+	chainID.FillBytes(footer[32:64])
+	copy(footer[76:96], tokenContract[:])
+	tokenID.FillBytes(footer[96:128])
+
+	code := make([]byte, 0, len(erc6551ProxyPrefix)+ecommon.AddressLength+len(erc6551ProxySuffix)+len(footer))
+	code = append(code, erc6551ProxyPrefix...)
+	code = append(code, implementation[:]...)
+	code = append(code, erc6551ProxySuffix...)
+	return append(code, footer[:]...)
+}
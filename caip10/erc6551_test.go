@@ -0,0 +1,56 @@
+package caip10
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC6551CreationCode(t *testing.T) {
+	impl := ecommon.HexToAddress("0x55266d75D1a14E4572138116aF39863Ed6596E7F")
+	token := ecommon.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D")
+	code := erc6551CreationCode(impl, [32]byte{31: 7}, big.NewInt(1), token, big.NewInt(42))
+
+	// The constructor copies 0xad bytes of runtime code from offset 0x0a
+	require.Len(t, code, 0x0a+0xad)
+	assert.Equal(t, impl[:], code[20:40])
+	footer := code[len(code)-128:]
+	assert.Equal(t, byte(7), footer[31])
+	assert.Equal(t, byte(1), footer[63])
+	assert.Equal(t, token[:], footer[76:96])
+	assert.Equal(t, byte(42), footer[127])
+}
+
+func TestComputeERC6551Account(t *testing.T) {
+	impl := ecommon.HexToAddress("0x55266d75D1a14E4572138116aF39863Ed6596E7F")
+	nft := NewERC721Asset(1, ecommon.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), big.NewInt(42))
+
+	account, err := ComputeERC6551Account(1, ERC6551RegistryAddress, impl, nft, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, ChainIDEthereumMainnet, account.ChainID())
+	code := erc6551CreationCode(impl, [32]byte{}, big.NewInt(1), nft.Contract(), big.NewInt(42))
+	assert.Equal(t, create2Address(ERC6551RegistryAddress, [32]byte{}, keccak256(code)), account.Account())
+
+	// The account chain changes only the chain of the account ID
+	onBase, err := ComputeERC6551Account(8453, ERC6551RegistryAddress, impl, nft, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, account.Account(), onBase.Account())
+	assert.Equal(t, ChainIDBase, onBase.ChainID())
+
+	// Token ID and salt select different accounts
+	other, err := ComputeERC6551Account(1, ERC6551RegistryAddress, impl, nft.SetTokenID(big.NewInt(43)), [32]byte{})
+	require.NoError(t, err)
+	assert.NotEqual(t, account.Account(), other.Account())
+	salted, err := ComputeERC6551Account(1, ERC6551RegistryAddress, impl, nft, [32]byte{31: 1})
+	require.NoError(t, err)
+	assert.NotEqual(t, account.Account(), salted.Account())
+
+	_, err = ComputeERC6551Account(1, ERC6551RegistryAddress, impl, nft.SetTokenID(nil), [32]byte{})
+	assert.True(t, errors.Is(err, ErrInvalidTokenID), "got %v", err)
+	_, err = ComputeERC6551Account(1, ERC6551RegistryAddress, impl, nil, [32]byte{})
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
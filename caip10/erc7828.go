@@ -0,0 +1,184 @@
+package caip10
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ERC-7828 interoperable addresses: address@chain (e.g. "0xab16...@ethereum", "alice.eth@base").
+// https://eips.ethereum.org/EIPS/eip-7828
+
+// ChainLabelRegex validates human-readable chain labels.
+var ChainLabelRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,30}[a-z0-9])?$`)
+
+// defaultChainLabels are the human-readable labels of well-known eip155 chains.
+var defaultChainLabels = []struct {
+	label   string
+	chainID ChainID
+}{
+	{"ethereum", ChainIDEthereumMainnet},
+	{"sepolia", ChainIDEthereumSepolia},
+	{"hoodi", ChainIDEthereumHoodi},
+	{"optimism", ChainIDOptimism},
+	{"arbitrum", ChainIDArbitrumOne},
+	{"arbitrum-nova", ChainIDArbitrumNova},
+	{"base", ChainIDBase},
+	{"polygon", ChainIDPolygon},
+	{"zksync", ChainIDZkSyncEra},
+	{"linea", ChainIDLinea},
+	{"scroll", ChainIDScroll},
+	{"bsc", ChainIDBSC},
+	{"avalanche", ChainIDAvalanche},
+	{"gnosis", ChainIDGnosis},
+}
+
+var (
+	chainLabelsMu sync.RWMutex
+	chainLabels   = map[string]ChainID{}
+	chainIDLabels = map[ChainID]string{}
+)
+
+func init() {
+	for _, d := range defaultChainLabels {
+		chainLabels[d.label] = d.chainID
+		chainIDLabels[d.chainID] = d.label
+	}
+}
+
+// RegisterChainLabel registers or replaces the human-readable label of a chain.
+// The label becomes the chain's preferred label for FormatERC7828.
+func RegisterChainLabel(label string, chainID ChainID) error {
+	if !ChainLabelRegex.MatchString(label) {
+		return fmt.Errorf("%w: chain label must be lower case alphanumeric with dashes, got %q", ErrInvalidFormat, label)
+	}
+	if err := validateAssetChainID(chainID); err != nil {
+		return err
+	}
+
+	chainLabelsMu.Lock()
+	defer chainLabelsMu.Unlock()
+	if old, ok := chainLabels[label]; ok && chainIDLabels[old] == label {
+		delete(chainIDLabels, old)
+	}
+	chainLabels[label] = chainID
+	chainIDLabels[chainID] = label
+	return nil
+}
+
+// LookupChainLabel returns the chain registered under a label.
+func LookupChainLabel(label string) (ChainID, bool) {
+	chainLabelsMu.RLock()
+	defer chainLabelsMu.RUnlock()
+	c, ok := chainLabels[label]
+	return c, ok
+}
+
+// ChainLabel returns the label registered for a chain.
+func ChainLabel(chainID ChainID) (string, bool) {
+	chainLabelsMu.RLock()
+	defer chainLabelsMu.RUnlock()
+	s, ok := chainIDLabels[chainID]
+	return s, ok
+}
+
+// resolveERC7828Chain resolves the chain part of an interoperable address.
+// Lookup order: chain label, EIP-3770 short name, CAIP-2 chain ID.
+func resolveERC7828Chain(chain string) (ChainID, error) {
+	if c, ok := LookupChainLabel(chain); ok {
+		return c, nil
+	}
+	if c, ok := LookupEIP3770ShortName(chain); ok {
+		return c, nil
+	}
+	if strings.Contains(chain, ":") {
+		return ParseChainID(chain)
+	}
+	return ChainID{}, fmt.Errorf("%w: unknown chain %q", ErrInvalidReference, chain)
+}
+
+// NameResolveFunc resolves a name such as "alice.eth" to an account on a chain.
+type NameResolveFunc func(ctx context.Context, name string, chainID ChainID) (AccountID, error)
+
+// ParseERC7828 parses an interoperable address with a hex address, e.g. "0xab16...@arbitrum".
+// Use ResolveERC7828 for names.
+func ParseERC7828(s string) (EIP155AccountID, error) {
+	return ResolveERC7828(context.Background(), s, nil)
+}
+
+// MustParseERC7828 parses an interoperable address and panics if invalid.
+func MustParseERC7828(s string) EIP155AccountID {
+	a, err := ParseERC7828(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ResolveERC7828 parses an interoperable address, e.g. "alice.eth@ethereum" or "0xab16...@base".
+// Names are resolved with resolve on the target chain; a nil resolve rejects names.
+//
+// The chain part is matched against the chain labels, then the EIP-3770 short names,
+// then parsed as a CAIP-2 chain ID. Only eip155 chains are supported.
+func ResolveERC7828(ctx context.Context, s string, resolve NameResolveFunc) (EIP155AccountID, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	i := strings.LastIndexByte(s, '@')
+	if i < 1 || i == len(s)-1 {
+		return nil, fmt.Errorf("%w: interoperable address must be address@chain, got %q", ErrInvalidFormat, s)
+	}
+	target, chain := s[:i], s[i+1:]
+	if strings.Contains(chain, "#") {
+		return nil, fmt.Errorf("%w: interoperable address checksums are not supported", ErrInvalidFormat)
+	}
+
+	chainID, err := resolveERC7828Chain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if chainID.Namespace != NamespaceEIP155 {
+		return nil, fmt.Errorf("%w: interoperable addresses require an %q chain, got %q", ErrInvalidNamespace, NamespaceEIP155, chainID)
+	}
+
+	if strings.HasPrefix(target, "0x") {
+		return newEIP155FromReference(chainID.Reference, target)
+	}
+	if !strings.Contains(target, ".") {
+		return nil, fmt.Errorf("%w: %q is neither a hex address nor a name", ErrInvalidAddress, target)
+	}
+	if resolve == nil {
+		return nil, fmt.Errorf("%w: no resolver for name %q", ErrInvalidAddress, target)
+	}
+	a, err := resolve(ctx, target, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil || a.IsZero() {
+		return nil, fmt.Errorf("%w: name %q resolved to no account", ErrInvalidAddress, target)
+	}
+	if a.Namespace() != NamespaceEIP155 {
+		return nil, fmt.Errorf("%w: name %q resolved to %s account", ErrInvalidNamespace, target, a.Namespace())
+	}
+	return newEIP155FromReference(chainID.Reference, a.Address())
+}
+
+// FormatERC7828 returns the interoperable address form, e.g. "0xab16...@ethereum".
+// The chain is written as its label, or as the CAIP-2 chain ID if no label is registered.
+func (a *eip155AccountID) FormatERC7828() (string, error) {
+	if a.IsZero() {
+		return "", ErrEmptyValue
+	}
+	chain, ok := ChainLabel(a.ChainID())
+	if !ok {
+		chain = a.ChainID().String()
+	}
+	return a.Address() + "@" + chain, nil
+}
+
+// FormatERC7828 returns the interoperable address form of a, e.g. "0xab16...@ethereum".
+func FormatERC7828(a EIP155AccountID) (string, error) {
+	return eip155Native(a).FormatERC7828()
+}
@@ -0,0 +1,115 @@
+package caip10
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseERC7828(t *testing.T) {
+	const addr = "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{addr + "@ethereum", "eip155:1:" + addr},
+		{"0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb@arbitrum", "eip155:42161:" + addr},
+		{addr + "@oeth", "eip155:10:" + addr},
+		{addr + "@eip155:8453", "eip155:8453:" + addr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			a, err := ParseERC7828(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.String())
+		})
+	}
+}
+
+func TestParseERC7828Invalid(t *testing.T) {
+	const addr = "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"", ErrEmptyValue},
+		{addr, ErrInvalidFormat},
+		{"@ethereum", ErrInvalidFormat},
+		{addr + "@", ErrInvalidFormat},
+		{addr + "@ethereum#4CA88C9C", ErrInvalidFormat},
+		{addr + "@nowhere", ErrInvalidReference},
+		{addr + "@solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp", ErrInvalidNamespace},
+		{"0x1234@ethereum", ErrInvalidAddress},
+		{"alice@ethereum", ErrInvalidAddress},
+		{"alice.eth@ethereum", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseERC7828(tt.input)
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestResolveERC7828(t *testing.T) {
+	resolve := func(_ context.Context, name string, chainID ChainID) (AccountID, error) {
+		switch name {
+		case "alice.eth":
+			return MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"), nil
+		case "sol.eth":
+			return MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"), nil
+		default:
+			return nil, ErrInvalidAddress
+		}
+	}
+
+	a, err := ResolveERC7828(context.Background(), "alice.eth@base", resolve)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:8453:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", a.String())
+
+	_, err = ResolveERC7828(context.Background(), "bob.eth@base", resolve)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	_, err = ResolveERC7828(context.Background(), "sol.eth@base", resolve)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestFormatERC7828(t *testing.T) {
+	a := NewEIP155FromHex(42161, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	s, err := FormatERC7828(a)
+	require.NoError(t, err)
+	assert.Equal(t, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb@arbitrum", s)
+
+	back, err := ParseERC7828(s)
+	require.NoError(t, err)
+	assert.True(t, a.Equal(back))
+
+	unlabeled := NewEIP155FromHex(999999993, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	s, err = FormatERC7828(unlabeled)
+	require.NoError(t, err)
+	assert.Equal(t, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb@eip155:999999993", s)
+}
+
+func TestRegisterChainLabel(t *testing.T) {
+	chainID := NewEIP155ChainID(999999994)
+	require.NoError(t, RegisterChainLabel("testchain", chainID))
+
+	c, ok := LookupChainLabel("testchain")
+	assert.True(t, ok)
+	assert.Equal(t, chainID, c)
+	label, ok := ChainLabel(chainID)
+	assert.True(t, ok)
+	assert.Equal(t, "testchain", label)
+
+	err := RegisterChainLabel("Test Chain", chainID)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	err = RegisterChainLabel("-test", chainID)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	err = RegisterChainLabel("test", ChainID{Namespace: "eip155", Reference: "abc"})
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+}
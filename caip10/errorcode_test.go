@@ -0,0 +1,57 @@
+package caip10
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  []ParseOption
+		code  string
+	}{
+		{"", nil, CodeEmptyValue},
+		{"eip155", nil, CodeInvalidFormat},
+		{"EIP155:1:0xab", nil, CodeInvalidNamespace},
+		{"unknown:1:0xab", []ParseOption{WithPolicy(ValidationStrict)}, CodeUnknownNamespace},
+		{"eip155:1.5:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil, CodeInvalidReference},
+		{"eip155:1:0xzz", nil, CodeInvalidAddress},
+		{"eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", []ParseOption{WithPolicy(ValidationStrict)}, CodeInvalidChecksum},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input, tt.opts...)
+		require.Error(t, err, tt.input)
+		assert.Equal(t, tt.code, ErrorCode(err), "%q: got %v", tt.input, err)
+
+		var pe *ParseError
+		require.True(t, errors.As(err, &pe), tt.input)
+		assert.Equal(t, tt.code, pe.ErrorCode(), tt.input)
+	}
+
+	assert.Equal(t, "", ErrorCode(nil))
+	assert.Equal(t, "", ErrorCode(errors.New("other")))
+	assert.Equal(t, CodeInvalidTokenID, ErrorCode(fmt.Errorf("wrapped: %w", ErrInvalidTokenID)))
+}
+
+func TestErrorCodeParents(t *testing.T) {
+	_, err := Parse("eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", WithPolicy(ValidationStrict))
+	assert.True(t, errors.Is(err, ErrInvalidChecksum), "got %v", err)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	var pe *ParseError
+	require.True(t, errors.As(err, &pe))
+	assert.Equal(t, ComponentAddress, pe.Component)
+	assert.NotContains(t, pe.Rule, "caip10:")
+
+	_, err = Parse("unknown:1:0xab", WithPolicy(ValidationStrict))
+	assert.True(t, errors.Is(err, ErrUnknownNamespace), "got %v", err)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	assert.False(t, errors.Is(ErrInvalidNamespace, ErrUnknownNamespace))
+
+	_, err = ParseMany([]string{"eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"}, WithPolicy(ValidationStrict))
+	assert.Equal(t, CodeInvalidChecksum, ErrorCode(err))
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 // Validation constraints per CAIP-10 spec
@@ -14,6 +15,18 @@ const (
 	ReferenceMaxLen = 32
 	AddressMinLen   = 1
 	AddressMaxLen   = 128
+
+	// Maximum length of a CAIP-10 account ID and a CAIP-2 chain ID string
+	AccountIDMaxLen = NamespaceMaxLen + 1 + ReferenceMaxLen + 1 + AddressMaxLen
+	ChainIDMaxLen   = NamespaceMaxLen + 1 + ReferenceMaxLen
+
+	// CAIP-19 asset constraints
+	AssetNamespaceMinLen = 3
+	AssetNamespaceMaxLen = 8
+	AssetReferenceMinLen = 1
+	AssetReferenceMaxLen = 128
+	TokenIDMinLen        = 1
+	TokenIDMaxLen        = 78
 )
 
 // Validation regex patterns per CAIP-10/CAIP-2 spec
@@ -21,17 +34,98 @@ var (
 	NamespaceRegex = regexp.MustCompile(`^[-a-z0-9]{3,8}$`)
 	ReferenceRegex = regexp.MustCompile(`^[-_a-zA-Z0-9]{1,32}$`)
 	AddressRegex   = regexp.MustCompile(`^[-.%a-zA-Z0-9]{1,128}$`)
+
+	// CAIP-19 asset components
+	AssetNamespaceRegex = regexp.MustCompile(`^[-a-z0-9]{3,8}$`)
+	AssetReferenceRegex = regexp.MustCompile(`^[-.%a-zA-Z0-9]{1,128}$`)
+	TokenIDRegex        = regexp.MustCompile(`^[-.%a-zA-Z0-9]{1,78}$`)
 )
 
-// Common errors
+// Common errors. Each carries a stable code, see ErrorCode.
 var (
-	ErrInvalidFormat    = errors.New("caip10: invalid account ID format")
-	ErrInvalidNamespace = errors.New("caip10: invalid namespace")
-	ErrInvalidReference = errors.New("caip10: invalid reference")
-	ErrInvalidAddress   = errors.New("caip10: invalid address")
-	ErrEmptyValue       = errors.New("caip10: empty value")
+	ErrInvalidFormat    = newCodedError("caip10: invalid account ID format", CodeInvalidFormat, nil)
+	ErrInvalidNamespace = newCodedError("caip10: invalid namespace", CodeInvalidNamespace, nil)
+	ErrInvalidReference = newCodedError("caip10: invalid reference", CodeInvalidReference, nil)
+	ErrInvalidAddress   = newCodedError("caip10: invalid address", CodeInvalidAddress, nil)
+	ErrEmptyValue       = newCodedError("caip10: empty value", CodeEmptyValue, nil)
+
+	// ErrUnknownNamespace is a namespace without a registered parser. It matches ErrInvalidNamespace.
+	ErrUnknownNamespace = newCodedError("caip10: unknown namespace", CodeUnknownNamespace, ErrInvalidNamespace)
+	// ErrInvalidChecksum is an address with a bad checksum. It matches ErrInvalidAddress.
+	ErrInvalidChecksum = newCodedError("caip10: invalid address checksum", CodeInvalidChecksum, ErrInvalidAddress)
+
+	ErrInvalidAssetNamespace = newCodedError("caip10: invalid asset namespace", CodeInvalidAssetNamespace, nil)
+	ErrInvalidAssetReference = newCodedError("caip10: invalid asset reference", CodeInvalidAssetReference, nil)
+	ErrInvalidTokenID        = newCodedError("caip10: invalid token id", CodeInvalidTokenID, nil)
+	ErrUnknownAsset          = newCodedError("caip10: unknown asset", CodeUnknownAsset, nil)
+	ErrAssetMismatch         = newCodedError("caip10: asset mismatch", CodeAssetMismatch, nil)
+
+	ErrInvalidSignature   = newCodedError("caip10: invalid signature", CodeInvalidSignature, nil)
+	ErrMessageExpired     = newCodedError("caip10: message expired", CodeMessageExpired, nil)
+	ErrMessageNotYetValid = newCodedError("caip10: message not yet valid", CodeMessageNotYetValid, nil)
+	ErrMessageMismatch    = newCodedError("caip10: message does not match expected value", CodeMessageMismatch, nil)
+
+	ErrUnsatisfiedNamespaces = newCodedError("caip10: unsatisfied session namespaces", CodeUnsatisfiedNamespaces, nil)
+
+	ErrNameNotFound = newCodedError("caip10: name not found", CodeNameNotFound, nil)
+
+	ErrInvalidBlock       = newCodedError("caip10: invalid block", CodeInvalidBlock, nil)
+	ErrInvalidTransaction = newCodedError("caip10: invalid transaction", CodeInvalidTransaction, nil)
+
+	ErrInvalidDerivationPath = newCodedError("caip10: invalid derivation path", CodeInvalidDerivationPath, nil)
+)
+
+// Error codes returned by ErrorCode. They are stable and safe to expose to clients.
+const (
+	CodeInvalidFormat         = "CAIP10_INVALID_FORMAT"
+	CodeInvalidNamespace      = "CAIP10_INVALID_NAMESPACE"
+	CodeUnknownNamespace      = "CAIP10_UNKNOWN_NAMESPACE"
+	CodeInvalidReference      = "CAIP10_INVALID_REFERENCE"
+	CodeInvalidAddress        = "CAIP10_INVALID_ADDRESS"
+	CodeInvalidChecksum       = "CAIP10_INVALID_CHECKSUM"
+	CodeEmptyValue            = "CAIP10_EMPTY_VALUE"
+	CodeInvalidAssetNamespace = "CAIP10_INVALID_ASSET_NAMESPACE"
+	CodeInvalidAssetReference = "CAIP10_INVALID_ASSET_REFERENCE"
+	CodeInvalidTokenID        = "CAIP10_INVALID_TOKEN_ID"
+	CodeUnknownAsset          = "CAIP10_UNKNOWN_ASSET"
+	CodeAssetMismatch         = "CAIP10_ASSET_MISMATCH"
+	CodeInvalidSignature      = "CAIP10_INVALID_SIGNATURE"
+	CodeMessageExpired        = "CAIP10_MESSAGE_EXPIRED"
+	CodeMessageNotYetValid    = "CAIP10_MESSAGE_NOT_YET_VALID"
+	CodeMessageMismatch       = "CAIP10_MESSAGE_MISMATCH"
+	CodeUnsatisfiedNamespaces = "CAIP10_UNSATISFIED_NAMESPACES"
+	CodeNameNotFound          = "CAIP10_NAME_NOT_FOUND"
+	CodeInvalidBlock          = "CAIP10_INVALID_BLOCK"
+	CodeInvalidTransaction    = "CAIP10_INVALID_TRANSACTION"
+	CodeInvalidDerivationPath = "CAIP10_INVALID_DERIVATION_PATH"
 )
 
+// codedError is a sentinel error with a code. A sentinel with a parent also
+// matches the parent with errors.Is.
+type codedError struct {
+	msg    string
+	code   string
+	parent error
+}
+
+func newCodedError(msg, code string, parent error) error {
+	return &codedError{msg: msg, code: code, parent: parent}
+}
+
+func (e *codedError) Error() string     { return e.msg }
+func (e *codedError) ErrorCode() string { return e.code }
+func (e *codedError) Unwrap() error     { return e.parent }
+
+// ErrorCode returns the code of the most specific package error that err wraps,
+// e.g. CodeInvalidChecksum, or an empty string if err wraps none.
+func ErrorCode(err error) string {
+	var coded interface{ ErrorCode() string }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return ""
+}
+
 // SplitCAIP2 splits a CAIP-2 chain ID string into namespace and reference.
 // Format: namespace:reference
 func SplitCAIP2(s string) (namespace, reference string, err error) {
@@ -89,3 +183,40 @@ func SplitCAIP10(s string) (namespace Namespace, reference, address string, err
 
 	return namespace, reference, address, nil
 }
+
+// SplitCAIP19 splits a CAIP-19 asset ID string into its components.
+// Format: namespace:reference/asset_namespace:asset_reference[/token_id]
+func SplitCAIP19(s string) (chainID string, assetNamespace AssetNamespace, assetReference, tokenID string, err error) {
+	if len(s) == 0 {
+		return "", "", "", "", ErrEmptyValue
+	}
+
+	// Find the slash separating the chain ID from the asset type
+	i := strings.IndexByte(s, '/')
+	if i < 0 {
+		return "", "", "", "", fmt.Errorf("%w: missing asset type separator", ErrInvalidFormat)
+	}
+	chainID = s[:i]
+	rest := s[i+1:]
+
+	// Optional token ID after the second slash
+	if j := strings.IndexByte(rest, '/'); j >= 0 {
+		tokenID = rest[j+1:]
+		rest = rest[:j]
+		if strings.IndexByte(tokenID, '/') >= 0 {
+			return "", "", "", "", fmt.Errorf("%w: unexpected slash in token id", ErrInvalidFormat)
+		}
+		if tokenID == "" {
+			return "", "", "", "", fmt.Errorf("%w: empty token id", ErrInvalidFormat)
+		}
+	}
+
+	k := strings.IndexByte(rest, ':')
+	if k < 0 {
+		return "", "", "", "", fmt.Errorf("%w: missing asset namespace separator", ErrInvalidFormat)
+	}
+	assetNamespace = AssetNamespace(rest[:k])
+	assetReference = rest[k+1:]
+
+	return chainID, assetNamespace, assetReference, tokenID, nil
+}
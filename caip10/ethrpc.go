@@ -0,0 +1,114 @@
+package caip10
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// EthCaller executes read-only contract calls (eth_call) against an Ethereum node.
+type EthCaller interface {
+	CallContract(ctx context.Context, to ecommon.Address, data []byte) ([]byte, error)
+}
+
+// EthCodeCaller is implemented by EthCallers that can execute eth_call without
+// a target address, running code as contract creation code. Such deployless
+// calls let EIP1271Verifier simulate the deployment of counterfactual wallets.
+type EthCodeCaller interface {
+	CallCode(ctx context.Context, code []byte) ([]byte, error)
+}
+
+// Ensure EthJSONRPC implements EthCaller and EthCodeCaller at compile time
+var (
+	_ EthCaller     = (*EthJSONRPC)(nil)
+	_ EthCodeCaller = (*EthJSONRPC)(nil)
+)
+
+// EthJSONRPC is an EthCaller backed by an Ethereum JSON-RPC HTTP endpoint.
+type EthJSONRPC struct {
+	URL    string
+	Client *http.Client // nil uses http.DefaultClient
+	nextID atomic.Uint64
+}
+
+// NewEthJSONRPC creates an EthJSONRPC for the endpoint URL.
+func NewEthJSONRPC(url string) *EthJSONRPC {
+	return &EthJSONRPC{URL: url}
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type ethRPCResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// CallContract executes eth_call on the latest block and returns the decoded result.
+func (c *EthJSONRPC) CallContract(ctx context.Context, to ecommon.Address, data []byte) ([]byte, error) {
+	return c.call(ctx, map[string]string{"to": to.Hex(), "data": "0x" + hex.EncodeToString(data)})
+}
+
+// CallCode executes eth_call of the creation code on the latest block and
+// returns the decoded result.
+func (c *EthJSONRPC) CallCode(ctx context.Context, code []byte) ([]byte, error) {
+	return c.call(ctx, map[string]string{"data": "0x" + hex.EncodeToString(code)})
+}
+
+// call executes eth_call of the transaction on the latest block.
+func (c *EthJSONRPC) call(ctx context.Context, tx map[string]string) ([]byte, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      c.nextID.Add(1),
+		Method:  "eth_call",
+		Params:  []any{tx, "latest"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("eth_call: unexpected HTTP status %s", resp.Status)
+	}
+
+	var out ethRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("eth_call: decode response: %w", err)
+	}
+	if out.Error != nil {
+		return nil, fmt.Errorf("eth_call: rpc error %d: %s", out.Error.Code, out.Error.Message)
+	}
+	result, err := hex.DecodeString(strings.TrimPrefix(out.Result, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("eth_call: invalid hex result: %w", err)
+	}
+	return result, nil
+}
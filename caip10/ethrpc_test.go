@@ -0,0 +1,64 @@
+package caip10
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthJSONRPCCallContract(t *testing.T) {
+	var got jsonRPCRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if got.Method != "eth_call" {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0102"}`))
+	}))
+	defer srv.Close()
+
+	c := NewEthJSONRPC(srv.URL)
+	to := ecommon.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+	out, err := c.CallContract(context.Background(), to, []byte{0xde, 0xad})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, out)
+
+	assert.Equal(t, "2.0", got.JSONRPC)
+	require.Len(t, got.Params, 2)
+	assert.Equal(t, map[string]any{"to": to.Hex(), "data": "0xdead"}, got.Params[0])
+	assert.Equal(t, "latest", got.Params[1])
+
+	// Deployless calls have no target address
+	out, err = c.CallCode(context.Background(), []byte{0x60, 0x00})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, out)
+	assert.Equal(t, map[string]any{"data": "0x6000"}, got.Params[0])
+}
+
+func TestEthJSONRPCErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rpc-error":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`))
+		case "/bad-hex":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xzz"}`))
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/rpc-error", "/bad-hex", "/down"} {
+		t.Run(path, func(t *testing.T) {
+			_, err := NewEthJSONRPC(srv.URL+path).CallContract(context.Background(), ecommon.Address{}, nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ParseInto parses a CAIP-10 string into dst without allocating, for hot paths such
+// as log or stream processing.
+//
+// Only the generic CAIP-10 syntax is checked (as with ValidationLoose); the
+// namespace-specific rules, the ValidationPolicy and hooks are not applied. Call
+// dst.Validate or Parse when the full rules are needed. dst is left unchanged on error.
+// The components of dst share memory with s.
+func ParseInto(dst *GenericAccountID, s string) error {
+	if dst == nil {
+		return ErrEmptyValue
+	}
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return err
+	}
+	if !isCAIP10Namespace(string(ns)) {
+		return fmt.Errorf("%w: must match [-a-z0-9]{3,8}, got %q", ErrInvalidNamespace, ns)
+	}
+	if !isCAIP10Reference(ref) {
+		return fmt.Errorf("%w: must match [-_a-zA-Z0-9]{1,32}, got %q", ErrInvalidReference, ref)
+	}
+	if !isCAIP10Address(addr) {
+		return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,128}, got %q", ErrInvalidAddress, addr)
+	}
+	dst.namespace, dst.reference, dst.address, dst.str = ns, ref, addr, s
+	return nil
+}
+
+// SplitCAIP10Bytes is the []byte variant of SplitCAIP10. The returned slices alias b;
+// no memory is allocated.
+func SplitCAIP10Bytes(b []byte) (namespace, reference, address []byte, err error) {
+	if len(b) == 0 {
+		return nil, nil, nil, ErrEmptyValue
+	}
+	i := bytes.IndexByte(b, ':')
+	if i < 0 {
+		return nil, nil, nil, errMissingNamespaceSeparator
+	}
+	j := bytes.IndexByte(b[i+1:], ':')
+	if j < 0 {
+		return nil, nil, nil, errMissingReferenceSeparator
+	}
+	j += i + 1
+	return b[:i], b[i+1 : j], b[j+1:], nil
+}
+
+// Preallocated so that the split fast path does not allocate on error.
+var (
+	errMissingNamespaceSeparator = fmt.Errorf("%w: missing namespace separator", ErrInvalidFormat)
+	errMissingReferenceSeparator = fmt.Errorf("%w: missing reference separator", ErrInvalidFormat)
+)
+
+// isCAIP10Namespace is an allocation-free equivalent of NamespaceRegex.
+func isCAIP10Namespace(s string) bool {
+	if len(s) < NamespaceMinLen || len(s) > NamespaceMaxLen {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '-' && (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isCAIP10Reference is an allocation-free equivalent of ReferenceRegex.
+func isCAIP10Reference(s string) bool {
+	if len(s) < ReferenceMinLen || len(s) > ReferenceMaxLen {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '-' && c != '_' && !isAlphanumericByte(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCAIP10Address is an allocation-free equivalent of AddressRegex.
+func isCAIP10Address(s string) bool {
+	if len(s) < AddressMinLen || len(s) > AddressMaxLen {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '-' && c != '.' && c != '%' && !isAlphanumericByte(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumericByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
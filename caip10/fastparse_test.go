@@ -0,0 +1,81 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInto(t *testing.T) {
+	var dst GenericAccountID
+	require.NoError(t, ParseInto(&dst, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"))
+	assert.Equal(t, NamespaceEIP155, dst.Namespace())
+	assert.Equal(t, "1", dst.Reference())
+	assert.Equal(t, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", dst.Address())
+	assert.NoError(t, dst.Validate())
+
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"", ErrEmptyValue},
+		{"eip155", ErrInvalidFormat},
+		{"eip155:1", ErrInvalidFormat},
+		{"EIP155:1:0xab", ErrInvalidNamespace},
+		{"eip155:1.0:0xab", ErrInvalidReference},
+		{"eip155:1:", ErrInvalidAddress},
+		{"eip155:1:0x:ab", ErrInvalidAddress},
+	}
+	for _, tt := range tests {
+		err := ParseInto(&dst, tt.input)
+		assert.True(t, errors.Is(err, tt.want), "%q: got %v", tt.input, err)
+	}
+	assert.Equal(t, "1", dst.Reference(), "dst is unchanged on error")
+	assert.True(t, errors.Is(ParseInto(nil, "eip155:1:0xab"), ErrEmptyValue))
+}
+
+func TestParseIntoMatchesRegex(t *testing.T) {
+	inputs := []string{
+		"cosmos:cosmoshub-4:cosmos1abc", "a-b:ref_1:addr.%-x", "ab:ref:addr", "abcdefghi:ref:addr",
+		"foo:" + string(make([]byte, 33)) + ":addr", "foo:bar:b@z",
+	}
+	for _, s := range inputs {
+		ns, ref, addr, err := SplitCAIP10(s)
+		require.NoError(t, err)
+		want := validateCAIP10Syntax(ns, ref, addr) == nil
+		var dst GenericAccountID
+		assert.Equal(t, want, ParseInto(&dst, s) == nil, s)
+	}
+}
+
+func TestParseIntoAllocs(t *testing.T) {
+	var dst GenericAccountID
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ParseInto(&dst, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestSplitCAIP10Bytes(t *testing.T) {
+	ns, ref, addr, err := SplitCAIP10Bytes([]byte("eip155:1:0xab:cd"))
+	require.NoError(t, err)
+	assert.Equal(t, "eip155", string(ns))
+	assert.Equal(t, "1", string(ref))
+	assert.Equal(t, "0xab:cd", string(addr))
+
+	_, _, _, err = SplitCAIP10Bytes(nil)
+	assert.True(t, errors.Is(err, ErrEmptyValue))
+	_, _, _, err = SplitCAIP10Bytes([]byte("eip155"))
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+	_, _, _, err = SplitCAIP10Bytes([]byte("eip155:1"))
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+
+	b := []byte("eip155:1:0xab")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _, _, _ = SplitCAIP10Bytes(b)
+		_, _, _, _ = SplitCAIP10Bytes(b[:6])
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,430 @@
+package caip10
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Filecoin namespaces. "fil" is the registered CAIP namespace; "filecoin" is accepted as an alias.
+// https://github.com/ChainAgnostic/namespaces/tree/main/fil
+const (
+	NamespaceFilecoin      Namespace = "fil"
+	NamespaceFilecoinAlias Namespace = "filecoin"
+)
+
+// FilecoinNetwork represents a Filecoin network (chain reference).
+// The reference is the address network prefix.
+type FilecoinNetwork string
+
+// Filecoin networks
+const (
+	FilecoinMainnet FilecoinNetwork = "f"
+	FilecoinTestnet FilecoinNetwork = "t"
+)
+
+// String returns the network reference string.
+func (n FilecoinNetwork) String() string {
+	return string(n)
+}
+
+// FilecoinProtocol is the address protocol class.
+// https://spec.filecoin.io/appendix/address/
+type FilecoinProtocol byte
+
+const (
+	FilecoinProtocolID        FilecoinProtocol = 0 // f0: actor ID
+	FilecoinProtocolSecp256k1 FilecoinProtocol = 1 // f1: secp256k1 public key hash
+	FilecoinProtocolActor     FilecoinProtocol = 2 // f2: actor address hash
+	FilecoinProtocolBLS       FilecoinProtocol = 3 // f3: BLS public key
+	FilecoinProtocolDelegated FilecoinProtocol = 4 // f4: delegated (e.g. f410 EVM)
+)
+
+// String returns the protocol name.
+func (p FilecoinProtocol) String() string {
+	switch p {
+	case FilecoinProtocolID:
+		return "id"
+	case FilecoinProtocolSecp256k1:
+		return "secp256k1"
+	case FilecoinProtocolActor:
+		return "actor"
+	case FilecoinProtocolBLS:
+		return "bls"
+	case FilecoinProtocolDelegated:
+		return "delegated"
+	default:
+		return "unknown"
+	}
+}
+
+// Filecoin payload sizes
+const (
+	filecoinHashPayloadLength   = 20 // f1 and f2
+	filecoinBLSPayloadLength    = 48 // f3
+	filecoinMaxSubaddressLength = 54 // f4
+	filecoinChecksumLength      = 4
+)
+
+// filecoinEncoding is the unpadded lowercase RFC 4648 base32 alphabet used by Filecoin.
+var filecoinEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+func filecoinChecksum(data []byte) []byte {
+	h, _ := blake2b.New(filecoinChecksumLength, nil)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// FilecoinAddress is a decoded Filecoin address.
+type FilecoinAddress struct {
+	Network  FilecoinNetwork
+	Protocol FilecoinProtocol
+	// ID is the actor ID for f0 addresses, or the address manager actor ID for f4 addresses.
+	ID uint64
+	// Payload is the hash, public key or sub-address; empty for f0 addresses.
+	Payload []byte
+}
+
+// String returns the address in its string form.
+func (a FilecoinAddress) String() string {
+	prefix := string(a.Network) + strconv.Itoa(int(a.Protocol))
+	switch a.Protocol {
+	case FilecoinProtocolID:
+		return prefix + strconv.FormatUint(a.ID, 10)
+	case FilecoinProtocolDelegated:
+		sum := filecoinChecksum(a.checksumInput())
+		return prefix + strconv.FormatUint(a.ID, 10) + "f" + filecoinEncoding.EncodeToString(append(append([]byte{}, a.Payload...), sum...))
+	default:
+		sum := filecoinChecksum(a.checksumInput())
+		return prefix + filecoinEncoding.EncodeToString(append(append([]byte{}, a.Payload...), sum...))
+	}
+}
+
+// checksumInput returns the bytes covered by the checksum: protocol || [leb128 namespace] || payload.
+func (a FilecoinAddress) checksumInput() []byte {
+	b := []byte{byte(a.Protocol)}
+	if a.Protocol == FilecoinProtocolDelegated {
+		b = binary.AppendUvarint(b, a.ID)
+	}
+	return append(b, a.Payload...)
+}
+
+// ParseFilecoinAddress decodes a Filecoin address of any protocol class.
+//
+// Validation steps:
+//  1. Network prefix is f or t, followed by a protocol digit 0-4
+//  2. f0: decimal actor ID without leading zeros, at most 2^63-1
+//  3. f1/f2/f3: base32 payload of the expected length with a valid blake2b-32 checksum
+//  4. f4: decimal namespace, 'f' separator, base32 sub-address (max 54 bytes) with a valid checksum
+func ParseFilecoinAddress(address string) (FilecoinAddress, error) {
+	var addr FilecoinAddress
+	if len(address) < 3 {
+		return addr, fmt.Errorf("%w: filecoin address too short", ErrInvalidAddress)
+	}
+	switch FilecoinNetwork(address[:1]) {
+	case FilecoinMainnet, FilecoinTestnet:
+		addr.Network = FilecoinNetwork(address[:1])
+	default:
+		return addr, fmt.Errorf("%w: unknown filecoin network prefix %q", ErrInvalidAddress, address[:1])
+	}
+	if address[1] < '0' || address[1] > '4' {
+		return addr, fmt.Errorf("%w: unknown filecoin address protocol %q", ErrInvalidAddress, address[1])
+	}
+	addr.Protocol = FilecoinProtocol(address[1] - '0')
+	raw := address[2:]
+
+	switch addr.Protocol {
+	case FilecoinProtocolID:
+		id, err := parseFilecoinUint(raw)
+		if err != nil {
+			return addr, err
+		}
+		addr.ID = id
+		return addr, nil
+	case FilecoinProtocolDelegated:
+		ns, sub, ok := strings.Cut(raw, "f")
+		if !ok {
+			return addr, fmt.Errorf("%w: missing filecoin delegated address separator", ErrInvalidAddress)
+		}
+		id, err := parseFilecoinUint(ns)
+		if err != nil {
+			return addr, err
+		}
+		addr.ID = id
+		raw = sub
+	}
+
+	data, err := filecoinEncoding.DecodeString(raw)
+	if err != nil || len(data) < filecoinChecksumLength {
+		return addr, fmt.Errorf("%w: invalid filecoin base32 payload", ErrInvalidAddress)
+	}
+	addr.Payload = data[:len(data)-filecoinChecksumLength]
+
+	switch addr.Protocol {
+	case FilecoinProtocolSecp256k1, FilecoinProtocolActor:
+		if len(addr.Payload) != filecoinHashPayloadLength {
+			return addr, fmt.Errorf("%w: filecoin %s payload must be %d bytes, got %d",
+				ErrInvalidAddress, addr.Protocol, filecoinHashPayloadLength, len(addr.Payload))
+		}
+	case FilecoinProtocolBLS:
+		if len(addr.Payload) != filecoinBLSPayloadLength {
+			return addr, fmt.Errorf("%w: filecoin bls payload must be %d bytes, got %d",
+				ErrInvalidAddress, filecoinBLSPayloadLength, len(addr.Payload))
+		}
+	case FilecoinProtocolDelegated:
+		if len(addr.Payload) > filecoinMaxSubaddressLength {
+			return addr, fmt.Errorf("%w: filecoin delegated sub-address exceeds %d bytes",
+				ErrInvalidAddress, filecoinMaxSubaddressLength)
+		}
+	}
+
+	if !bytes.Equal(filecoinChecksum(addr.checksumInput()), data[len(addr.Payload):]) {
+		return addr, fmt.Errorf("%w: filecoin checksum mismatch", ErrInvalidChecksum)
+	}
+	return addr, nil
+}
+
+func parseFilecoinUint(s string) (uint64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || v > math.MaxInt64 || strconv.FormatUint(v, 10) != s {
+		return 0, fmt.Errorf("%w: invalid filecoin actor id %q", ErrInvalidAddress, s)
+	}
+	return v, nil
+}
+
+// FilecoinEAMActorID is the actor ID of the Ethereum Address Manager, the
+// namespace of f410 delegated addresses.
+const FilecoinEAMActorID = 10
+
+// filecoinEVMChainIDs are the FEVM chain IDs of the Filecoin networks.
+var filecoinEVMChainIDs = map[FilecoinNetwork]uint64{
+	FilecoinMainnet: 314,
+	FilecoinTestnet: 314159, // calibration
+}
+
+// filecoinMaskedIDPrefix prefixes the big-endian actor ID of an f0 address in its
+// masked EVM address form, 0xff0000000000000000000000<id>.
+var filecoinMaskedIDPrefix = []byte{0xff, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// NewFilecoinDelegated creates the f410 delegated address of an EVM account.
+func NewFilecoinDelegated(network FilecoinNetwork, address ecommon.Address) (FilecoinAccountID, error) {
+	addr := FilecoinAddress{
+		Network:  network,
+		Protocol: FilecoinProtocolDelegated,
+		ID:       FilecoinEAMActorID,
+		Payload:  address.Bytes(),
+	}
+	return NewFilecoin(network, addr.String())
+}
+
+// FilecoinAccountID is the interface for Filecoin account IDs.
+type FilecoinAccountID interface {
+	AccountID
+	// Network returns the Filecoin network.
+	Network() FilecoinNetwork
+	// Protocol returns the address protocol class.
+	Protocol() FilecoinProtocol
+	// FilecoinAddress returns the decoded address.
+	FilecoinAddress() FilecoinAddress
+	// ActorID returns the actor ID of an f0 address.
+	ActorID() (uint64, bool)
+	// Payload returns the address payload; empty for f0 addresses.
+	Payload() []byte
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+	// ToEVM returns the FEVM account of an f410 or f0 address.
+	ToEVM() (EIP155AccountID, error)
+}
+
+// Ensure filecoinAccountID implements FilecoinAccountID at compile time
+var _ FilecoinAccountID = (*filecoinAccountID)(nil)
+
+func init() {
+	RegisterParser(&filecoinParser{ns: NamespaceFilecoin})
+	RegisterParser(&filecoinParser{ns: NamespaceFilecoinAlias})
+}
+
+// filecoinAccountID represents a Filecoin account ID per CAIP-10.
+type filecoinAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	addr              FilecoinAddress
+}
+
+// NewFilecoin creates a new FilecoinAccountID from an address string in the fil namespace.
+// The address network prefix must match the network.
+func NewFilecoin(network FilecoinNetwork, address string) (FilecoinAccountID, error) {
+	return newFilecoin(NamespaceFilecoin, network, address)
+}
+
+func newFilecoin(ns Namespace, network FilecoinNetwork, address string) (FilecoinAccountID, error) {
+	if err := validateReference(ns, string(network)); err != nil {
+		return nil, err
+	}
+	addr, err := ParseFilecoinAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if addr.Network != network {
+		return nil, fmt.Errorf("%w: filecoin address prefix %q does not match network %q",
+			ErrInvalidAddress, addr.Network, network)
+	}
+	return &filecoinAccountID{
+		GenericAccountID: newGenericUnchecked(ns, network.String(), address),
+		addr:             addr,
+	}, nil
+}
+
+// MustNewFilecoin creates a new FilecoinAccountID and panics if invalid.
+func MustNewFilecoin(network FilecoinNetwork, address string) FilecoinAccountID {
+	a, err := NewFilecoin(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Filecoin network.
+func (a *filecoinAccountID) Network() FilecoinNetwork {
+	if a == nil {
+		return ""
+	}
+	return a.addr.Network
+}
+
+// Protocol returns the address protocol class.
+func (a *filecoinAccountID) Protocol() FilecoinProtocol {
+	if a == nil {
+		return 0
+	}
+	return a.addr.Protocol
+}
+
+// FilecoinAddress returns the decoded address.
+func (a *filecoinAccountID) FilecoinAddress() FilecoinAddress {
+	if a == nil {
+		return FilecoinAddress{}
+	}
+	return a.addr
+}
+
+// ActorID returns the actor ID of an f0 address.
+func (a *filecoinAccountID) ActorID() (uint64, bool) {
+	if a == nil || a.addr.Protocol != FilecoinProtocolID {
+		return 0, false
+	}
+	return a.addr.ID, true
+}
+
+// Payload returns the address payload; empty for f0 addresses.
+func (a *filecoinAccountID) Payload() []byte {
+	if a == nil {
+		return nil
+	}
+	return append([]byte(nil), a.addr.Payload...)
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *filecoinAccountID) IsMainnet() bool {
+	return a.Network() == FilecoinMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *filecoinAccountID) IsTestnet() bool {
+	return a.Network() == FilecoinTestnet
+}
+
+// ToEVM returns the FEVM account of an f410 address, or the masked ID address of
+// an f0 address. Other protocols have no EVM form.
+func (a *filecoinAccountID) ToEVM() (EIP155AccountID, error) {
+	if a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	chainID := filecoinEVMChainIDs[a.addr.Network]
+	switch {
+	case a.addr.Protocol == FilecoinProtocolDelegated && a.addr.ID == FilecoinEAMActorID && len(a.addr.Payload) == ecommon.AddressLength:
+		return NewEIP155(chainID, ecommon.BytesToAddress(a.addr.Payload)), nil
+	case a.addr.Protocol == FilecoinProtocolID:
+		return NewEIP155(chainID, ecommon.BytesToAddress(binary.BigEndian.AppendUint64(filecoinMaskedIDPrefix, a.addr.ID))), nil
+	default:
+		return nil, fmt.Errorf("%w: filecoin %s address %q has no EVM form", ErrInvalidAddress, a.addr.Protocol, a.Address())
+	}
+}
+
+// ToFilecoin returns the Filecoin form of an FEVM account: the f0 address of a
+// masked ID address, or the f410 delegated address otherwise. The chain must be
+// Filecoin mainnet (314) or calibration (314159).
+func (a *eip155AccountID) ToFilecoin() (FilecoinAccountID, error) {
+	if a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	var network FilecoinNetwork
+	for n, id := range filecoinEVMChainIDs {
+		if a.chainID != nil && a.chainID.IsUint64() && a.chainID.Uint64() == id {
+			network = n
+		}
+	}
+	if network == "" {
+		return nil, fmt.Errorf("%w: chain %s is not a Filecoin EVM chain", ErrInvalidReference, a.ChainID())
+	}
+	if b := a.ethAddr.Bytes(); bytes.HasPrefix(b, filecoinMaskedIDPrefix) {
+		if id := binary.BigEndian.Uint64(b[len(filecoinMaskedIDPrefix):]); id <= math.MaxInt64 {
+			return NewFilecoin(network, FilecoinAddress{Network: network, Protocol: FilecoinProtocolID, ID: id}.String())
+		}
+	}
+	return NewFilecoinDelegated(network, a.ethAddr)
+}
+
+// ToFilecoin returns the Filecoin form of an FEVM account, the f0 or f410 address.
+func ToFilecoin(a EIP155AccountID) (FilecoinAccountID, error) {
+	return eip155Native(a).ToFilecoin()
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *filecoinAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *filecoinAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- filecoinParser ---
+
+type filecoinParser struct {
+	ns Namespace
+}
+
+func (p *filecoinParser) Namespace() Namespace {
+	return p.ns
+}
+
+func (p *filecoinParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != p.ns {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, p.ns, ns)
+	}
+	return newFilecoin(p.ns, FilecoinNetwork(ref), addr)
+}
+
+func (p *filecoinParser) ParseAddress(reference, address string) (AccountID, error) {
+	return newFilecoin(p.ns, FilecoinNetwork(reference), address)
+}
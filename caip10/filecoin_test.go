@@ -0,0 +1,150 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	filecoinID        = "f01024"
+	filecoinSecp256k1 = "f147d2xmpa5cg6n6ygpbbqdarf73b73iun4jqiwmq"
+	filecoinActor     = "f2v33d7lvwzzbi4gc4godxslx7bljd7eb2pye74ga"
+	filecoinBLS       = "f3aaaqeayeaudaocajbifqydiob4ibceqtcqkrmfyydenbwha5dypsaijcemsckjrhfausukzmfuxc7xayzmkq"
+	filecoinDelegated = "f410fvkvkvkvkvkvkvkvkvkvkvkvkvkvkvkvkne76kay"
+)
+
+func TestFilecoinProtocols(t *testing.T) {
+	tests := []struct {
+		address  string
+		protocol FilecoinProtocol
+		payload  string
+	}{
+		{filecoinID, FilecoinProtocolID, ""},
+		{filecoinSecp256k1, FilecoinProtocolSecp256k1, "e7c7abb1e0e88de6fb067843018225fec3fda28d"},
+		{filecoinActor, FilecoinProtocolActor, "aef63faeb6ce428e185c3387792eff0ad23f903a"},
+		{filecoinBLS, FilecoinProtocolBLS, "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"},
+		{filecoinDelegated, FilecoinProtocolDelegated, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.protocol.String(), func(t *testing.T) {
+			input := "fil:f:" + tt.address
+			a, err := Parse(input)
+			require.NoError(t, err)
+
+			fil, ok := a.(FilecoinAccountID)
+			require.True(t, ok, "expected FilecoinAccountID, got %T", a)
+			assert.Equal(t, FilecoinMainnet, fil.Network())
+			assert.True(t, fil.IsMainnet())
+			assert.Equal(t, tt.protocol, fil.Protocol())
+			assert.Equal(t, tt.payload, hex.EncodeToString(fil.Payload()))
+			assert.Equal(t, tt.address, fil.FilecoinAddress().String())
+			assert.Equal(t, input, fil.String())
+		})
+	}
+}
+
+func TestFilecoinActorID(t *testing.T) {
+	a := MustNewFilecoin(FilecoinMainnet, filecoinID)
+	id, ok := a.ActorID()
+	require.True(t, ok)
+	assert.Equal(t, uint64(1024), id)
+
+	_, ok = MustNewFilecoin(FilecoinMainnet, filecoinSecp256k1).ActorID()
+	assert.False(t, ok)
+
+	// f4 addresses carry the address manager actor ID (10 is the EAM)
+	assert.Equal(t, uint64(10), MustNewFilecoin(FilecoinMainnet, filecoinDelegated).FilecoinAddress().ID)
+}
+
+func TestFilecoinTestnetAndAlias(t *testing.T) {
+	a, err := Parse("fil:t:t147d2xmpa5cg6n6ygpbbqdarf73b73iun4jqiwmq")
+	require.NoError(t, err)
+	assert.True(t, a.(FilecoinAccountID).IsTestnet())
+	assert.Equal(t, ChainIDFilecoinTestnet, a.ChainID())
+
+	// The filecoin alias namespace is preserved
+	alias, err := Parse("filecoin:f:" + filecoinSecp256k1)
+	require.NoError(t, err)
+	assert.Equal(t, NamespaceFilecoinAlias, alias.Namespace())
+	assert.Equal(t, FilecoinProtocolSecp256k1, alias.(FilecoinAccountID).Protocol())
+	assert.NoError(t, alias.Validate())
+}
+
+func TestFilecoinParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "fil:mainnet:" + filecoinID, ErrInvalidReference},
+		{"network mismatch", "fil:t:" + filecoinSecp256k1, ErrInvalidAddress},
+		{"unknown protocol", "fil:f:f5abc", ErrInvalidAddress},
+		{"id leading zero", "fil:f:f001024", ErrInvalidAddress},
+		{"id overflow", "fil:f:f09223372036854775808", ErrInvalidAddress},
+		{"bad checksum", "fil:f:f147d2xmpa5cg6n6ygpbbqdarf73b73iun4jqiwma", ErrInvalidAddress},
+		{"short payload", "fil:f:f147d2xmpa5cg6n6ygpbbqdarf73b73iun4", ErrInvalidAddress},
+		{"uppercase", "fil:f:f147D2XMPA5CG6N6YGPBBQDARF73B73IUN4JQIWMQ", ErrInvalidAddress},
+		{"bls wrong length", "fil:f:" + filecoinActor[:1] + "3" + filecoinActor[2:], ErrInvalidAddress},
+		{"delegated missing separator", "fil:f:f410", ErrInvalidAddress},
+		{"delegated bad checksum", "fil:f:f410fvkvkvkvkvkvkvkvkvkvkvkvkvkvkvkvkne76kaa", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestFilecoinNilReceiver(t *testing.T) {
+	var a *filecoinAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, FilecoinNetwork(""), a.Network())
+	assert.Nil(t, a.Payload())
+	_, ok := a.ActorID()
+	assert.False(t, ok)
+}
+
+func TestFilecoinEVMConversion(t *testing.T) {
+	evm := NewEIP155FromHex(314, "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa")
+	fil, err := ToFilecoin(evm)
+	require.NoError(t, err)
+	assert.Equal(t, "fil:f:"+filecoinDelegated, fil.String())
+
+	back, err := fil.ToEVM()
+	require.NoError(t, err)
+	assert.True(t, back.Equal(evm), "got %s", back)
+
+	// Calibration uses the testnet prefix
+	calibration, err := ToFilecoin(evm.SetChainID(big.NewInt(314159)))
+	require.NoError(t, err)
+	assert.Equal(t, FilecoinTestnet, calibration.Network())
+	assert.True(t, strings.HasPrefix(calibration.Address(), "t410f"), calibration.Address())
+
+	// f0 ID addresses map to masked ID addresses
+	id := MustNewFilecoin(FilecoinMainnet, filecoinID)
+	masked, err := id.ToEVM()
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:314:0xff00000000000000000000000000000000000400", strings.ToLower(masked.String()))
+	roundTrip, err := ToFilecoin(masked)
+	require.NoError(t, err)
+	assert.Equal(t, filecoinID, roundTrip.Address())
+
+	delegated, err := NewFilecoinDelegated(FilecoinMainnet, evm.Account())
+	require.NoError(t, err)
+	assert.Equal(t, filecoinDelegated, delegated.Address())
+
+	_, err = MustNewFilecoin(FilecoinMainnet, filecoinSecp256k1).ToEVM()
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = ToFilecoin(evm.SetChainID(big.NewInt(1)))
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+}
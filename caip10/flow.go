@@ -0,0 +1,231 @@
+package caip10
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const NamespaceFlow Namespace = "flow"
+
+// FlowNetwork represents a Flow network (chain reference).
+type FlowNetwork string
+
+// Flow networks
+const (
+	FlowMainnet  FlowNetwork = "mainnet"
+	FlowTestnet  FlowNetwork = "testnet"
+	FlowEmulator FlowNetwork = "emulator"
+)
+
+// String returns the network reference string.
+func (n FlowNetwork) String() string {
+	return string(n)
+}
+
+// FlowAddressLength is the length of a Flow address in bytes.
+const FlowAddressLength = 8
+
+// flowCodeWords are XORed onto addresses so that each network uses a distinct
+// coset of the [64,45] linear code, making addresses of one network invalid on another.
+var flowCodeWords = map[FlowNetwork]uint64{
+	FlowMainnet:  0,
+	FlowTestnet:  0x6834ba37b3980209,
+	FlowEmulator: 0x1cb159857af02018,
+}
+
+// flowParityCheckColumns are the columns of the parity-check matrix of the Flow address code.
+var flowParityCheckColumns = [64]uint32{
+	0x00001, 0x00002, 0x00004, 0x00008,
+	0x00010, 0x00020, 0x00040, 0x00080,
+	0x00100, 0x00200, 0x00400, 0x00800,
+	0x01000, 0x02000, 0x04000, 0x08000,
+	0x10000, 0x20000, 0x40000, 0x7328d,
+	0x6689a, 0x6112f, 0x6084b, 0x433fd,
+	0x42aab, 0x41951, 0x233ce, 0x22a81,
+	0x21948, 0x1ef60, 0x1deca, 0x1c639,
+	0x1bdd8, 0x1a535, 0x194ac, 0x18c46,
+	0x1632b, 0x1529b, 0x14a43, 0x13184,
+	0x12942, 0x118c1, 0x0f812, 0x0e027,
+	0x0d00e, 0x0c83c, 0x0b01d, 0x0a831,
+	0x0982b, 0x07034, 0x0682a, 0x05819,
+	0x03807, 0x007d2, 0x00727, 0x0068e,
+	0x0067c, 0x0059d, 0x004eb, 0x003b4,
+	0x0036a, 0x002d9, 0x001c7, 0x0003f,
+}
+
+// IsValidFlowAddress reports whether addr is a valid code word for the network.
+// https://github.com/onflow/flow-go-sdk/blob/master/address.go
+func IsValidFlowAddress(network FlowNetwork, addr [FlowAddressLength]byte) bool {
+	codeWord, ok := flowCodeWords[network]
+	if !ok {
+		return false
+	}
+	codeWord ^= binary.BigEndian.Uint64(addr[:])
+	if codeWord == 0 {
+		return false
+	}
+	var parity uint32
+	for i := 0; i < len(flowParityCheckColumns); i++ {
+		if codeWord&1 == 1 {
+			parity ^= flowParityCheckColumns[i]
+		}
+		codeWord >>= 1
+	}
+	return parity == 0
+}
+
+// ParseFlowAddress parses a 0x-prefixed, 16 character lower case hex Flow address.
+func ParseFlowAddress(address string) ([FlowAddressLength]byte, error) {
+	var addr [FlowAddressLength]byte
+	if !strings.HasPrefix(address, "0x") {
+		return addr, fmt.Errorf("%w: flow address must start with 0x", ErrInvalidAddress)
+	}
+	s := address[2:]
+	if len(s) != FlowAddressLength*2 {
+		return addr, fmt.Errorf("%w: flow address must be %d hex characters, got %d",
+			ErrInvalidAddress, FlowAddressLength*2, len(s))
+	}
+	if strings.ToLower(s) != s {
+		return addr, fmt.Errorf("%w: flow address must be lower case", ErrInvalidAddress)
+	}
+	if _, err := hex.Decode(addr[:], []byte(s)); err != nil {
+		return addr, fmt.Errorf("%w: invalid hex in flow address", ErrInvalidAddress)
+	}
+	return addr, nil
+}
+
+// ValidateFlowAddress validates a Flow address for a specific network.
+// Returns nil if valid, error otherwise.
+func ValidateFlowAddress(network FlowNetwork, address string) error {
+	addr, err := ParseFlowAddress(address)
+	if err != nil {
+		return err
+	}
+	if !IsValidFlowAddress(network, addr) {
+		return fmt.Errorf("%w: %s is not a valid %s address", ErrInvalidAddress, address, network)
+	}
+	return nil
+}
+
+// FlowAccountID is the interface for Flow account IDs.
+type FlowAccountID interface {
+	AccountID
+	// Network returns the Flow network.
+	Network() FlowNetwork
+	// Bytes returns the 8-byte address.
+	Bytes() [FlowAddressLength]byte
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+}
+
+// Ensure flowAccountID implements FlowAccountID at compile time
+var _ FlowAccountID = (*flowAccountID)(nil)
+
+func init() {
+	RegisterParser(&flowParser{})
+}
+
+// flowAccountID represents a Flow account ID per CAIP-10.
+type flowAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	addr              [FlowAddressLength]byte
+}
+
+// NewFlow creates a new FlowAccountID from a hex address.
+// Validation:
+//  1. 0x prefix followed by 16 lower case hex characters
+//  2. Address is a valid code word for the network
+func NewFlow(network FlowNetwork, address string) (FlowAccountID, error) {
+	if err := validateReference(NamespaceFlow, string(network)); err != nil {
+		return nil, err
+	}
+	addr, err := ParseFlowAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if !IsValidFlowAddress(network, addr) {
+		return nil, fmt.Errorf("%w: %s is not a valid %s address", ErrInvalidAddress, address, network)
+	}
+	return &flowAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceFlow, network.String(), address),
+		addr:             addr,
+	}, nil
+}
+
+// MustNewFlow creates a new FlowAccountID and panics if invalid.
+func MustNewFlow(network FlowNetwork, address string) FlowAccountID {
+	a, err := NewFlow(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Flow network.
+func (a *flowAccountID) Network() FlowNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return FlowNetwork(a.Reference())
+}
+
+// Bytes returns the 8-byte address.
+func (a *flowAccountID) Bytes() [FlowAddressLength]byte {
+	if a == nil {
+		return [FlowAddressLength]byte{}
+	}
+	return a.addr
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *flowAccountID) IsMainnet() bool {
+	return a.Network() == FlowMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *flowAccountID) IsTestnet() bool {
+	return a.Network() == FlowTestnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *flowAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *flowAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- flowParser ---
+
+type flowParser struct{}
+
+func (p *flowParser) Namespace() Namespace {
+	return NamespaceFlow
+}
+
+func (p *flowParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceFlow {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceFlow, ns)
+	}
+	return NewFlow(FlowNetwork(ref), addr)
+}
+
+func (p *flowParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewFlow(FlowNetwork(reference), address)
+}
@@ -0,0 +1,85 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowParse(t *testing.T) {
+	input := "flow:mainnet:0x1654653399040a61"
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	flow, ok := a.(FlowAccountID)
+	require.True(t, ok, "expected FlowAccountID, got %T", a)
+	assert.Equal(t, FlowMainnet, flow.Network())
+	assert.Equal(t, ChainIDFlowMainnet, flow.ChainID())
+	assert.True(t, flow.IsMainnet())
+
+	b := flow.Bytes()
+	assert.Equal(t, "1654653399040a61", hex.EncodeToString(b[:]))
+	assert.Equal(t, input, flow.String())
+}
+
+func TestFlowNetworkCodeWords(t *testing.T) {
+	tests := []struct {
+		address string
+		valid   FlowNetwork
+	}{
+		{"0x1654653399040a61", FlowMainnet},  // FlowToken
+		{"0xe467b9dd11fa00df", FlowMainnet},  // service account
+		{"0x7e60df042a9c0868", FlowTestnet},  // FlowToken
+		{"0x8c5303eaa26202d6", FlowTestnet},  // service account
+		{"0xf8d6e0586b0a20c7", FlowEmulator}, // service account
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			for _, network := range []FlowNetwork{FlowMainnet, FlowTestnet, FlowEmulator} {
+				err := ValidateFlowAddress(network, tt.address)
+				if network == tt.valid {
+					assert.NoError(t, err)
+				} else {
+					assert.True(t, errors.Is(err, ErrInvalidAddress), "network %s: %v", network, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFlowParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "flow:canarynet:0x1654653399040a61", ErrInvalidReference},
+		{"testnet address on mainnet", "flow:mainnet:0x7e60df042a9c0868", ErrInvalidAddress},
+		{"bad code word", "flow:mainnet:0x1654653399040a62", ErrInvalidAddress},
+		{"zero address", "flow:mainnet:0x0000000000000000", ErrInvalidAddress},
+		{"missing prefix", "flow:mainnet:1654653399040a61", ErrInvalidAddress},
+		{"upper case", "flow:mainnet:0x1654653399040A61", ErrInvalidAddress},
+		{"too short", "flow:mainnet:0x1654653399040a6", ErrInvalidAddress},
+		{"invalid hex", "flow:mainnet:0x1654653399040x61", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestFlowNilReceiver(t *testing.T) {
+	var a *flowAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, FlowNetwork(""), a.Network())
+	assert.Equal(t, [FlowAddressLength]byte{}, a.Bytes())
+	assert.False(t, a.IsMainnet())
+}
@@ -0,0 +1,159 @@
+package caip10
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// formatField is a namespace-specific value printed by %+v and %#v.
+type formatField struct {
+	name  string
+	value any
+}
+
+// formatAccount implements fmt.Formatter for accounts:
+//   - %s and %v print the CAIP-10 string, %q quotes it
+//   - %+v prints {namespace:... reference:... address:...} and the native fields
+//   - %#v prints the same fields as a Go composite literal of the account type
+//
+// Width and precision apply to %s, %v and %q as for strings.
+func formatAccount(f fmt.State, verb rune, a AccountID, extras ...formatField) {
+	switch {
+	case verb == 'v' && (f.Flag('+') || f.Flag('#')):
+		formatFields(f, a, f.Flag('#'), extras)
+	case verb == 'v':
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), a.String())
+	case verb == 's' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), a.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%s)", verb, a, a.String())
+	}
+}
+
+func formatFields(f fmt.State, a AccountID, goSyntax bool, extras []formatField) {
+	fields := append([]formatField{
+		{"namespace", a.Namespace()},
+		{"reference", a.Reference()},
+		{"address", a.Address()},
+	}, extras...)
+	var b []byte
+	if goSyntax {
+		b = append(b, strings.Replace(fmt.Sprintf("%T", a), "*", "&", 1)...)
+	}
+	b = append(b, '{')
+	for i, field := range fields {
+		if i > 0 && goSyntax {
+			b = append(b, ", "...)
+		} else if i > 0 {
+			b = append(b, ' ')
+		}
+		b = append(b, field.name...)
+		b = append(b, ':')
+		if goSyntax {
+			b = fmt.Appendf(b, "%#v", field.value)
+		} else {
+			b = fmt.Append(b, field.value)
+		}
+	}
+	b = append(b, '}')
+	f.Write(b)
+}
+
+// Format implements fmt.Formatter, see formatAccount.
+func (a *GenericAccountID) Format(f fmt.State, verb rune) {
+	if a == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	formatAccount(f, verb, a)
+}
+
+// Format implements fmt.Formatter for native types without namespace-specific
+// fields, so that %#v prints the native type rather than the embedded
+// *GenericAccountID. See formatAccount.
+func (a *algorandAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *antelopeAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *cardanoAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *filecoinAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *flowAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *hederaAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *icpAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *kaspaAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *multiversXAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *nearAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *polkadotAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *stacksAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *stellarAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *tonAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+func (a *tronAccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+// formatNative formats a native account, or "<nil>" if isNil.
+func formatNative(f fmt.State, verb rune, a AccountID, isNil bool) {
+	if isNil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	formatAccount(f, verb, a)
+}
+
+// Format implements fmt.Formatter: %s and %v print the CAIP-2 string, %q quotes
+// it, and %+v and %#v print the namespace and reference.
+func (c ChainID) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{namespace:%s reference:%s}", c.Namespace, c.Reference)
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "caip10.ChainID{Namespace:%s, Reference:%s}", strconv.Quote(string(c.Namespace)), strconv.Quote(c.Reference))
+	case verb == 'v':
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), c.String())
+	case verb == 's' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), c.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(caip10.ChainID=%s)", verb, c.String())
+	}
+}
@@ -0,0 +1,70 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatVerbs(t *testing.T) {
+	const evm = "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	eth := MustParse(evm)
+	generic := MustNewGeneric("cosmos", "cosmoshub-4", "cosmos1abc")
+	btc := NewBIP122(BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%s", eth, evm},
+		{"%v", eth, evm},
+		{"%q", eth, `"` + evm + `"`},
+		{"%+v", eth, "{namespace:eip155 reference:137 address:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb chainID:137}"},
+		{"%#v", eth, `&caip10.eip155AccountID{namespace:"eip155", reference:"137", address:"0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", chainID:137}`},
+		{"%d", eth, "%!d(*caip10.eip155AccountID=" + evm + ")"},
+		{"%+v", btc, "{namespace:bip122 reference:000000000019d6689c085ae165831e93 address:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 scriptType:p2wpkh}"},
+		{"%+v", generic, "{namespace:cosmos reference:cosmoshub-4 address:cosmos1abc}"},
+		{"%#v", generic, `&caip10.GenericAccountID{namespace:"cosmos", reference:"cosmoshub-4", address:"cosmos1abc"}`},
+		{"%-20s|", generic, "cosmos:cosmoshub-4:cosmos1abc|"},
+		{"%30s", generic, " cosmos:cosmoshub-4:cosmos1abc"},
+		{"%.6s", generic, "cosmos"},
+		{"%v", (*GenericAccountID)(nil), "<nil>"},
+		{"%v", []AccountID{generic}, "[cosmos:cosmoshub-4:cosmos1abc]"},
+		{"%v", ChainIDPolygon, "eip155:137"},
+		{"%q", ChainIDPolygon, `"eip155:137"`},
+		{"%+v", ChainIDPolygon, "{namespace:eip155 reference:137}"},
+		{"%#v", ChainIDPolygon, `caip10.ChainID{Namespace:"eip155", Reference:"137"}`},
+		{"%12v|", ChainIDPolygon, "  eip155:137|"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, fmt.Sprintf(tt.format, tt.arg), tt.format)
+	}
+
+	sol, err := SolanaFromEd25519(SolanaMainnet, make([]byte, 32))
+	assert.NoError(t, err)
+	assert.Contains(t, fmt.Sprintf("%+v", sol), " onCurve:true}")
+	// Wrapped in an error, the CAIP string is printed
+	assert.Equal(t, "bad account "+evm, fmt.Errorf("bad account %v", eth).Error())
+}
+
+func TestFormatNativeTypes(t *testing.T) {
+	tron := MustParse("tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	assert.Equal(t, `&caip10.tronAccountID{namespace:"tron", reference:"0x2b6653dc", address:"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"}`, fmt.Sprintf("%#v", tron))
+	assert.Equal(t, "%!d(*caip10.tronAccountID=tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t)", fmt.Sprintf("%d", tron))
+
+	for _, s := range []string{
+		"hedera:mainnet:0.0.123",
+		"near:mainnet:alice.near",
+		"sui:mainnet:0x02a212de6a9dfa3a69e22387acfbafbb1a9e591bd9d636e7895dcfc8de05f331",
+	} {
+		a := MustParse(s)
+		want := "&caip10." + strings.TrimPrefix(fmt.Sprintf("%T", a), "*caip10.") + "{"
+		assert.True(t, strings.HasPrefix(fmt.Sprintf("%#v", a), want), "%#v", a)
+		assert.Equal(t, s, fmt.Sprintf("%v", a))
+	}
+	assert.Equal(t, "<nil>", fmt.Sprintf("%v", (*tronAccountID)(nil)))
+	assert.Equal(t, "<nil>", fmt.Sprintf("%v", &hederaAccountID{}))
+}
@@ -0,0 +1,23 @@
+package caip10
+
+//go:generate go run ../cmd/caip10gen -spec namespaces.yaml -out .
+
+// generatedNamespace holds the validators of a namespace generated by
+// caip10gen from namespaces.yaml.
+type generatedNamespace struct {
+	validateReference func(reference string) error
+	validateAddress   func(address string) error
+}
+
+// generatedNamespaces is written by the init functions of generated files only,
+// so it is read without locking.
+var generatedNamespaces = map[Namespace]generatedNamespace{}
+
+// registerGeneratedNamespace registers the validators of a generated namespace
+// for use by validateReference and GenericAccountID.Validate.
+func registerGeneratedNamespace(ns Namespace, validateReference func(string) error, validateAddress func(string) error) {
+	generatedNamespaces[ns] = generatedNamespace{
+		validateReference: validateReference,
+		validateAddress:   validateAddress,
+	}
+}
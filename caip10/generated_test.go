@@ -0,0 +1,42 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedNamespaces(t *testing.T) {
+	const suiAddress = "0x02a212de6a9dfa3a69e22387acfbafbb1a9e591bd9d636e7895dcfc8de05f331"
+	a, err := Parse("sui:mainnet:" + suiAddress)
+	require.NoError(t, err)
+	sui, ok := a.(SuiAccountID)
+	require.True(t, ok, "got %T", a)
+	assert.Equal(t, SuiMainnet, sui.Network())
+	assert.True(t, sui.Equal(MustNewSui(SuiMainnet, suiAddress)))
+	assert.Equal(t, "sui:testnet", NewSuiChainID(SuiTestnet).String())
+
+	_, err = Parse("sui:betanet:" + suiAddress)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	_, err = NewSui(SuiMainnet, "0x2")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	// ParseChainID and GenericAccountID.Validate use the generated validators
+	_, err = ParseChainID("starknet:SN_SEPOLIA")
+	require.NoError(t, err)
+	_, err = ParseChainID("starknet:mainnet")
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	_, err = NewGeneric(NamespaceSui, "mainnet", "0x2")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	stark, err := NewStarknet(StarknetMainnet, "0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7")
+	require.NoError(t, err)
+	assert.Equal(t, "starknet:SN_MAIN:0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7", stark.String())
+	// 2^251 + 17*2^192 + 1 is the field prime, not a field element
+	_, err = NewStarknet(StarknetMainnet, "0x800000000000011000000000000000000000000000000000000000000000001")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = NewStarknet(StarknetMainnet, "0x800000000000011000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+}
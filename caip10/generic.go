@@ -18,15 +18,12 @@ type GenericAccountID struct {
 	namespace Namespace
 	reference string
 	address   string
+	str       string // canonical CAIP-10 string, computed once at construction
 }
 
 // NewGeneric creates a new GenericAccountID with validation.
 func NewGeneric(namespace Namespace, reference, address string) (*GenericAccountID, error) {
-	a := &GenericAccountID{
-		namespace: namespace,
-		reference: reference,
-		address:   address,
-	}
+	a := newGenericUnchecked(namespace, reference, address)
 	if err := a.Validate(); err != nil {
 		return nil, err
 	}
@@ -44,11 +41,15 @@ func MustNewGeneric(namespace Namespace, reference, address string) *GenericAcco
 
 // newGenericUnchecked creates without validation (for internal use by embedders).
 func newGenericUnchecked(namespace Namespace, reference, address string) *GenericAccountID {
-	return &GenericAccountID{
+	a := &GenericAccountID{
 		namespace: namespace,
 		reference: reference,
 		address:   address,
 	}
+	if !a.IsZero() {
+		a.str = string(namespace) + ":" + reference + ":" + address
+	}
+	return a
 }
 
 // Namespace returns the blockchain namespace.
@@ -87,10 +88,14 @@ func (a *GenericAccountID) ChainID() ChainID {
 }
 
 // String returns the full CAIP-10 string representation.
+// The string is precomputed, so calling String does not allocate.
 func (a *GenericAccountID) String() string {
 	if a.IsZero() {
 		return ""
 	}
+	if a.str != "" {
+		return a.str
+	}
 	return string(a.namespace) + ":" + a.reference + ":" + a.address
 }
 
@@ -112,8 +117,16 @@ func (a *GenericAccountID) Equal(other AccountID) bool {
 		a.address == other.Address()
 }
 
-// Validate checks if the AccountID is valid per CAIP-10 spec.
+// Validate checks if the AccountID is valid per CAIP-10 spec,
+// using the default ValidationPolicy.
 func (a *GenericAccountID) Validate() error {
+	err := a.validate(DefaultValidationPolicy(), GetParser)
+	fireValidateErrorHooks(a, err)
+	return err
+}
+
+// validateStandard checks the account against the ValidationStandard rules.
+func (a *GenericAccountID) validateStandard() error {
 	if a == nil {
 		return ErrEmptyValue
 	}
@@ -136,7 +149,88 @@ func (a *GenericAccountID) Validate() error {
 		if err != nil {
 			return err
 		}
+	case NamespacePolkadot:
+		_, err := NewPolkadotFromSS58(PolkadotNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceStellar:
+		_, err := NewStellarFromStrKey(StellarNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceNEAR:
+		_, err := NewNEAR(NEARNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceTON:
+		_, err := NewTONFromString(TONNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceCardano:
+		_, err := NewCardano(CardanoNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceAlgorand:
+		_, err := NewAlgorandFromAddress(AlgorandNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceFilecoin, NamespaceFilecoinAlias:
+		_, err := newFilecoin(a.namespace, FilecoinNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceHedera:
+		_, err := NewHederaFromString(HederaNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceAntelope:
+		_, err := NewAntelope(AntelopeNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceStacks:
+		_, err := NewStacksFromAddress(StacksNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceMultiversX:
+		_, err := NewMultiversXFromAddress(MultiversXNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceFlow:
+		_, err := NewFlow(FlowNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceKaspa:
+		_, err := NewKaspa(KaspaNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceICP:
+		_, err := NewICP(ICPNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
+	case NamespaceTron:
+		_, err := NewTron(TronNetwork(a.reference), a.address)
+		if err != nil {
+			return err
+		}
 	default:
+		if g, ok := generatedNamespaces[a.namespace]; ok {
+			if err := g.validateReference(a.reference); err != nil {
+				return err
+			}
+			return g.validateAddress(a.address)
+		}
 		if !ReferenceRegex.MatchString(a.reference) {
 			return fmt.Errorf("%w: must match [-_a-zA-Z0-9]{1,32}, got %q", ErrInvalidReference, a.reference)
 		}
@@ -170,13 +264,49 @@ func (a *GenericAccountID) ToColumnsCompact() AccountIDColumnsCompact {
 	}
 }
 
+// Key returns the canonical comparable key of the account, safe for use as a map key.
+// Returns the zero key for zero values.
+func (a *GenericAccountID) Key() AccountKey {
+	if a.IsZero() {
+		return AccountKey{}
+	}
+	return newAccountKey(a.namespace, a.reference, a.address)
+}
+
+// genericOf returns the components of an AccountID implemented outside this
+// package as a *GenericAccountID. Nil and zero accounts return nil.
+func genericOf(a AccountID) *GenericAccountID {
+	if a == nil || a.IsZero() {
+		return nil
+	}
+	return newGenericUnchecked(a.Namespace(), a.Reference(), a.Address())
+}
+
+// ToDID returns the did:pkh identifier of an account, or "" for nil and zero
+// accounts.
+func ToDID(a AccountID) string {
+	if a == nil || a.IsZero() {
+		return ""
+	}
+	return DIDPKHPrefix + a.String()
+}
+
+// ToDID returns the did:pkh identifier (did:pkh:namespace:reference:address).
+// Returns an empty string for zero values.
+func (a *GenericAccountID) ToDID() string {
+	if a.IsZero() {
+		return ""
+	}
+	return DIDPKHPrefix + a.String()
+}
+
 // ToNative converts GenericAccountID to its namespace-specific type.
 // Returns EIP155AccountID for eip155, SolanaAccountID for solana, or *GenericAccountID for others.
 func (a *GenericAccountID) ToNative() any {
 	if a == nil {
 		return nil
 	}
-	if p, ok := registry[a.namespace]; ok {
+	if p, ok := GetParser(a.namespace); ok {
 		native, err := p.ParseAddress(a.reference, a.address)
 		if err == nil {
 			return native
@@ -188,7 +318,12 @@ func (a *GenericAccountID) ToNative() any {
 // --- encoding.TextMarshaler / encoding.TextUnmarshaler ---
 
 func (a *GenericAccountID) MarshalText() ([]byte, error) {
-	return []byte(a.String()), nil
+	return a.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+func (a *GenericAccountID) AppendText(b []byte) ([]byte, error) {
+	return append(b, a.String()...), nil
 }
 
 func (a *GenericAccountID) UnmarshalText(text []byte) error {
@@ -208,28 +343,32 @@ func (a *GenericAccountID) UnmarshalText(text []byte) error {
 
 func (a *GenericAccountID) MarshalBinary() ([]byte, error) {
 	if a == nil {
-		return []byte{0, 0, 0, 0}, nil
+		return a.AppendBinary(nil)
 	}
-	nsLen := len(a.namespace)
-	refLen := len(a.reference)
-	addrLen := len(a.address)
-
-	buf := make([]byte, 4+nsLen+refLen+addrLen)
-	buf[0] = byte(nsLen)
-	buf[1] = byte(refLen)
-	binary.BigEndian.PutUint16(buf[2:4], uint16(addrLen))
-
-	offset := 4
-	copy(buf[offset:], a.namespace)
-	offset += nsLen
-	copy(buf[offset:], a.reference)
-	offset += refLen
-	copy(buf[offset:], a.address)
+	return a.AppendBinary(make([]byte, 0, 4+len(a.namespace)+len(a.reference)+len(a.address)))
+}
 
-	return buf, nil
+// AppendBinary implements encoding.BinaryAppender.
+func (a *GenericAccountID) AppendBinary(b []byte) ([]byte, error) {
+	if a == nil {
+		return append(b, 0, 0, 0, 0), nil
+	}
+	b = append(b, byte(len(a.namespace)), byte(len(a.reference)))
+	b = binary.BigEndian.AppendUint16(b, uint16(len(a.address)))
+	b = append(b, a.namespace...)
+	b = append(b, a.reference...)
+	return append(b, a.address...), nil
 }
 
+// UnmarshalBinary reads both the format written by MarshalBinary and the compact
+// format written by MarshalBinaryCompact.
 func (a *GenericAccountID) UnmarshalBinary(data []byte) error {
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		if data[0] != binaryVersionCompact {
+			return fmt.Errorf("%w: unsupported binary version %#x", ErrInvalidFormat, data[0])
+		}
+		return a.unmarshalBinaryCompact(data)
+	}
 	if len(data) < 4 {
 		return fmt.Errorf("%w: binary data too short", ErrInvalidFormat)
 	}
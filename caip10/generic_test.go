@@ -31,10 +31,10 @@ func TestGenericParse(t *testing.T) {
 			chainID:   "bip122:000000000019d6689c085ae165831e93",
 		},
 		{
-			input:     "polkadot:b0a8d493285c2df73290dfb7e61f870f:5hmuyxw9xdgbpptgypokw4thfyoe3ryenebr381z9iaegmfy",
+			input:     "polkadot:b0a8d493285c2df73290dfb7e61f870f:HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F",
 			namespace: "polkadot",
 			reference: "b0a8d493285c2df73290dfb7e61f870f",
-			address:   "5hmuyxw9xdgbpptgypokw4thfyoe3ryenebr381z9iaegmfy",
+			address:   "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F",
 			chainID:   "polkadot:b0a8d493285c2df73290dfb7e61f870f",
 		},
 		{
@@ -157,6 +157,40 @@ func TestGenericBinary(t *testing.T) {
 	}
 }
 
+func TestGenericAppend(t *testing.T) {
+	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	buf := make([]byte, 0, 64)
+
+	text, err := a.AppendText(append(buf, '['))
+	if err != nil {
+		t.Fatalf("AppendText failed: %v", err)
+	}
+	if got, want := string(text), "[cosmos:cosmoshub-3:cosmos1abc"; got != want {
+		t.Errorf("AppendText = %q, want %q", got, want)
+	}
+
+	data, err := a.AppendBinary([]byte{0xff})
+	if err != nil {
+		t.Fatalf("AppendBinary failed: %v", err)
+	}
+	want, _ := a.MarshalBinary()
+	if string(data[1:]) != string(want) || data[0] != 0xff {
+		t.Errorf("AppendBinary = %x, want ff%x", data, want)
+	}
+
+	var nilAccount *GenericAccountID
+	if data, _ := nilAccount.AppendBinary(nil); len(data) != 4 {
+		t.Errorf("nil AppendBinary = %x", data)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		_, _ = a.AppendText(buf)
+		_, _ = a.AppendBinary(buf)
+	}); allocs != 0 {
+		t.Errorf("Append allocated %v times", allocs)
+	}
+}
+
 func TestGenericCBOR(t *testing.T) {
 	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
 
@@ -577,20 +611,36 @@ func TestGenericAccountID_Validate(t *testing.T) {
 			errType:   ErrInvalidAddress,
 		},
 
-		// 通用 namespace (default case) 测试
+		// Polkadot namespace 测试
 		{
-			name:      "generic valid cosmos",
-			accountID: newGenericUnchecked("cosmos", "cosmoshub-3", "cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0"),
+			name:      "polkadot valid kusama",
+			accountID: newGenericUnchecked(NamespacePolkadot, string(KusamaMainnet), "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"),
 			wantErr:   false,
 		},
 		{
-			name:      "generic valid polkadot",
-			accountID: newGenericUnchecked("polkadot", "b0a8d493285c2df73290dfb7e61f870f", "5hmuyxw9xdgbpptgypokw4thfyoe3ryenebr381z9iaegmfy"),
+			name:      "polkadot prefix mismatch",
+			accountID: newGenericUnchecked(NamespacePolkadot, string(PolkadotMainnet), "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"),
+			wantErr:   true,
+			errType:   ErrInvalidAddress,
+		},
+
+		// Hedera namespace 测试
+		{
+			name:      "hedera valid with checksum",
+			accountID: newGenericUnchecked(NamespaceHedera, string(HederaMainnet), "0.0.1234567890-zbhlt"),
 			wantErr:   false,
 		},
 		{
-			name:      "generic valid hedera",
-			accountID: newGenericUnchecked("hedera", "mainnet", "0.0.1234567890-zbhlt"),
+			name:      "hedera checksum of other network",
+			accountID: newGenericUnchecked(NamespaceHedera, string(HederaTestnet), "0.0.1234567890-zbhlt"),
+			wantErr:   true,
+			errType:   ErrInvalidAddress,
+		},
+
+		// 通用 namespace (default case) 测试
+		{
+			name:      "generic valid cosmos",
+			accountID: newGenericUnchecked("cosmos", "cosmoshub-3", "cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0"),
 			wantErr:   false,
 		},
 		{
@@ -787,3 +837,101 @@ func TestToNative(t *testing.T) {
 		}
 	})
 }
+
+func TestGenericStringPrecomputed(t *testing.T) {
+	const s = "cosmos:cosmoshub-3:cosmos1abc"
+	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	if got := a.String(); got != s {
+		t.Errorf("String: got %q, want %q", got, s)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = a.String() }); n != 0 {
+		t.Errorf("String allocated %v times, want 0", n)
+	}
+
+	native := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	if n := testing.AllocsPerRun(100, func() { _ = native.String() }); n != 0 {
+		t.Errorf("native String allocated %v times, want 0", n)
+	}
+
+	data, _ := a.MarshalBinary()
+	decoders := map[string]func(*GenericAccountID) error{
+		"text":   func(b *GenericAccountID) error { return b.UnmarshalText([]byte(s)) },
+		"json":   func(b *GenericAccountID) error { return b.UnmarshalJSON([]byte(`"` + s + `"`)) },
+		"binary": func(b *GenericAccountID) error { return b.UnmarshalBinary(data) },
+		"into":   func(b *GenericAccountID) error { return ParseInto(b, s) },
+	}
+	for name, decode := range decoders {
+		var b GenericAccountID
+		if err := decode(&b); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := b.String(); got != s {
+			t.Errorf("%s: got %q, want %q", name, got, s)
+		}
+		if n := testing.AllocsPerRun(100, func() { _ = b.String() }); n != 0 {
+			t.Errorf("%s: String allocated %v times, want 0", name, n)
+		}
+	}
+
+	var nilID *GenericAccountID
+	if got := nilID.String(); got != "" {
+		t.Errorf("nil String: got %q", got)
+	}
+	if got := (&GenericAccountID{}).String(); got != "" {
+		t.Errorf("zero String: got %q", got)
+	}
+}
+
+// externalAccountID is an AccountID implemented outside this package, with
+// only the methods of the interface.
+type externalAccountID struct {
+	AccountID
+}
+
+// externalEIP155AccountID is an EIP155AccountID implemented outside this package.
+type externalEIP155AccountID struct {
+	EIP155AccountID
+}
+
+func TestExternalAccountID(t *testing.T) {
+	native := MustParse("eip155:01:0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB")
+	external := externalAccountID{native}
+
+	if KeyOf(external) != KeyOf(native) {
+		t.Errorf("KeyOf() = %v, want %v", KeyOf(external), KeyOf(native))
+	}
+	if FingerprintOf(external) != FingerprintOf(native) || UUID(external) != UUID(native) {
+		t.Error("FingerprintOf() and UUID() differ from the native account")
+	}
+	if got, want := Normalize(external).String(), Normalize(native).String(); got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+	if got := ToDID(external); got != "did:pkh:"+native.String() {
+		t.Errorf("ToDID() = %q", got)
+	}
+	got, err := MarshalBinaryCompact(external)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := MarshalBinaryCompact(native); string(got) != string(want) {
+		t.Errorf("MarshalBinaryCompact() = %x, want %x", got, want)
+	}
+	moved, err := SwitchNetwork(external, "137")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := moved.(EIP155AccountID); !ok || moved.Reference() != "137" {
+		t.Errorf("SwitchNetwork() = %#v", moved)
+	}
+	if _, err := WithChainID(external, ChainIDSolanaMainnet); !errors.Is(err, ErrInvalidNamespace) {
+		t.Errorf("WithChainID() error = %v, want ErrInvalidNamespace", err)
+	}
+
+	eth := externalEIP155AccountID{NewEIP155(1, native.(EIP155AccountID).Account())}
+	if ChecksumAddress(eth) != "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb" {
+		t.Errorf("ChecksumAddress() = %q", ChecksumAddress(eth))
+	}
+	if ToTron(eth) == nil || IsZeroAddress(eth) || IsPrecompile(eth) || IsBurnAddress(eth) {
+		t.Error("EIP-155 helpers do not accept external implementations")
+	}
+}
@@ -0,0 +1,117 @@
+package caip10
+
+import (
+	"encoding/gob"
+	"fmt"
+	"sync"
+)
+
+// GobEncode implements gob.GobEncoder. The account is encoded as its CAIP-10 string.
+func (a *GenericAccountID) GobEncode() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (a *GenericAccountID) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		*a = GenericAccountID{}
+		return nil
+	}
+	return a.UnmarshalText(data)
+}
+
+// GobEncode implements gob.GobEncoder. The chain ID is encoded as its CAIP-2 string.
+func (c ChainID) GobEncode() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (c *ChainID) GobDecode(data []byte) error {
+	return c.UnmarshalText(data)
+}
+
+var registerGobTypesOnce sync.Once
+
+// RegisterGobTypes registers GenericAccountID and the native account types with
+// encoding/gob, so that accounts held in interface values, e.g. net/rpc arguments
+// or cached any values, are decoded back into their native types. It is safe to
+// call more than once.
+//
+// Fields declared as AccountID cannot be gob-decoded: gob treats an interface type
+// with an UnmarshalBinary method as a value to unmarshal into, not a polymorphic
+// interface. Declare such fields as any or as *GenericAccountID.
+func RegisterGobTypes() {
+	registerGobTypesOnce.Do(func() {
+		for _, a := range []AccountID{
+			&GenericAccountID{},
+			&algorandAccountID{},
+			&antelopeAccountID{},
+			&bip122AccountID{},
+			&cardanoAccountID{},
+			&eip155AccountID{},
+			&filecoinAccountID{},
+			&flowAccountID{},
+			&hederaAccountID{},
+			&icpAccountID{},
+			&kaspaAccountID{},
+			&multiversXAccountID{},
+			&nearAccountID{},
+			&polkadotAccountID{},
+			&solanaAccountID{},
+			&stacksAccountID{},
+			&stellarAccountID{},
+			&tonAccountID{},
+			&tronAccountID{},
+		} {
+			gob.Register(a)
+		}
+	})
+}
+
+// The native types inherit GobEncode from GenericAccountID, but decoding must
+// restore their native fields, so each decodes through its namespace parser.
+
+func (a *algorandAccountID) GobDecode(data []byte) error   { return gobDecodeNative(a, data) }
+func (a *antelopeAccountID) GobDecode(data []byte) error   { return gobDecodeNative(a, data) }
+func (a *bip122AccountID) GobDecode(data []byte) error     { return gobDecodeNative(a, data) }
+func (a *cardanoAccountID) GobDecode(data []byte) error    { return gobDecodeNative(a, data) }
+func (a *eip155AccountID) GobDecode(data []byte) error     { return gobDecodeNative(a, data) }
+func (a *filecoinAccountID) GobDecode(data []byte) error   { return gobDecodeNative(a, data) }
+func (a *flowAccountID) GobDecode(data []byte) error       { return gobDecodeNative(a, data) }
+func (a *hederaAccountID) GobDecode(data []byte) error     { return gobDecodeNative(a, data) }
+func (a *icpAccountID) GobDecode(data []byte) error        { return gobDecodeNative(a, data) }
+func (a *kaspaAccountID) GobDecode(data []byte) error      { return gobDecodeNative(a, data) }
+func (a *multiversXAccountID) GobDecode(data []byte) error { return gobDecodeNative(a, data) }
+func (a *nearAccountID) GobDecode(data []byte) error       { return gobDecodeNative(a, data) }
+func (a *polkadotAccountID) GobDecode(data []byte) error   { return gobDecodeNative(a, data) }
+func (a *solanaAccountID) GobDecode(data []byte) error     { return gobDecodeNative(a, data) }
+func (a *stacksAccountID) GobDecode(data []byte) error     { return gobDecodeNative(a, data) }
+func (a *stellarAccountID) GobDecode(data []byte) error    { return gobDecodeNative(a, data) }
+func (a *tonAccountID) GobDecode(data []byte) error        { return gobDecodeNative(a, data) }
+func (a *tronAccountID) GobDecode(data []byte) error       { return gobDecodeNative(a, data) }
+
+func gobDecodeNative[T any](dst *T, data []byte) error {
+	if len(data) == 0 {
+		var zero T
+		*dst = zero
+		return nil
+	}
+	ns, ref, addr, err := SplitCAIP10(string(data))
+	if err != nil {
+		return err
+	}
+	p, ok := GetParser(ns)
+	if !ok {
+		return fmt.Errorf("%w: gob: no parser registered for %q", ErrUnknownNamespace, ns)
+	}
+	a, err := p.ParseAddress(ref, addr)
+	if err != nil {
+		return err
+	}
+	native, ok := any(a).(*T)
+	if !ok {
+		return fmt.Errorf("%w: gob: cannot decode %q into %T", ErrInvalidNamespace, data, dst)
+	}
+	*dst = *native
+	return nil
+}
@@ -0,0 +1,94 @@
+package caip10
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type gobEnvelope struct {
+	Account any
+	Chain   ChainID
+	Generic *GenericAccountID
+}
+
+func gobRoundTrip(t *testing.T, in, out any) {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+	require.NoError(t, gob.NewDecoder(&buf).Decode(out))
+}
+
+func TestGobNativeTypes(t *testing.T) {
+	RegisterGobTypes()
+	RegisterGobTypes() // idempotent
+
+	inputs := []string{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+		"bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6",
+		"hedera:mainnet:0.0.123",
+		"near:mainnet:alice.near",
+		"tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+	}
+	for _, s := range inputs {
+		in := gobEnvelope{
+			Account: MustParse(s),
+			Chain:   MustParse(s).ChainID(),
+			Generic: MustNewGeneric(mustSplit(t, s)),
+		}
+		var out gobEnvelope
+		gobRoundTrip(t, in, &out)
+
+		assert.Equal(t, fmt.Sprintf("%T", in.Account), fmt.Sprintf("%T", out.Account), s)
+		assert.True(t, Equal(in.Account.(AccountID), out.Account.(AccountID)), s)
+		assert.Equal(t, in.Chain, out.Chain, s)
+		assert.True(t, in.Generic.Equal(out.Generic), s)
+	}
+}
+
+func TestGobGeneric(t *testing.T) {
+	RegisterGobTypes()
+	a := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	in := gobEnvelope{Account: a, Generic: a}
+	var out gobEnvelope
+	gobRoundTrip(t, in, &out)
+	assert.IsType(t, &GenericAccountID{}, out.Account)
+	assert.True(t, a.Equal(out.Account.(AccountID)))
+	assert.True(t, a.Equal(out.Generic))
+	assert.True(t, out.Chain.IsZero())
+}
+
+func TestGobNativeFields(t *testing.T) {
+	RegisterGobTypes()
+	in := gobEnvelope{Account: MustParse("eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")}
+	var out gobEnvelope
+	gobRoundTrip(t, in, &out)
+
+	eth, ok := out.Account.(EIP155AccountID)
+	require.True(t, ok, "got %T", out.Account)
+	assert.Equal(t, in.Account.(EIP155AccountID).Account(), eth.Account())
+	assert.Equal(t, int64(137), eth.EIP155ChainID().Int64())
+}
+
+func TestGobDecodeErrors(t *testing.T) {
+	var a GenericAccountID
+	assert.True(t, errors.Is(a.GobDecode([]byte("eip155:1")), ErrInvalidFormat))
+
+	var eth eip155AccountID
+	err := eth.GobDecode([]byte("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"))
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	err = eth.GobDecode([]byte("unknown:1:abc"))
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+
+	require.NoError(t, eth.GobDecode(nil))
+	assert.True(t, eth.IsZero())
+
+	var c ChainID
+	assert.Error(t, c.GobDecode([]byte("eip155")))
+}
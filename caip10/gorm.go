@@ -0,0 +1,157 @@
+package caip10
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// GormSerializerName is the name of the GORM serializer registered by this package.
+// It stores AccountID and ChainID fields as a single string column and is needed
+// for fields of interface type, which GORM cannot scan into:
+//
+//	type Wallet struct {
+//		ID    uint
+//		Owner caip10.AccountID `gorm:"serializer:caip10;type:varchar(170)"`
+//	}
+//
+// Accounts are scanned into their native types; fields of type *GenericAccountID
+// receive a *GenericAccountID. Zero values are stored as NULL.
+const GormSerializerName = "caip10"
+
+func init() {
+	schema.RegisterSerializer(GormSerializerName, gormSerializer{})
+}
+
+var (
+	chainIDType          = reflect.TypeFor[ChainID]()
+	genericAccountIDType = reflect.TypeFor[*GenericAccountID]()
+)
+
+type gormSerializer struct{}
+
+// Scan implements schema.SerializerInterface.
+func (gormSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	fieldValue := reflect.New(field.FieldType).Elem()
+	if dbValue != nil {
+		var s string
+		switch v := dbValue.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		default:
+			return fmt.Errorf("caip10: cannot scan type %T into %s", dbValue, field.Name)
+		}
+		if s != "" {
+			v, err := gormParse(field.FieldType, s)
+			if err != nil {
+				return fmt.Errorf("caip10: scan %s: %w", field.Name, err)
+			}
+			fieldValue.Set(v)
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+func gormParse(t reflect.Type, s string) (reflect.Value, error) {
+	if t == chainIDType {
+		c, err := ParseChainID(s)
+		return reflect.ValueOf(c), err
+	}
+	var opts []ParseOption
+	if t == genericAccountIDType {
+		opts = append(opts, WithoutNativeTypes())
+	}
+	a, err := Parse(s, opts...)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	v := reflect.ValueOf(a)
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("%w: cannot assign %T to %s", ErrInvalidNamespace, a, t)
+	}
+	return v, nil
+}
+
+// Value implements schema.SerializerValuerInterface.
+func (gormSerializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	switch v := fieldValue.(type) {
+	case nil:
+		return nil, nil
+	case ChainID:
+		return v.Value()
+	case AccountID:
+		if v.IsZero() {
+			return nil, nil
+		}
+		return v.String(), nil
+	default:
+		return nil, fmt.Errorf("caip10: serializer %q does not support %s of type %T", GormSerializerName, field.Name, fieldValue)
+	}
+}
+
+// GormDataType implements schema.GormDataTypeInterface.
+func (a *GenericAccountID) GormDataType() string {
+	return string(schema.String)
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface, sizing the column for
+// the longest CAIP-10 string.
+func (a *GenericAccountID) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	return gormStringType(db, AccountIDMaxLen)
+}
+
+// GormDataType implements schema.GormDataTypeInterface.
+func (c ChainID) GormDataType() string {
+	return string(schema.String)
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface, sizing the column for
+// the longest CAIP-2 string.
+func (c ChainID) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	return gormStringType(db, ChainIDMaxLen)
+}
+
+func gormStringType(db *gorm.DB, size int) string {
+	n := strconv.Itoa(size)
+	switch db.Dialector.Name() {
+	case "mysql", "postgres":
+		return "varchar(" + n + ")"
+	case "sqlserver":
+		return "nvarchar(" + n + ")"
+	case "sqlite":
+		return "text"
+	default:
+		return ""
+	}
+}
+
+// CreateAccountIDColumnsIndex creates a composite index on the namespace, reference
+// and address columns of an AccountIDColumns embedded in model with embeddedPrefix
+// prefix, for use in migrations after AutoMigrate. The index is named
+// idx_<table>_<prefix>account; nothing is done if it already exists.
+func CreateAccountIDColumnsIndex(db *gorm.DB, model any, prefix string) error {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	name := "idx_" + stmt.Table + "_" + prefix + "account"
+	if db.Migrator().HasIndex(model, name) {
+		return nil
+	}
+	columns := make([]any, 0, 3)
+	for _, col := range []string{"namespace", "reference", "address"} {
+		if stmt.Schema.LookUpField(prefix+col) == nil {
+			return fmt.Errorf("caip10: %s has no column %q", stmt.Schema.Name, prefix+col)
+		}
+		columns = append(columns, clause.Column{Name: prefix + col})
+	}
+	return db.Exec("CREATE INDEX ? ON ? (?,?,?)", append([]any{clause.Column{Name: name}, clause.Table{Name: stmt.Table}}, columns...)...).Error
+}
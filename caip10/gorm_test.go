@@ -0,0 +1,168 @@
+package caip10
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+type gormWallet struct {
+	ID     uint
+	Owner  AccountID         `gorm:"serializer:caip10"`
+	Backup *GenericAccountID `gorm:"serializer:caip10"`
+	Chain  ChainID
+}
+
+type gormTransfer struct {
+	ID   uint
+	From AccountIDColumns `gorm:"embedded;embeddedPrefix:from_"`
+	To   AccountIDColumns `gorm:"embedded;embeddedPrefix:to_"`
+}
+
+type gormNamedDialector struct {
+	tests.DummyDialector
+	name     string
+	migrator gorm.Migrator
+}
+
+func (d gormNamedDialector) Name() string { return d.name }
+
+func (d gormNamedDialector) Migrator(*gorm.DB) gorm.Migrator { return d.migrator }
+
+func gormDryRun(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+	return db
+}
+
+func gormParseSchema(t *testing.T, model any) *schema.Schema {
+	t.Helper()
+	s, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	require.NoError(t, err)
+	return s
+}
+
+func TestGormSerializerValue(t *testing.T) {
+	w := gormWallet{
+		Owner:  MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"),
+		Backup: MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc"),
+		Chain:  ChainIDPolygon,
+	}
+	assert.Equal(t, []any{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"cosmos:cosmoshub-3:cosmos1abc",
+		"eip155:137",
+	}, gormCreateValues(t, &w))
+	assert.Equal(t, []any{nil, nil, nil}, gormCreateValues(t, &gormWallet{}))
+}
+
+// gormCreateValues returns the driver values GORM would insert for model.
+func gormCreateValues(t *testing.T, model any) []any {
+	t.Helper()
+	stmt := gormDryRun(t).Create(model).Statement
+	values := make([]any, len(stmt.Vars))
+	for i, v := range stmt.Vars {
+		if valuer, ok := v.(driver.Valuer); ok {
+			var err error
+			v, err = valuer.Value()
+			require.NoError(t, err)
+		}
+		values[i] = v
+	}
+	return values
+}
+
+func TestGormSerializerScan(t *testing.T) {
+	s := gormParseSchema(t, &gormWallet{})
+	owner, backup := s.LookUpField("Owner"), s.LookUpField("Backup")
+	assert.Equal(t, schema.String, owner.DataType)
+
+	var w gormWallet
+	dst := reflect.ValueOf(&w).Elem()
+	ctx := context.Background()
+
+	require.NoError(t, owner.Serializer.Scan(ctx, owner, dst, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"))
+	eth, ok := w.Owner.(EIP155AccountID)
+	require.True(t, ok, "got %T", w.Owner)
+	assert.Equal(t, "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ChecksumAddress(eth))
+
+	require.NoError(t, backup.Serializer.Scan(ctx, backup, dst, []byte("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")))
+	assert.Equal(t, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", w.Backup.String())
+
+	require.NoError(t, owner.Serializer.Scan(ctx, owner, dst, nil))
+	assert.Nil(t, w.Owner)
+
+	err := owner.Serializer.Scan(ctx, owner, dst, "eip155:1:0x12")
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+	assert.Contains(t, err.Error(), "Owner")
+	assert.Error(t, owner.Serializer.Scan(ctx, owner, dst, 42))
+}
+
+func TestGormEmbeddedColumnsIndexes(t *testing.T) {
+	s := gormParseSchema(t, &gormTransfer{})
+	for _, col := range []string{"from_namespace", "from_reference", "from_address", "to_namespace", "to_reference", "to_address"} {
+		assert.NotNil(t, s.LookUpField(col), col)
+	}
+
+	rec := &gormSQLRecorder{Interface: logger.Discard}
+	migrator := gormStubMigrator{indexes: map[string]bool{"idx_gorm_transfers_to_account": true}}
+	db, err := gorm.Open(gormNamedDialector{name: "mysql", migrator: migrator}, &gorm.Config{DryRun: true, Logger: rec})
+	require.NoError(t, err)
+
+	require.NoError(t, CreateAccountIDColumnsIndex(db, &gormTransfer{}, "from_"))
+	require.NoError(t, CreateAccountIDColumnsIndex(db, &gormTransfer{}, "to_"))
+	assert.Equal(t, []string{
+		"CREATE INDEX `idx_gorm_transfers_from_account` ON `gorm_transfers` (`from_namespace`,`from_reference`,`from_address`)",
+	}, rec.sql)
+
+	assert.Error(t, CreateAccountIDColumnsIndex(db, &gormTransfer{}, "via_"))
+}
+
+type gormStubMigrator struct {
+	gorm.Migrator
+	indexes map[string]bool
+}
+
+func (m gormStubMigrator) HasIndex(_ any, name string) bool { return m.indexes[name] }
+
+type gormSQLRecorder struct {
+	logger.Interface
+	sql []string
+}
+
+func (r *gormSQLRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.sql = append(r.sql, sql)
+}
+
+func TestGormDBDataType(t *testing.T) {
+	cases := []struct {
+		dialect string
+		account string
+		chain   string
+	}{
+		{"mysql", "varchar(170)", "varchar(41)"},
+		{"postgres", "varchar(170)", "varchar(41)"},
+		{"sqlserver", "nvarchar(170)", "nvarchar(41)"},
+		{"sqlite", "text", "text"},
+		{"dummy", "", ""},
+	}
+	for _, tt := range cases {
+		db := &gorm.DB{Config: &gorm.Config{Dialector: gormNamedDialector{name: tt.dialect}}}
+		assert.Equal(t, tt.account, (&GenericAccountID{}).GormDBDataType(db, nil), tt.dialect)
+		assert.Equal(t, tt.chain, ChainID{}.GormDBDataType(db, nil), tt.dialect)
+	}
+	assert.Equal(t, "string", (&GenericAccountID{}).GormDataType())
+	assert.Equal(t, "string", ChainID{}.GormDataType())
+}
@@ -0,0 +1,125 @@
+package caip10
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GraphQL scalar names. Declare them in the schema, e.g. "scalar CAIP10Account".
+const (
+	GraphQLAccountScalar = "CAIP10Account"
+	GraphQLChainScalar   = "CAIP2Chain"
+	GraphQLAssetScalar   = "CAIP19Asset"
+)
+
+// GraphQL support. MarshalGQL and UnmarshalGQL implement gqlgen's graphql.Marshaler
+// and graphql.Unmarshaler; ImplementsGraphQLType and UnmarshalGraphQL implement
+// custom scalars for github.com/graph-gophers/graphql-go, which outputs them with
+// MarshalJSON. Values are strings; zero values are written as null and empty
+// input strings are rejected with ErrEmptyValue.
+
+// MarshalGQL writes the account as a GraphQL string.
+func (a *GenericAccountID) MarshalGQL(w io.Writer) {
+	writeGQLString(w, a.IsZero(), a.String())
+}
+
+// UnmarshalGQL parses a GraphQL input value into the account.
+func (a *GenericAccountID) UnmarshalGQL(v any) error {
+	s, err := gqlString(GraphQLAccountScalar, v)
+	if err != nil {
+		return err
+	}
+	if err := a.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", GraphQLAccountScalar, s, err)
+	}
+	return nil
+}
+
+// ImplementsGraphQLType reports whether name is the CAIP10Account scalar.
+func (a *GenericAccountID) ImplementsGraphQLType(name string) bool {
+	return name == GraphQLAccountScalar
+}
+
+// UnmarshalGraphQL parses a GraphQL input value into the account.
+func (a *GenericAccountID) UnmarshalGraphQL(input any) error {
+	return a.UnmarshalGQL(input)
+}
+
+// MarshalGQL writes the chain ID as a GraphQL string.
+func (c ChainID) MarshalGQL(w io.Writer) {
+	writeGQLString(w, c.IsZero(), c.String())
+}
+
+// UnmarshalGQL parses a GraphQL input value into the chain ID.
+func (c *ChainID) UnmarshalGQL(v any) error {
+	s, err := gqlString(GraphQLChainScalar, v)
+	if err != nil {
+		return err
+	}
+	if err := c.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", GraphQLChainScalar, s, err)
+	}
+	return nil
+}
+
+// ImplementsGraphQLType reports whether name is the CAIP2Chain scalar.
+func (c ChainID) ImplementsGraphQLType(name string) bool {
+	return name == GraphQLChainScalar
+}
+
+// UnmarshalGraphQL parses a GraphQL input value into the chain ID.
+func (c *ChainID) UnmarshalGraphQL(input any) error {
+	return c.UnmarshalGQL(input)
+}
+
+// MarshalGQL writes the asset ID as a GraphQL string.
+func (a *GenericAssetID) MarshalGQL(w io.Writer) {
+	writeGQLString(w, a.IsZero(), a.String())
+}
+
+// UnmarshalGQL parses a GraphQL input value into the asset ID.
+func (a *GenericAssetID) UnmarshalGQL(v any) error {
+	s, err := gqlString(GraphQLAssetScalar, v)
+	if err != nil {
+		return err
+	}
+	if err := a.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", GraphQLAssetScalar, s, err)
+	}
+	return nil
+}
+
+// ImplementsGraphQLType reports whether name is the CAIP19Asset scalar.
+func (a *GenericAssetID) ImplementsGraphQLType(name string) bool {
+	return name == GraphQLAssetScalar
+}
+
+// UnmarshalGraphQL parses a GraphQL input value into the asset ID.
+func (a *GenericAssetID) UnmarshalGraphQL(input any) error {
+	return a.UnmarshalGQL(input)
+}
+
+func writeGQLString(w io.Writer, zero bool, s string) {
+	if zero {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	_, _ = io.WriteString(w, strconv.Quote(s))
+}
+
+func gqlString(scalar string, v any) (string, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return "", fmt.Errorf("%w: %s must be a string, got %T", ErrInvalidFormat, scalar, v)
+	}
+	if s == "" {
+		return "", fmt.Errorf("%w: %s must not be empty", ErrEmptyValue, scalar)
+	}
+	return s, nil
+}
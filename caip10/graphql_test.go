@@ -0,0 +1,83 @@
+package caip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLAccount(t *testing.T) {
+	const s = "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	var a GenericAccountID
+	require.NoError(t, a.UnmarshalGQL(s))
+	assert.Equal(t, s, a.String())
+
+	var buf bytes.Buffer
+	a.MarshalGQL(&buf)
+	assert.Equal(t, `"`+s+`"`, buf.String())
+
+	var b GenericAccountID
+	require.NoError(t, b.UnmarshalGraphQL(s))
+	assert.True(t, a.Equal(&b))
+	assert.True(t, b.ImplementsGraphQLType("CAIP10Account"))
+	assert.False(t, b.ImplementsGraphQLType("CAIP2Chain"))
+
+	buf.Reset()
+	(&GenericAccountID{}).MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+}
+
+func TestGraphQLChainID(t *testing.T) {
+	var c ChainID
+	require.NoError(t, c.UnmarshalGQL("eip155:137"))
+	assert.Equal(t, ChainIDPolygon, c)
+
+	var buf bytes.Buffer
+	c.MarshalGQL(&buf)
+	assert.Equal(t, `"eip155:137"`, buf.String())
+	assert.True(t, c.ImplementsGraphQLType(GraphQLChainScalar))
+
+	var d ChainID
+	require.NoError(t, d.UnmarshalGraphQL("eip155:137"))
+	assert.Equal(t, c, d)
+}
+
+func TestGraphQLAsset(t *testing.T) {
+	const s = "cosmos:cosmoshub-3/slip44:118"
+	var a GenericAssetID
+	require.NoError(t, a.UnmarshalGQL(s))
+	assert.Equal(t, s, a.String())
+
+	var buf bytes.Buffer
+	a.MarshalGQL(&buf)
+	assert.Equal(t, `"`+s+`"`, buf.String())
+	assert.True(t, a.ImplementsGraphQLType(GraphQLAssetScalar))
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	var a GenericAccountID
+	err := a.UnmarshalGQL(42)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	assert.EqualError(t, err, "caip10: invalid account ID format: CAIP10Account must be a string, got int")
+
+	err = a.UnmarshalGQL("")
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+
+	err = a.UnmarshalGQL("eip155:1:0x12")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid CAIP10Account "eip155:1:0x12"`)
+
+	var c ChainID
+	err = c.UnmarshalGQL("eip155")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid CAIP2Chain "eip155"`)
+	assert.True(t, errors.Is(c.UnmarshalGQL(""), ErrEmptyValue))
+
+	var asset GenericAssetID
+	err = asset.UnmarshalGQL("cosmos:cosmoshub-3")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid CAIP19Asset "cosmos:cosmoshub-3"`)
+}
@@ -0,0 +1,312 @@
+package caip10
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const NamespaceHedera Namespace = "hedera"
+
+// HederaNetwork represents a Hedera network (chain reference).
+// https://github.com/ChainAgnostic/namespaces/blob/main/hedera/caip10.md
+type HederaNetwork string
+
+// Hedera networks
+const (
+	HederaMainnet    HederaNetwork = "mainnet"
+	HederaTestnet    HederaNetwork = "testnet"
+	HederaPreviewnet HederaNetwork = "previewnet"
+	HederaDevnet     HederaNetwork = "devnet"
+)
+
+// String returns the network reference string.
+func (n HederaNetwork) String() string {
+	return string(n)
+}
+
+// hederaLedgerIDs maps networks to the ledger ID used in HIP-15 checksums.
+// Devnet has no fixed ledger ID, so its checksums cannot be verified.
+var hederaLedgerIDs = map[HederaNetwork][]byte{
+	HederaMainnet:    {0x00},
+	HederaTestnet:    {0x01},
+	HederaPreviewnet: {0x02},
+}
+
+// hederaChecksumLength is the number of letters in a HIP-15 checksum.
+const hederaChecksumLength = 5
+
+// HederaChecksum computes the HIP-15 checksum of an entity ID (shard.realm.num)
+// for the given network. It returns false if the network has no known ledger ID.
+// https://hips.hedera.com/hip/hip-15
+func HederaChecksum(network HederaNetwork, entityID string) (string, bool) {
+	ledgerID, ok := hederaLedgerIDs[network]
+	if !ok {
+		return "", false
+	}
+
+	const (
+		p3 = 26 * 26 * 26           // 3 digits in base 26
+		p5 = 26 * 26 * 26 * 26 * 26 // 5 digits in base 26
+		m  = 1_000_003              // min prime greater than a million
+		w  = 31                     // sum of digit values weights them by powers of w
+	)
+
+	var sd0, sd1, sd, sh uint64
+	for i := 0; i < len(entityID); i++ {
+		d := uint64(10) // '.'
+		if c := entityID[i]; c != '.' {
+			d = uint64(c - '0')
+		}
+		sd = (w*sd + d) % p3
+		if i%2 == 0 {
+			sd0 = (sd0 + d) % 11
+		} else {
+			sd1 = (sd1 + d) % 11
+		}
+	}
+
+	h := append(append([]byte{}, ledgerID...), 0, 0, 0, 0, 0, 0)
+	for _, b := range h {
+		sh = (w*sh + uint64(b)) % p5
+	}
+
+	c := ((((uint64(len(entityID))%5)*11+sd0)*11+sd1)*p3 + sd + sh) % p5
+	cp := (c * m) % p5
+
+	var out [hederaChecksumLength]byte
+	for i := hederaChecksumLength - 1; i >= 0; i-- {
+		out[i] = byte('a' + cp%26)
+		cp /= 26
+	}
+	return string(out[:]), true
+}
+
+// HederaEntityID is a decoded shard.realm.num entity ID.
+type HederaEntityID struct {
+	Shard uint64
+	Realm uint64
+	Num   uint64
+	// Checksum is the optional HIP-15 checksum suffix, empty if absent.
+	Checksum string
+}
+
+// String returns shard.realm.num without checksum.
+func (e HederaEntityID) String() string {
+	return strconv.FormatUint(e.Shard, 10) + "." + strconv.FormatUint(e.Realm, 10) + "." + strconv.FormatUint(e.Num, 10)
+}
+
+// ParseHederaEntityID parses shard.realm.num[-checksum] without verifying the checksum.
+func ParseHederaEntityID(s string) (HederaEntityID, error) {
+	var e HederaEntityID
+	id, checksum, hasChecksum := strings.Cut(s, "-")
+	if hasChecksum {
+		if len(checksum) != hederaChecksumLength || strings.Trim(checksum, "abcdefghijklmnopqrstuvwxyz") != "" {
+			return e, fmt.Errorf("%w: hedera checksum must be %d lowercase letters, got %q",
+				ErrInvalidAddress, hederaChecksumLength, checksum)
+		}
+		e.Checksum = checksum
+	}
+
+	parts := strings.Split(id, ".")
+	if len(parts) != 3 {
+		return e, fmt.Errorf("%w: hedera entity id must be shard.realm.num, got %q", ErrInvalidAddress, id)
+	}
+	nums := [3]*uint64{&e.Shard, &e.Realm, &e.Num}
+	for i, p := range parts {
+		v, err := strconv.ParseUint(p, 10, 63)
+		if err != nil || strconv.FormatUint(v, 10) != p {
+			return e, fmt.Errorf("%w: invalid hedera entity id component %q", ErrInvalidAddress, p)
+		}
+		*nums[i] = v
+	}
+	return e, nil
+}
+
+// ValidateHederaAddress validates a Hedera account address for a specific network.
+// The checksum, if present, is verified for networks with a known ledger ID.
+func ValidateHederaAddress(network HederaNetwork, address string) error {
+	_, err := parseHederaAddress(network, address)
+	return err
+}
+
+func parseHederaAddress(network HederaNetwork, address string) (HederaEntityID, error) {
+	e, err := ParseHederaEntityID(address)
+	if err != nil {
+		return e, err
+	}
+	if e.Checksum != "" {
+		if want, ok := HederaChecksum(network, e.String()); ok && want != e.Checksum {
+			return e, fmt.Errorf("%w: hedera checksum mismatch for %s on %s", ErrInvalidChecksum, e, network)
+		}
+	}
+	return e, nil
+}
+
+// HederaAccountID is the interface for Hedera account IDs.
+type HederaAccountID interface {
+	AccountID
+	// Network returns the Hedera network.
+	Network() HederaNetwork
+	// EntityID returns the decoded entity ID.
+	EntityID() HederaEntityID
+	// Shard returns the shard number.
+	Shard() uint64
+	// Realm returns the realm number.
+	Realm() uint64
+	// Num returns the account number.
+	Num() uint64
+	// WithChecksum returns the account with a computed checksum suffix.
+	// The account is returned unchanged if the network has no known ledger ID.
+	WithChecksum() HederaAccountID
+	// WithoutChecksum returns the account without checksum suffix.
+	WithoutChecksum() HederaAccountID
+}
+
+// Ensure hederaAccountID implements HederaAccountID at compile time
+var _ HederaAccountID = (*hederaAccountID)(nil)
+
+func init() {
+	RegisterParser(&hederaParser{})
+}
+
+// hederaAccountID represents a Hedera account ID per CAIP-10.
+type hederaAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	entity            HederaEntityID
+}
+
+// NewHedera creates a new HederaAccountID from entity ID components, without checksum.
+func NewHedera(network HederaNetwork, shard, realm, num uint64) HederaAccountID {
+	return newHedera(network, HederaEntityID{Shard: shard, Realm: realm, Num: num})
+}
+
+func newHedera(network HederaNetwork, e HederaEntityID) *hederaAccountID {
+	address := e.String()
+	if e.Checksum != "" {
+		address += "-" + e.Checksum
+	}
+	return &hederaAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceHedera, network.String(), address),
+		entity:           e,
+	}
+}
+
+// NewHederaFromString creates a new HederaAccountID from shard.realm.num[-checksum].
+// Validation:
+//  1. Three non-negative decimal components without leading zeros
+//  2. Optional checksum of five lowercase letters
+//  3. Checksum matches the network (mainnet, testnet, previewnet)
+func NewHederaFromString(network HederaNetwork, address string) (HederaAccountID, error) {
+	if err := validateReference(NamespaceHedera, string(network)); err != nil {
+		return nil, err
+	}
+	e, err := parseHederaAddress(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return newHedera(network, e), nil
+}
+
+// MustNewHederaFromString creates a new HederaAccountID and panics if invalid.
+func MustNewHederaFromString(network HederaNetwork, address string) HederaAccountID {
+	a, err := NewHederaFromString(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Hedera network.
+func (a *hederaAccountID) Network() HederaNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return HederaNetwork(a.Reference())
+}
+
+// EntityID returns the decoded entity ID.
+func (a *hederaAccountID) EntityID() HederaEntityID {
+	if a == nil {
+		return HederaEntityID{}
+	}
+	return a.entity
+}
+
+// Shard returns the shard number.
+func (a *hederaAccountID) Shard() uint64 {
+	return a.EntityID().Shard
+}
+
+// Realm returns the realm number.
+func (a *hederaAccountID) Realm() uint64 {
+	return a.EntityID().Realm
+}
+
+// Num returns the account number.
+func (a *hederaAccountID) Num() uint64 {
+	return a.EntityID().Num
+}
+
+// WithChecksum returns the account with a computed checksum suffix.
+func (a *hederaAccountID) WithChecksum() HederaAccountID {
+	if a == nil {
+		return nil
+	}
+	checksum, ok := HederaChecksum(a.Network(), a.entity.String())
+	if !ok {
+		return a
+	}
+	e := a.entity
+	e.Checksum = checksum
+	return newHedera(a.Network(), e)
+}
+
+// WithoutChecksum returns the account without checksum suffix.
+func (a *hederaAccountID) WithoutChecksum() HederaAccountID {
+	if a == nil {
+		return nil
+	}
+	e := a.entity
+	e.Checksum = ""
+	return newHedera(a.Network(), e)
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *hederaAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *hederaAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- hederaParser ---
+
+type hederaParser struct{}
+
+func (p *hederaParser) Namespace() Namespace {
+	return NamespaceHedera
+}
+
+func (p *hederaParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceHedera {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceHedera, ns)
+	}
+	return NewHederaFromString(HederaNetwork(ref), addr)
+}
+
+func (p *hederaParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewHederaFromString(HederaNetwork(reference), address)
+}
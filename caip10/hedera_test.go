@@ -0,0 +1,98 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHederaChecksum(t *testing.T) {
+	tests := []struct {
+		network  HederaNetwork
+		entityID string
+		want     string
+	}{
+		{HederaMainnet, "0.0.123", "vfmkw"}, // HIP-15 example
+		{HederaMainnet, "0.0.1234567890", "zbhlt"},
+		{HederaTestnet, "0.0.123", "esxsf"},
+	}
+	for _, tt := range tests {
+		got, ok := HederaChecksum(tt.network, tt.entityID)
+		require.True(t, ok)
+		assert.Equal(t, tt.want, got, "%s on %s", tt.entityID, tt.network)
+	}
+
+	_, ok := HederaChecksum(HederaDevnet, "0.0.123")
+	assert.False(t, ok)
+}
+
+func TestHederaParse(t *testing.T) {
+	input := "hedera:mainnet:0.0.1234567890-zbhlt"
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	hbar, ok := a.(HederaAccountID)
+	require.True(t, ok, "expected HederaAccountID, got %T", a)
+	assert.Equal(t, HederaMainnet, hbar.Network())
+	assert.Equal(t, uint64(0), hbar.Shard())
+	assert.Equal(t, uint64(0), hbar.Realm())
+	assert.Equal(t, uint64(1234567890), hbar.Num())
+	assert.Equal(t, "zbhlt", hbar.EntityID().Checksum)
+	assert.Equal(t, ChainIDHederaMainnet, hbar.ChainID())
+	assert.Equal(t, input, hbar.String())
+
+	assert.Equal(t, "hedera:mainnet:0.0.1234567890", hbar.WithoutChecksum().String())
+}
+
+func TestNewHedera(t *testing.T) {
+	a := NewHedera(HederaMainnet, 0, 0, 123)
+	assert.Equal(t, "0.0.123", a.Address())
+	assert.Equal(t, "0.0.123-vfmkw", a.WithChecksum().Address())
+	assert.NoError(t, a.WithChecksum().Validate())
+
+	// Devnet has no known ledger ID, so no checksum is added
+	assert.Equal(t, "0.0.123", NewHedera(HederaDevnet, 0, 0, 123).WithChecksum().Address())
+}
+
+func TestHederaDevnetChecksumNotVerified(t *testing.T) {
+	a, err := NewHederaFromString(HederaDevnet, "0.0.123-aaaaa")
+	require.NoError(t, err)
+	assert.Equal(t, "aaaaa", a.EntityID().Checksum)
+}
+
+func TestHederaParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "hedera:betanet:0.0.123", ErrInvalidReference},
+		{"checksum mismatch", "hedera:mainnet:0.0.123-vfmkx", ErrInvalidAddress},
+		{"wrong network checksum", "hedera:testnet:0.0.123-vfmkw", ErrInvalidAddress},
+		{"short checksum", "hedera:mainnet:0.0.123-vfmk", ErrInvalidAddress},
+		{"uppercase checksum", "hedera:mainnet:0.0.123-VFMKW", ErrInvalidAddress},
+		{"two components", "hedera:mainnet:0.123", ErrInvalidAddress},
+		{"leading zero", "hedera:mainnet:0.0.0123", ErrInvalidAddress},
+		{"negative", "hedera:mainnet:0.-1.123", ErrInvalidAddress},
+		{"empty component", "hedera:mainnet:0..123", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestHederaNilReceiver(t *testing.T) {
+	var a *hederaAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, HederaNetwork(""), a.Network())
+	assert.Equal(t, uint64(0), a.Num())
+	assert.Nil(t, a.WithChecksum())
+	assert.Nil(t, a.WithoutChecksum())
+}
@@ -0,0 +1,106 @@
+package caip10
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// HookEvent describes a Parse or Validate call passed to hooks.
+type HookEvent struct {
+	// Input is the raw CAIP-10 string that was parsed or validated.
+	Input string
+	// Account is the parsed or validated account, nil if parsing failed.
+	Account AccountID
+	// Err is the parse or validation error, nil on success.
+	Err error
+}
+
+// Type returns the concrete type of the parsed account, e.g. "*caip10.eip155AccountID",
+// or an empty string if parsing failed.
+func (e HookEvent) Type() string {
+	if e.Account == nil {
+		return ""
+	}
+	return fmt.Sprintf("%T", e.Account)
+}
+
+// Hook is called with the outcome of a Parse or Validate call. Hooks run
+// synchronously on the calling goroutine and must be safe for concurrent use.
+type Hook func(HookEvent)
+
+type hookEntry struct {
+	id   uint64
+	hook Hook
+}
+
+// hookList is a copy-on-write list of hooks; reads are lock-free.
+type hookList struct {
+	mu     sync.Mutex
+	nextID uint64
+	hooks  atomic.Pointer[[]hookEntry]
+}
+
+func (l *hookList) add(h Hook) (remove func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nextID++
+	id := l.nextID
+	var hooks []hookEntry
+	if p := l.hooks.Load(); p != nil {
+		hooks = slices.Clone(*p)
+	}
+	hooks = append(hooks, hookEntry{id: id, hook: h})
+	l.hooks.Store(&hooks)
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		hooks := slices.DeleteFunc(slices.Clone(*l.hooks.Load()), func(e hookEntry) bool { return e.id == id })
+		l.hooks.Store(&hooks)
+	}
+}
+
+func (l *hookList) fire(e HookEvent) {
+	p := l.hooks.Load()
+	if p == nil {
+		return
+	}
+	for _, entry := range *p {
+		entry.hook(e)
+	}
+}
+
+var (
+	parseHooks         hookList
+	validateErrorHooks hookList
+)
+
+// OnParse registers a hook called after every Parse, MustParse and ParseWithNamespace
+// call, successful or not. Decoding JSON, text, binary or database values does not
+// call the hook. It returns a function that removes the hook.
+func OnParse(h Hook) (remove func()) {
+	return parseHooks.add(h)
+}
+
+// OnValidateError registers a hook called whenever Validate, ValidateWithPolicy or
+// NewGeneric reports an error. It returns a function that removes the hook.
+func OnValidateError(h Hook) (remove func()) {
+	return validateErrorHooks.add(h)
+}
+
+func fireParseHooks(input string, a AccountID, err error) {
+	parseHooks.fire(HookEvent{Input: input, Account: a, Err: err})
+}
+
+func fireValidateErrorHooks(a AccountID, err error) {
+	if err == nil {
+		return
+	}
+	e := HookEvent{Err: err}
+	if a != nil && !a.IsZero() {
+		e.Input, e.Account = a.String(), a
+	}
+	validateErrorHooks.fire(e)
+}
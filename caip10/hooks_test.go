@@ -0,0 +1,83 @@
+package caip10
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordHook collects hook events for the duration of the test.
+type recordHook struct {
+	mu     sync.Mutex
+	events []HookEvent
+}
+
+func (r *recordHook) hook(e HookEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func TestOnParse(t *testing.T) {
+	var rec recordHook
+	remove := OnParse(rec.hook)
+	t.Cleanup(remove)
+
+	_, err := Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	require.NoError(t, err)
+	_, err = Parse("not-caip10")
+	require.Error(t, err)
+	_, err = ParseWithNamespace(NamespaceEIP155, "1", "0xnothex")
+	require.Error(t, err)
+
+	require.Len(t, rec.events, 3)
+	assert.Equal(t, "eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", rec.events[0].Input)
+	assert.Equal(t, "*caip10.eip155AccountID", rec.events[0].Type())
+	assert.NoError(t, rec.events[0].Err)
+
+	assert.Equal(t, "not-caip10", rec.events[1].Input)
+	assert.Nil(t, rec.events[1].Account)
+	assert.Equal(t, "", rec.events[1].Type())
+	assert.True(t, errors.Is(rec.events[1].Err, ErrInvalidFormat), "got %v", rec.events[1].Err)
+
+	assert.Equal(t, "eip155:1:0xnothex", rec.events[2].Input)
+
+	remove()
+	_, _ = Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	assert.Len(t, rec.events, 3, "removed hooks are not called")
+}
+
+func TestOnValidateError(t *testing.T) {
+	var rec recordHook
+	t.Cleanup(OnValidateError(rec.hook))
+
+	require.NoError(t, MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb").Validate())
+	assert.Empty(t, rec.events, "successful validation is not reported")
+
+	_, err := NewGeneric("foo", "bar", "b@z")
+	require.Error(t, err)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, "foo:bar:b@z", rec.events[0].Input)
+	assert.Equal(t, "*caip10.GenericAccountID", rec.events[0].Type())
+	assert.True(t, errors.Is(rec.events[0].Err, ErrInvalidAddress), "got %v", rec.events[0].Err)
+
+	require.Error(t, ValidateWithPolicy(nil, ValidationStandard))
+	require.Len(t, rec.events, 2)
+	assert.Nil(t, rec.events[1].Account)
+}
+
+func TestHooksMultiple(t *testing.T) {
+	var a, b recordHook
+	removeA := OnParse(a.hook)
+	t.Cleanup(removeA)
+	t.Cleanup(OnParse(b.hook))
+
+	_, _ = Parse("foo:bar:baz")
+	removeA()
+	_, _ = Parse("foo:bar:baz")
+	assert.Len(t, a.events, 1)
+	assert.Len(t, b.events, 2)
+}
@@ -0,0 +1,150 @@
+package caip10
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UnstoppableDomainsAPI is the Unstoppable Domains resolution API base URL.
+// https://docs.unstoppabledomains.com/resolution/
+const UnstoppableDomainsAPI = "https://api.unstoppabledomains.com"
+
+// UnstoppableDomainsRecords maps Unstoppable Domains record keys to chains.
+var UnstoppableDomainsRecords = map[string]ChainID{
+	"crypto.ETH.address":                 ChainIDEthereumMainnet,
+	"crypto.MATIC.version.MATIC.address": ChainIDPolygon,
+	"crypto.BTC.address":                 ChainIDBitcoinMainnet,
+	"crypto.SOL.address":                 ChainIDSolanaMainnet,
+}
+
+// Ensure HTTPResolver implements Resolver at compile time
+var _ Resolver = (*HTTPResolver)(nil)
+
+// HTTPResolver resolves names through a JSON resolution API such as Unstoppable Domains.
+//
+// Forward lookups GET BaseURL+ResolvePath and reverse lookups GET BaseURL+ReversePath,
+// with the escaped name or address substituted for %s. Responses have the form
+//
+//	{"meta": {"domain": "brad.crypto"}, "records": {"crypto.ETH.address": "0x..."}}
+//
+// and Records selects which record keys become accounts on which chain.
+type HTTPResolver struct {
+	BaseURL     string
+	ResolvePath string // e.g. "/resolve/domains/%s"
+	ReversePath string // e.g. "/resolve/reverse/%s"; empty disables reverse lookups
+	APIKey      string // sent as a bearer token if set
+	Records     map[string]ChainID
+	Client      *http.Client // nil uses http.DefaultClient
+}
+
+// NewUnstoppableDomainsResolver creates an HTTPResolver for the Unstoppable Domains API.
+func NewUnstoppableDomainsResolver(apiKey string) *HTTPResolver {
+	return &HTTPResolver{
+		BaseURL:     UnstoppableDomainsAPI,
+		ResolvePath: "/resolve/domains/%s",
+		ReversePath: "/resolve/reverse/%s",
+		APIKey:      apiKey,
+		Records:     maps.Clone(UnstoppableDomainsRecords),
+	}
+}
+
+type httpResolverResponse struct {
+	Meta struct {
+		Domain string `json:"domain"`
+	} `json:"meta"`
+	Records map[string]string `json:"records"`
+}
+
+// ResolveAll returns every account of a name keyed by chain.
+// Records that are empty or not valid for their chain are skipped.
+func (r *HTTPResolver) ResolveAll(ctx context.Context, name string) (map[ChainID]AccountID, error) {
+	resp, err := r.get(ctx, r.ResolvePath, strings.ToLower(name))
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(map[ChainID]AccountID)
+	for key, chainID := range r.Records {
+		value := strings.TrimSpace(resp.Records[key])
+		if value == "" {
+			continue
+		}
+		a, err := chainID.ToAccountID(value)
+		if err != nil {
+			continue
+		}
+		accounts[chainID] = a
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("%w: %q has no account records", ErrNameNotFound, name)
+	}
+	return accounts, nil
+}
+
+// Resolve returns the account of a name on chainID.
+func (r *HTTPResolver) Resolve(ctx context.Context, name string, chainID ChainID) (AccountID, error) {
+	accounts, err := r.ResolveAll(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	a, ok := accounts[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q has no account on %s", ErrNameNotFound, name, chainID)
+	}
+	return a, nil
+}
+
+// ReverseResolve returns the name whose reverse record points to the account's address.
+func (r *HTTPResolver) ReverseResolve(ctx context.Context, account AccountID) (string, error) {
+	if account == nil || account.IsZero() {
+		return "", ErrEmptyValue
+	}
+	if r.ReversePath == "" {
+		return "", fmt.Errorf("%w: reverse lookups are not configured", ErrNameNotFound)
+	}
+	resp, err := r.get(ctx, r.ReversePath, account.Address())
+	if err != nil {
+		return "", err
+	}
+	if resp.Meta.Domain == "" {
+		return "", fmt.Errorf("%w: no reverse record for %s", ErrNameNotFound, account)
+	}
+	return resp.Meta.Domain, nil
+}
+
+func (r *HTTPResolver) get(ctx context.Context, path, arg string) (*httpResolverResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.BaseURL+fmt.Sprintf(path, url.PathEscape(arg)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+r.APIKey)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %q", ErrNameNotFound, arg)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("resolve %q: unexpected HTTP status %s", arg, resp.Status)
+	}
+
+	var out httpResolverResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("resolve %q: decode response: %w", arg, err)
+	}
+	return &out, nil
+}
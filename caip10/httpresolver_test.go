@@ -0,0 +1,103 @@
+package caip10
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHTTPResolver(t *testing.T) *HTTPResolver {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/resolve/domains/brad.crypto":
+			_, _ = w.Write([]byte(`{
+				"meta": {"domain": "brad.crypto", "owner": "0x8aad44321a86b170879d7a244c1e8d360c99dda8"},
+				"records": {
+					"crypto.ETH.address": "0x8aaD44321A86b170879d7A244c1e8d360c99DdA8",
+					"crypto.MATIC.version.MATIC.address": "0x8aaD44321A86b170879d7A244c1e8d360c99DdA8",
+					"crypto.BTC.address": "bc1q359khn0phg58xgezyqsuuaha28zkwx047c0c3y",
+					"crypto.SOL.address": "not-a-solana-address!",
+					"crypto.DOGE.address": "DBXu2kgc3xtvCUWFcxFE3r9hEYgmuaaCyD"
+				}
+			}`))
+		case "/resolve/domains/empty.crypto":
+			_, _ = w.Write([]byte(`{"meta": {"domain": "empty.crypto"}, "records": {}}`))
+		case "/resolve/reverse/0x8aaD44321A86b170879d7A244c1e8d360c99DdA8":
+			_, _ = w.Write([]byte(`{"meta": {"domain": "brad.crypto"}}`))
+		case "/resolve/reverse/0x0000000000000000000000000000000000000001":
+			_, _ = w.Write([]byte(`{"meta": {"domain": ""}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	r := NewUnstoppableDomainsResolver("test-key")
+	r.BaseURL = srv.URL
+	return r
+}
+
+func TestHTTPResolverResolveAll(t *testing.T) {
+	r := newTestHTTPResolver(t)
+
+	accounts, err := r.ResolveAll(context.Background(), "Brad.crypto")
+	require.NoError(t, err)
+	require.Len(t, accounts, 3, "invalid and unmapped records are skipped")
+	assert.Equal(t, "eip155:1:0x8aaD44321A86b170879d7A244c1e8d360c99DdA8", accounts[ChainIDEthereumMainnet].String())
+	assert.Equal(t, "eip155:137:0x8aaD44321A86b170879d7A244c1e8d360c99DdA8", accounts[ChainIDPolygon].String())
+	assert.Equal(t, ChainIDBitcoinMainnet, accounts[ChainIDBitcoinMainnet].ChainID())
+
+	_, err = r.ResolveAll(context.Background(), "empty.crypto")
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.ResolveAll(context.Background(), "missing.crypto")
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+}
+
+func TestHTTPResolverResolve(t *testing.T) {
+	r := newTestHTTPResolver(t)
+
+	a, err := r.Resolve(context.Background(), "brad.crypto", ChainIDPolygon)
+	require.NoError(t, err)
+	_, ok := a.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", a)
+
+	_, err = r.Resolve(context.Background(), "brad.crypto", ChainIDSolanaMainnet)
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+
+	r.Records["crypto.DOGE.address"] = MustNewBIP122ChainID(DogecoinMainnet)
+	a, err = r.Resolve(context.Background(), "brad.crypto", r.Records["crypto.DOGE.address"])
+	require.NoError(t, err)
+	assert.Equal(t, "DBXu2kgc3xtvCUWFcxFE3r9hEYgmuaaCyD", a.Address())
+	assert.Len(t, UnstoppableDomainsRecords, 4, "resolver records are a copy")
+}
+
+func TestHTTPResolverReverseResolve(t *testing.T) {
+	r := newTestHTTPResolver(t)
+	ctx := context.Background()
+
+	name, err := r.ReverseResolve(ctx, MustParse("eip155:1:0x8aad44321a86b170879d7a244c1e8d360c99dda8"))
+	require.NoError(t, err)
+	assert.Equal(t, "brad.crypto", name)
+
+	_, err = r.ReverseResolve(ctx, NewEIP155FromHex(1, "0x0000000000000000000000000000000000000001"))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+
+	r.APIKey = "wrong"
+	_, err = r.ReverseResolve(ctx, MustParse("eip155:1:0x8aad44321a86b170879d7a244c1e8d360c99dda8"))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrNameNotFound))
+
+	r.ReversePath = ""
+	_, err = r.ReverseResolve(ctx, MustParse("eip155:1:0x8aad44321a86b170879d7a244c1e8d360c99dda8"))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+}
@@ -0,0 +1,327 @@
+package caip10
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+const NamespaceICP Namespace = "icp"
+
+// ICPNetwork represents an Internet Computer network (chain reference).
+// https://github.com/ChainAgnostic/namespaces/blob/main/icp/caip2.md
+type ICPNetwork string
+
+// ICP networks
+const (
+	ICPMainnet ICPNetwork = "737ba355e855bd4b61279056603e0550"
+)
+
+// String returns the network reference string.
+func (n ICPNetwork) String() string {
+	return string(n)
+}
+
+// ICPAddressKind distinguishes the two ICP address forms.
+type ICPAddressKind int
+
+const (
+	ICPPrincipal         ICPAddressKind = iota // textual principal ID (e.g. "2vxsx-fae")
+	ICPAccountIdentifier                       // 32-byte hex ledger account identifier
+)
+
+// String returns the name of the address kind.
+func (k ICPAddressKind) String() string {
+	switch k {
+	case ICPPrincipal:
+		return "principal"
+	case ICPAccountIdentifier:
+		return "account-identifier"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(k))
+	}
+}
+
+// ICP address lengths
+const (
+	ICPPrincipalMaxLength      = 29
+	ICPAccountIdentifierLength = 32
+	ICPSubaccountLength        = 32
+)
+
+// icpPrincipalGroupLength is the number of characters between dashes in a textual principal.
+const icpPrincipalGroupLength = 5
+
+var icpBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EncodeICPPrincipal encodes principal bytes in textual form:
+// base32(crc32 || bytes), lower case, grouped by dashes every 5 characters.
+func EncodeICPPrincipal(principal []byte) string {
+	data := binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(principal))
+	s := strings.ToLower(icpBase32.EncodeToString(append(data, principal...)))
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i += icpPrincipalGroupLength {
+		if i > 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteString(s[i:min(i+icpPrincipalGroupLength, len(s))])
+	}
+	return sb.String()
+}
+
+// DecodeICPPrincipal decodes a textual principal into its bytes.
+//
+// Validation steps:
+//  1. Lower case base32 grouped by dashes in canonical form
+//  2. At most 29 principal bytes
+//  3. CRC32 checksum matches
+func DecodeICPPrincipal(s string) ([]byte, error) {
+	data, err := icpBase32.DecodeString(strings.ToUpper(strings.ReplaceAll(s, "-", "")))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid icp principal encoding", ErrInvalidAddress)
+	}
+	if len(data) < 4 || len(data) > 4+ICPPrincipalMaxLength {
+		return nil, fmt.Errorf("%w: icp principal must be at most %d bytes", ErrInvalidAddress, ICPPrincipalMaxLength)
+	}
+	principal := data[4:]
+	if binary.BigEndian.Uint32(data) != crc32.ChecksumIEEE(principal) {
+		return nil, fmt.Errorf("%w: icp principal checksum mismatch", ErrInvalidChecksum)
+	}
+	if EncodeICPPrincipal(principal) != s {
+		return nil, fmt.Errorf("%w: non-canonical icp principal %q", ErrInvalidAddress, s)
+	}
+	return principal, nil
+}
+
+// ComputeICPAccountIdentifier derives the ledger account identifier of a principal and subaccount:
+// crc32(h) || h where h = sha224("\x0Aaccount-id" || principal || subaccount).
+// A nil subaccount selects the default (all zero) subaccount.
+func ComputeICPAccountIdentifier(principal []byte, subaccount *[ICPSubaccountLength]byte) [ICPAccountIdentifierLength]byte {
+	var sub [ICPSubaccountLength]byte
+	if subaccount != nil {
+		sub = *subaccount
+	}
+	h := sha256.New224()
+	h.Write([]byte("\x0Aaccount-id"))
+	h.Write(principal)
+	h.Write(sub[:])
+	hash := h.Sum(nil)
+
+	var id [ICPAccountIdentifierLength]byte
+	binary.BigEndian.PutUint32(id[:4], crc32.ChecksumIEEE(hash))
+	copy(id[4:], hash)
+	return id
+}
+
+// DecodeICPAccountIdentifier decodes a 64 character lower case hex account identifier
+// and verifies its CRC32 prefix.
+func DecodeICPAccountIdentifier(s string) ([ICPAccountIdentifierLength]byte, error) {
+	var id [ICPAccountIdentifierLength]byte
+	if len(s) != ICPAccountIdentifierLength*2 {
+		return id, fmt.Errorf("%w: icp account identifier must be %d hex characters, got %d",
+			ErrInvalidAddress, ICPAccountIdentifierLength*2, len(s))
+	}
+	if strings.ToLower(s) != s {
+		return id, fmt.Errorf("%w: icp account identifier must be lower case", ErrInvalidAddress)
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return id, fmt.Errorf("%w: invalid hex in icp account identifier", ErrInvalidAddress)
+	}
+	if binary.BigEndian.Uint32(id[:4]) != crc32.ChecksumIEEE(id[4:]) {
+		return id, fmt.Errorf("%w: icp account identifier checksum mismatch", ErrInvalidChecksum)
+	}
+	return id, nil
+}
+
+// isICPAccountIdentifier reports whether s has the shape of a hex account identifier;
+// textual principals always contain dashes at this length.
+func isICPAccountIdentifier(s string) bool {
+	return len(s) == ICPAccountIdentifierLength*2 && !strings.Contains(s, "-")
+}
+
+// ICPAccountID is the interface for Internet Computer account IDs.
+type ICPAccountID interface {
+	AccountID
+	// Network returns the ICP network.
+	Network() ICPNetwork
+	// Kind returns which address form was parsed.
+	Kind() ICPAddressKind
+	// IsPrincipal returns true if the address is a principal ID.
+	IsPrincipal() bool
+	// IsAccountIdentifier returns true if the address is a ledger account identifier.
+	IsAccountIdentifier() bool
+	// Principal returns the principal bytes if the address is a principal ID.
+	Principal() ([]byte, bool)
+	// AccountIdentifier returns the ledger account identifier. For principals
+	// it is derived with the default subaccount.
+	AccountIdentifier() [ICPAccountIdentifierLength]byte
+	// ToAccountIdentifier converts a principal and subaccount into an
+	// account-identifier account on the same network.
+	ToAccountIdentifier(subaccount [ICPSubaccountLength]byte) (ICPAccountID, error)
+}
+
+// Ensure icpAccountID implements ICPAccountID at compile time
+var _ ICPAccountID = (*icpAccountID)(nil)
+
+func init() {
+	RegisterParser(&icpParser{})
+}
+
+// icpAccountID represents an Internet Computer account ID per CAIP-10.
+type icpAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	kind              ICPAddressKind
+	principal         []byte // nil for account identifiers
+	accountID         [ICPAccountIdentifierLength]byte
+}
+
+// NewICP creates a new ICPAccountID from a textual principal or hex account identifier.
+func NewICP(network ICPNetwork, address string) (ICPAccountID, error) {
+	if err := validateReference(NamespaceICP, string(network)); err != nil {
+		return nil, err
+	}
+	if isICPAccountIdentifier(address) {
+		id, err := DecodeICPAccountIdentifier(address)
+		if err != nil {
+			return nil, err
+		}
+		return newICPAccountIdentifier(network, id), nil
+	}
+	principal, err := DecodeICPPrincipal(address)
+	if err != nil {
+		return nil, err
+	}
+	return newICPPrincipal(network, principal), nil
+}
+
+// NewICPFromPrincipal creates a new principal ICPAccountID from principal bytes.
+func NewICPFromPrincipal(network ICPNetwork, principal []byte) (ICPAccountID, error) {
+	if err := validateReference(NamespaceICP, string(network)); err != nil {
+		return nil, err
+	}
+	if len(principal) > ICPPrincipalMaxLength {
+		return nil, fmt.Errorf("%w: icp principal must be at most %d bytes, got %d",
+			ErrInvalidAddress, ICPPrincipalMaxLength, len(principal))
+	}
+	return newICPPrincipal(network, append([]byte{}, principal...)), nil
+}
+
+// MustNewICP creates a new ICPAccountID and panics if invalid.
+func MustNewICP(network ICPNetwork, address string) ICPAccountID {
+	a, err := NewICP(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func newICPPrincipal(network ICPNetwork, principal []byte) *icpAccountID {
+	return &icpAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceICP, network.String(), EncodeICPPrincipal(principal)),
+		kind:             ICPPrincipal,
+		principal:        principal,
+		accountID:        ComputeICPAccountIdentifier(principal, nil),
+	}
+}
+
+func newICPAccountIdentifier(network ICPNetwork, id [ICPAccountIdentifierLength]byte) *icpAccountID {
+	return &icpAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceICP, network.String(), hex.EncodeToString(id[:])),
+		kind:             ICPAccountIdentifier,
+		accountID:        id,
+	}
+}
+
+// Network returns the ICP network.
+func (a *icpAccountID) Network() ICPNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return ICPNetwork(a.Reference())
+}
+
+// Kind returns which address form was parsed.
+func (a *icpAccountID) Kind() ICPAddressKind {
+	if a == nil {
+		return ICPPrincipal
+	}
+	return a.kind
+}
+
+// IsPrincipal returns true if the address is a principal ID.
+func (a *icpAccountID) IsPrincipal() bool {
+	return !a.IsZero() && a.kind == ICPPrincipal
+}
+
+// IsAccountIdentifier returns true if the address is a ledger account identifier.
+func (a *icpAccountID) IsAccountIdentifier() bool {
+	return !a.IsZero() && a.kind == ICPAccountIdentifier
+}
+
+// Principal returns a copy of the principal bytes if the address is a principal ID.
+func (a *icpAccountID) Principal() ([]byte, bool) {
+	if !a.IsPrincipal() {
+		return nil, false
+	}
+	return append([]byte{}, a.principal...), true
+}
+
+// AccountIdentifier returns the ledger account identifier.
+func (a *icpAccountID) AccountIdentifier() [ICPAccountIdentifierLength]byte {
+	if a == nil {
+		return [ICPAccountIdentifierLength]byte{}
+	}
+	return a.accountID
+}
+
+// ToAccountIdentifier converts a principal and subaccount into an account-identifier account.
+func (a *icpAccountID) ToAccountIdentifier(subaccount [ICPSubaccountLength]byte) (ICPAccountID, error) {
+	if !a.IsPrincipal() {
+		return nil, fmt.Errorf("%w: icp account identifier cannot be derived without a principal", ErrInvalidAddress)
+	}
+	return newICPAccountIdentifier(a.Network(), ComputeICPAccountIdentifier(a.principal, &subaccount)), nil
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *icpAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *icpAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- icpParser ---
+
+type icpParser struct{}
+
+func (p *icpParser) Namespace() Namespace {
+	return NamespaceICP
+}
+
+func (p *icpParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceICP {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceICP, ns)
+	}
+	return NewICP(ICPNetwork(ref), addr)
+}
+
+func (p *icpParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewICP(ICPNetwork(reference), address)
+}
@@ -0,0 +1,136 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	icpAnonymousPrincipal = "2vxsx-fae"
+	icpAnonymousAccountID = "1c7a48ba6a562aa9eaa2481a9049cdf0433b9738c992d698c31d8abf89cadc79"
+	icpLedgerPrincipal    = "ryjl3-tyaaa-aaaaa-aaaba-cai"
+)
+
+func TestICPParsePrincipal(t *testing.T) {
+	input := "icp:" + string(ICPMainnet) + ":" + icpLedgerPrincipal
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	icp, ok := a.(ICPAccountID)
+	require.True(t, ok, "expected ICPAccountID, got %T", a)
+	assert.Equal(t, ICPMainnet, icp.Network())
+	assert.Equal(t, ChainIDICPMainnet, icp.ChainID())
+	assert.Equal(t, ICPPrincipal, icp.Kind())
+	assert.True(t, icp.IsPrincipal())
+	assert.False(t, icp.IsAccountIdentifier())
+
+	principal, ok := icp.Principal()
+	require.True(t, ok)
+	assert.Equal(t, "00000000000000020101", hex.EncodeToString(principal))
+	assert.Equal(t, input, icp.String())
+}
+
+func TestICPParseAccountIdentifier(t *testing.T) {
+	a, err := NewICP(ICPMainnet, icpAnonymousAccountID)
+	require.NoError(t, err)
+	assert.Equal(t, ICPAccountIdentifier, a.Kind())
+	assert.True(t, a.IsAccountIdentifier())
+
+	_, ok := a.Principal()
+	assert.False(t, ok)
+	id := a.AccountIdentifier()
+	assert.Equal(t, icpAnonymousAccountID, hex.EncodeToString(id[:]))
+
+	_, err = a.ToAccountIdentifier([ICPSubaccountLength]byte{})
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+}
+
+func TestICPAccountIdentifierDerivation(t *testing.T) {
+	a := MustNewICP(ICPMainnet, icpAnonymousPrincipal)
+
+	// Default subaccount
+	id := a.AccountIdentifier()
+	assert.Equal(t, icpAnonymousAccountID, hex.EncodeToString(id[:]))
+
+	converted, err := a.ToAccountIdentifier([ICPSubaccountLength]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, icpAnonymousAccountID, converted.Address())
+
+	var sub [ICPSubaccountLength]byte
+	sub[31] = 1
+	converted, err = a.ToAccountIdentifier(sub)
+	require.NoError(t, err)
+	assert.Equal(t, "b8fab0be4ad596a3739ab93e7316a8647ee72e167709441da49ce9171828629d", converted.Address())
+	assert.True(t, converted.IsAccountIdentifier())
+}
+
+func TestICPPrincipalEncoding(t *testing.T) {
+	tests := []struct {
+		hex  string
+		text string
+	}{
+		{"", "aaaaa-aa"}, // management canister
+		{"04", icpAnonymousPrincipal},
+		{"00000000000000020101", icpLedgerPrincipal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hex)
+			assert.Equal(t, tt.text, EncodeICPPrincipal(b))
+
+			decoded, err := DecodeICPPrincipal(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.hex, hex.EncodeToString(decoded))
+		})
+	}
+
+	a, err := NewICPFromPrincipal(ICPMainnet, []byte{0x04})
+	require.NoError(t, err)
+	assert.Equal(t, icpAnonymousPrincipal, a.Address())
+}
+
+func TestICPParseInvalid(t *testing.T) {
+	ref := "icp:" + string(ICPMainnet) + ":"
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "icp:mainnet:" + icpAnonymousPrincipal, ErrInvalidReference},
+		{"principal bad checksum", ref + "2vxsx-fai", ErrInvalidAddress},
+		{"principal missing dashes", ref + "ryjl3tyaaaaaaaaaaabacai", ErrInvalidAddress},
+		{"principal upper case", ref + "RYJL3-TYAAA-AAAAA-AAABA-CAI", ErrInvalidAddress},
+		{"principal invalid character", ref + "ryjl3-tyaaa-aaaaa-aaaba-ca1", ErrInvalidAddress},
+		{"account identifier bad checksum", ref + "1c7a48ba6a562aa9eaa2481a9049cdf0433b9738c992d698c31d8abf89cadc78", ErrInvalidAddress},
+		{"account identifier upper case", ref + "1C7A48BA6A562AA9EAA2481A9049CDF0433B9738C992D698C31D8ABF89CADC79", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+
+	_, err := NewICPFromPrincipal(ICPMainnet, make([]byte, ICPPrincipalMaxLength+1))
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+}
+
+func TestICPNilReceiver(t *testing.T) {
+	var a *icpAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, ICPNetwork(""), a.Network())
+	assert.False(t, a.IsPrincipal())
+	assert.False(t, a.IsAccountIdentifier())
+	_, ok := a.Principal()
+	assert.False(t, ok)
+	assert.Equal(t, [ICPAccountIdentifierLength]byte{}, a.AccountIdentifier())
+	_, err := a.ToAccountIdentifier([ICPSubaccountLength]byte{})
+	assert.Error(t, err)
+}
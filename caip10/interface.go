@@ -6,10 +6,16 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"sync"
 )
 
 // AccountID is the base interface for CAIP-10 account identifiers.
 // Format: namespace:reference:address
+//
+// Functions such as KeyOf, FingerprintOf, UUID, Normalize, ToDID, WithChainID
+// and Format accept any implementation. The types of this package also
+// implement fmt.Formatter, slog.LogValuer, encoding.TextAppender and
+// encoding.BinaryAppender.
 type AccountID interface {
 	// Core accessors
 
@@ -61,37 +67,69 @@ type Parser interface {
 }
 
 // registry holds namespace-specific parsers
-var registry = make(map[Namespace]Parser)
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[Namespace]Parser)
+)
 
-// RegisterParser registers a parser for a namespace.
+// RegisterParser registers a parser for a namespace, replacing any parser
+// previously registered for it. It is safe for concurrent use.
 func RegisterParser(p Parser) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
 	registry[p.Namespace()] = p
 }
 
+// UnregisterParser removes the parser of a namespace. Accounts of the namespace
+// are parsed as *GenericAccountID afterwards. It is safe for concurrent use.
+func UnregisterParser(namespace Namespace) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	delete(registry, namespace)
+}
+
 // GetParser returns the parser for a namespace.
 func GetParser(namespace Namespace) (Parser, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
 	p, ok := registry[namespace]
 	return p, ok
 }
 
+// ListParsers returns the registered parsers sorted by namespace.
+func ListParsers() []Parser {
+	return DefaultRegistry().Parsers()
+}
+
 // Parse parses a CAIP-10 string into an AccountID.
-// It automatically selects the appropriate parser based on namespace.
-func Parse(s string) (AccountID, error) {
+// It automatically selects the appropriate parser based on namespace and
+// validates with the default ValidationPolicy unless WithPolicy is given.
+// Errors are of type *ParseError.
+func Parse(s string, opts ...ParseOption) (AccountID, error) {
+	a, err := parse(s, newParseOptions(opts))
+	fireParseHooks(s, a, err)
+	return a, err
+}
+
+// parse parses s, reporting failures as *ParseError.
+func parse(s string, o parseOptions) (AccountID, error) {
+	if err := o.checkInput(s); err != nil {
+		return nil, newParseError(s, ComponentNone, o.maxLength, err)
+	}
 	ns, ref, addr, err := SplitCAIP10(s)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(s, ComponentNone, len(s), err)
 	}
-
-	if p, ok := registry[ns]; ok {
-		return p.ParseAddress(ref, addr)
+	a, err := o.parse(ns, ref, addr)
+	if err != nil {
+		return nil, newParseError(s, ComponentNone, -1, err)
 	}
-
-	return NewGeneric(ns, ref, addr)
+	return a, nil
 }
 
 // MustParse parses a CAIP-10 string and panics if invalid.
-func MustParse(s string) AccountID {
-	a, err := Parse(s)
+func MustParse(s string, opts ...ParseOption) AccountID {
+	a, err := Parse(s, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -99,16 +137,27 @@ func MustParse(s string) AccountID {
 }
 
 // ParseWithNamespace parses using a specific namespace parser.
-func ParseWithNamespace(namespace Namespace, reference, address string) (AccountID, error) {
-	if p, ok := registry[namespace]; ok {
-		return p.ParseAddress(reference, address)
+func ParseWithNamespace(namespace Namespace, reference, address string, opts ...ParseOption) (AccountID, error) {
+	o := newParseOptions(opts)
+	input := string(namespace) + ":" + reference + ":" + address
+	if err := o.checkInput(input); err != nil {
+		err = newParseError(input, ComponentNone, o.maxLength, err)
+		fireParseHooks(input, nil, err)
+		return nil, err
+	}
+	a, err := o.parse(namespace, reference, address)
+	if err != nil {
+		err = newParseError(input, ComponentNone, -1, err)
+		fireParseHooks(input, nil, err)
+		return nil, err
 	}
-	return NewGeneric(namespace, reference, address)
+	fireParseHooks(input, a, nil)
+	return a, nil
 }
 
 // ParseWithChainID parses using a specific chainId parser.
-func ParseWithChainID(chainID string, address string) (AccountID, error) {
-	return Parse(chainID + ":" + address)
+func ParseWithChainID(chainID string, address string, opts ...ParseOption) (AccountID, error) {
+	return Parse(chainID+":"+address, opts...)
 }
 
 // Equal compares two AccountIDs for equality.
@@ -123,10 +172,13 @@ func Equal(a, b AccountID) bool {
 }
 
 // AccountIDColumns is a helper struct for storing AccountID as separate database columns.
+// With GORM, embed it with an embeddedPrefix per account. GORM cannot name indexes on
+// embedded fields per prefix, so create composite indexes in a migration, e.g. on
+// (from_namespace, from_reference, from_address), with CreateAccountIDColumnsIndex.
 type AccountIDColumns struct {
-	Namespace string `json:"namespace" db:"namespace" gorm:"column:namespace;type:varchar(8);not null"`
-	Reference string `json:"reference" db:"reference" gorm:"column:reference;type:varchar(32);not null"`
-	Address   string `json:"address" db:"address" gorm:"column:address;type:varchar(128);not null"`
+	Namespace string `json:"namespace" bson:"namespace" db:"namespace" gorm:"column:namespace;type:varchar(8);not null"`
+	Reference string `json:"reference" bson:"reference" db:"reference" gorm:"column:reference;type:varchar(32);not null"`
+	Address   string `json:"address" bson:"address" db:"address" gorm:"column:address;type:varchar(128);not null"`
 }
 
 // ToAccountID converts AccountIDColumns back to AccountID with validation.
@@ -176,8 +228,8 @@ func (c AccountIDColumns) ToCompact() AccountIDColumnsCompact {
 // AccountIDColumnsCompact is a compact two-field format for storing AccountID.
 // ChainID is the CAIP-2 chain identifier (namespace:reference).
 type AccountIDColumnsCompact struct {
-	ChainID string `json:"chain_id" db:"chain_id" gorm:"column:chain_id;type:varchar(41);not null"` // namespace:reference (max 8+1+32=41)
-	Address string `json:"address" db:"address" gorm:"column:address;type:varchar(128);not null"`
+	ChainID string `json:"chain_id" bson:"chain_id" db:"chain_id" gorm:"column:chain_id;type:varchar(41);not null"` // namespace:reference (max 8+1+32=41)
+	Address string `json:"address" bson:"address" db:"address" gorm:"column:address;type:varchar(128);not null"`
 }
 
 // ToAccountID converts AccountIDColumnsCompact back to AccountID with validation.
@@ -0,0 +1,261 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+const NamespaceKaspa Namespace = "kaspa"
+
+// KaspaNetwork represents a Kaspa network (chain reference).
+type KaspaNetwork string
+
+// Kaspa networks
+const (
+	KaspaMainnet   KaspaNetwork = "mainnet"
+	KaspaTestnet10 KaspaNetwork = "testnet-10"
+	KaspaTestnet11 KaspaNetwork = "testnet-11"
+	KaspaDevnet    KaspaNetwork = "devnet"
+	KaspaSimnet    KaspaNetwork = "simnet"
+)
+
+// String returns the network reference string.
+func (n KaspaNetwork) String() string {
+	return string(n)
+}
+
+// Prefix returns the address prefix used on the network.
+func (n KaspaNetwork) Prefix() string {
+	switch n {
+	case KaspaMainnet:
+		return "kaspa"
+	case KaspaTestnet10, KaspaTestnet11:
+		return "kaspatest"
+	case KaspaDevnet:
+		return "kaspadev"
+	case KaspaSimnet:
+		return "kaspasim"
+	default:
+		return ""
+	}
+}
+
+// KaspaAddressVersion is the version byte of a Kaspa address.
+type KaspaAddressVersion byte
+
+// Kaspa address versions
+const (
+	KaspaPubKey      KaspaAddressVersion = 0 // 32-byte schnorr public key
+	KaspaPubKeyECDSA KaspaAddressVersion = 1 // 33-byte compressed ECDSA public key
+	KaspaScriptHash  KaspaAddressVersion = 8 // 32-byte script hash
+)
+
+// String returns the name of the address version.
+func (v KaspaAddressVersion) String() string {
+	switch v {
+	case KaspaPubKey:
+		return "pubkey"
+	case KaspaPubKeyECDSA:
+		return "pubkey-ecdsa"
+	case KaspaScriptHash:
+		return "scripthash"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(v))
+	}
+}
+
+// payloadLength returns the expected payload length of the version, or 0 if unknown.
+func (v KaspaAddressVersion) payloadLength() int {
+	switch v {
+	case KaspaPubKey, KaspaScriptHash:
+		return 32
+	case KaspaPubKeyECDSA:
+		return 33
+	default:
+		return 0
+	}
+}
+
+// EncodeKaspaAddress encodes a version and payload as a prefixed Kaspa address.
+func EncodeKaspaAddress(prefix string, version KaspaAddressVersion, payload []byte) string {
+	data, _ := convertBits(append([]byte{byte(version)}, payload...), 8, 5, true)
+	return encodeCashAddr(prefix, data)
+}
+
+// DecodeKaspaAddress decodes a prefixed Kaspa address into its prefix, version and payload.
+//
+// Validation steps:
+//  1. Lower case prefix:payload form
+//  2. CashAddr-style 40-bit checksum matches
+//  3. Known version byte with the matching payload length
+func DecodeKaspaAddress(address string) (string, KaspaAddressVersion, []byte, error) {
+	if strings.ToLower(address) != address {
+		return "", 0, nil, fmt.Errorf("%w: kaspa address must be lower case", ErrInvalidAddress)
+	}
+	prefix, data, err := decodeCashAddr(address)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	decoded, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(decoded) == 0 {
+		return "", 0, nil, fmt.Errorf("%w: empty kaspa address", ErrInvalidAddress)
+	}
+	version, payload := KaspaAddressVersion(decoded[0]), decoded[1:]
+	want := version.payloadLength()
+	if want == 0 {
+		return "", 0, nil, fmt.Errorf("%w: unknown kaspa address version %d", ErrInvalidAddress, decoded[0])
+	}
+	if len(payload) != want {
+		return "", 0, nil, fmt.Errorf("%w: kaspa %s payload must be %d bytes, got %d",
+			ErrInvalidAddress, version, want, len(payload))
+	}
+	return prefix, version, payload, nil
+}
+
+// ValidateKaspaAddress validates a Kaspa address for a specific network.
+// Returns nil if valid, error otherwise.
+func ValidateKaspaAddress(network KaspaNetwork, address string) error {
+	_, _, err := decodeKaspaNetworkAddress(network, address)
+	return err
+}
+
+func decodeKaspaNetworkAddress(network KaspaNetwork, address string) (KaspaAddressVersion, []byte, error) {
+	prefix, version, payload, err := DecodeKaspaAddress(address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if prefix != network.Prefix() {
+		return 0, nil, fmt.Errorf("%w: kaspa address prefix %q does not match network %s", ErrInvalidAddress, prefix, network)
+	}
+	return version, payload, nil
+}
+
+// KaspaAccountID is the interface for Kaspa account IDs.
+type KaspaAccountID interface {
+	AccountID
+	// Network returns the Kaspa network.
+	Network() KaspaNetwork
+	// Version returns the address version.
+	Version() KaspaAddressVersion
+	// Payload returns the public key or script hash.
+	Payload() []byte
+	// IsScriptHash returns true for pay-to-script-hash addresses.
+	IsScriptHash() bool
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+}
+
+// Ensure kaspaAccountID implements KaspaAccountID at compile time
+var _ KaspaAccountID = (*kaspaAccountID)(nil)
+
+func init() {
+	RegisterParser(&kaspaParser{})
+}
+
+// kaspaAccountID represents a Kaspa account ID per CAIP-10.
+type kaspaAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	version           KaspaAddressVersion
+	payload           []byte
+}
+
+// NewKaspa creates a new KaspaAccountID from a prefixed address (e.g. "kaspa:qr...").
+func NewKaspa(network KaspaNetwork, address string) (KaspaAccountID, error) {
+	if err := validateReference(NamespaceKaspa, string(network)); err != nil {
+		return nil, err
+	}
+	version, payload, err := decodeKaspaNetworkAddress(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &kaspaAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceKaspa, network.String(), address),
+		version:          version,
+		payload:          payload,
+	}, nil
+}
+
+// MustNewKaspa creates a new KaspaAccountID and panics if invalid.
+func MustNewKaspa(network KaspaNetwork, address string) KaspaAccountID {
+	a, err := NewKaspa(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Kaspa network.
+func (a *kaspaAccountID) Network() KaspaNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return KaspaNetwork(a.Reference())
+}
+
+// Version returns the address version.
+func (a *kaspaAccountID) Version() KaspaAddressVersion {
+	if a == nil {
+		return 0
+	}
+	return a.version
+}
+
+// Payload returns a copy of the public key or script hash.
+func (a *kaspaAccountID) Payload() []byte {
+	if a == nil || a.payload == nil {
+		return nil
+	}
+	return append([]byte(nil), a.payload...)
+}
+
+// IsScriptHash returns true for pay-to-script-hash addresses.
+func (a *kaspaAccountID) IsScriptHash() bool {
+	return !a.IsZero() && a.version == KaspaScriptHash
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *kaspaAccountID) IsMainnet() bool {
+	return a.Network() == KaspaMainnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *kaspaAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *kaspaAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- kaspaParser ---
+
+type kaspaParser struct{}
+
+func (p *kaspaParser) Namespace() Namespace {
+	return NamespaceKaspa
+}
+
+func (p *kaspaParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceKaspa {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceKaspa, ns)
+	}
+	return NewKaspa(KaspaNetwork(ref), addr)
+}
+
+func (p *kaspaParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewKaspa(KaspaNetwork(reference), address)
+}
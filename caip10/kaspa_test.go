@@ -0,0 +1,93 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	kaspaPubKeyAddress       = "kaspa:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajr"
+	kaspaScriptHashAddress   = "kaspa:pqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p74gurgf2j"
+	kaspaTestnetECDSAAddress = "kaspatest:qypqqqgzqvzq2ps8pqys5zcvp58q7yq3zgf3g9gkzuvpjxsmrsw3u8cews3zppv"
+)
+
+func kaspaTestPayload() []byte {
+	b := make([]byte, 32)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestKaspaParse(t *testing.T) {
+	input := "kaspa:mainnet:" + kaspaPubKeyAddress
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	kas, ok := a.(KaspaAccountID)
+	require.True(t, ok, "expected KaspaAccountID, got %T", a)
+	assert.Equal(t, KaspaMainnet, kas.Network())
+	assert.Equal(t, ChainIDKaspaMainnet, kas.ChainID())
+	assert.Equal(t, KaspaPubKey, kas.Version())
+	assert.Equal(t, kaspaTestPayload(), kas.Payload())
+	assert.False(t, kas.IsScriptHash())
+	assert.True(t, kas.IsMainnet())
+	assert.Equal(t, kaspaPubKeyAddress, kas.Address())
+	assert.Equal(t, input, kas.String())
+}
+
+func TestKaspaVersions(t *testing.T) {
+	a, err := NewKaspa(KaspaMainnet, kaspaScriptHashAddress)
+	require.NoError(t, err)
+	assert.True(t, a.IsScriptHash())
+
+	b, err := NewKaspa(KaspaTestnet11, kaspaTestnetECDSAAddress)
+	require.NoError(t, err)
+	assert.Equal(t, KaspaPubKeyECDSA, b.Version())
+	assert.Len(t, b.Payload(), 33)
+}
+
+func TestKaspaEncode(t *testing.T) {
+	payload := kaspaTestPayload()
+	assert.Equal(t, kaspaPubKeyAddress, EncodeKaspaAddress(KaspaMainnet.Prefix(), KaspaPubKey, payload))
+	assert.Equal(t, kaspaScriptHashAddress, EncodeKaspaAddress(KaspaMainnet.Prefix(), KaspaScriptHash, payload))
+	assert.Equal(t, kaspaTestnetECDSAAddress,
+		EncodeKaspaAddress(KaspaTestnet10.Prefix(), KaspaPubKeyECDSA, append([]byte{0x02}, payload...)))
+}
+
+func TestKaspaParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "kaspa:testnet:" + kaspaPubKeyAddress, ErrInvalidReference},
+		{"testnet address on mainnet", "kaspa:mainnet:" + kaspaTestnetECDSAAddress, ErrInvalidAddress},
+		{"mainnet address on testnet", "kaspa:testnet-10:" + kaspaPubKeyAddress, ErrInvalidAddress},
+		{"missing prefix", "kaspa:mainnet:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajr", ErrInvalidAddress},
+		{"bad checksum", "kaspa:mainnet:kaspa:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajq", ErrInvalidAddress},
+		{"upper case", "kaspa:mainnet:KASPA:QQQQZQSRQSZSVPCGPY9QKRQDPC83QYGJZV2P29SHRQV35XCUR50P7U4JHSAJR", ErrInvalidAddress},
+		{"invalid character", "kaspa:mainnet:kaspa:qqqqzqsrqszsvpcgpy9qkrqdpc83qygjzv2p29shrqv35xcur50p7u4jhsajb", ErrInvalidAddress},
+		{"too short", "kaspa:mainnet:kaspa:qqqq", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestKaspaNilReceiver(t *testing.T) {
+	var a *kaspaAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, KaspaNetwork(""), a.Network())
+	assert.Nil(t, a.Payload())
+	assert.False(t, a.IsScriptHash())
+	assert.False(t, a.IsMainnet())
+}
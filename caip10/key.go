@@ -0,0 +1,122 @@
+package caip10
+
+import "strings"
+
+// AccountKey is a comparable, canonical form of an AccountID for use as a Go map key.
+// Accounts that are equal in their namespace produce equal keys regardless of their
+// concrete type (*GenericAccountID or a native type); EVM hex addresses are lower cased.
+type AccountKey struct {
+	Namespace Namespace
+	Reference string
+	Address   string
+}
+
+// ChainKey is a comparable form of a ChainID for use as a Go map key.
+type ChainKey struct {
+	Namespace Namespace
+	Reference string
+}
+
+// KeyOf returns the key of an account; nil and zero accounts have the zero key.
+func KeyOf(a AccountID) AccountKey {
+	if a == nil || a.IsZero() {
+		return AccountKey{}
+	}
+	if k, ok := a.(interface{ Key() AccountKey }); ok {
+		return k.Key()
+	}
+	return newAccountKey(a.Namespace(), a.Reference(), a.Address())
+}
+
+// FingerprintOf returns the fingerprint of the account's key, see AccountKey.Fingerprint.
+func FingerprintOf(a AccountID) uint64 {
+	return KeyOf(a).Fingerprint()
+}
+
+// Fingerprint returns the fingerprint of the account's key, see AccountKey.Fingerprint.
+func (a *GenericAccountID) Fingerprint() uint64 {
+	return KeyOf(a).Fingerprint()
+}
+
+// newAccountKey canonicalizes the account components.
+func newAccountKey(namespace Namespace, reference, address string) AccountKey {
+	if namespace == NamespaceEIP155 {
+		address = strings.ToLower(address)
+	}
+	return AccountKey{Namespace: namespace, Reference: reference, Address: address}
+}
+
+// IsZero reports whether the key is the zero value.
+func (k AccountKey) IsZero() bool {
+	return k == AccountKey{}
+}
+
+// ChainKey returns the key of the account's chain.
+func (k AccountKey) ChainKey() ChainKey {
+	return ChainKey{Namespace: k.Namespace, Reference: k.Reference}
+}
+
+// String returns the canonical CAIP-10 string of the key.
+func (k AccountKey) String() string {
+	if k.IsZero() {
+		return ""
+	}
+	return string(k.Namespace) + ":" + k.Reference + ":" + k.Address
+}
+
+// Fingerprint returns the 64-bit FNV-1a hash of the key's canonical string, for
+// shard selection and bloom filters. Equal accounts have equal fingerprints. The
+// algorithm is fixed and will not change across versions; the zero key hashes to 0.
+func (k AccountKey) Fingerprint() uint64 {
+	if k.IsZero() {
+		return 0
+	}
+	h := uint64(fnvOffset64)
+	h = fnvString(h, string(k.Namespace))
+	h = fnvString(h, ":")
+	h = fnvString(h, k.Reference)
+	h = fnvString(h, ":")
+	return fnvString(h, k.Address)
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// fnvString feeds s into an FNV-1a hash without allocating.
+func fnvString(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// Account parses the key back into an AccountID.
+func (k AccountKey) Account() (AccountID, error) {
+	if k.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	return ParseWithNamespace(k.Namespace, k.Reference, k.Address)
+}
+
+// Key returns the key of the chain.
+func (c ChainID) Key() ChainKey {
+	return ChainKey(c)
+}
+
+// IsZero reports whether the key is the zero value.
+func (k ChainKey) IsZero() bool {
+	return k == ChainKey{}
+}
+
+// ChainID returns the chain ID of the key.
+func (k ChainKey) ChainID() ChainID {
+	return ChainID(k)
+}
+
+// String returns the CAIP-2 string of the key.
+func (k ChainKey) String() string {
+	return ChainID(k).String()
+}
@@ -0,0 +1,61 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountKey(t *testing.T) {
+	native := MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	generic := newGenericUnchecked(NamespaceEIP155, "1", "0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB")
+
+	keys := map[AccountKey]string{KeyOf(native): "native"}
+	assert.Equal(t, "native", keys[generic.Key()], "generic and native accounts share a key")
+	assert.Equal(t, "eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", KeyOf(native).String())
+	assert.Equal(t, ChainIDEthereumMainnet.Key(), KeyOf(native).ChainKey())
+
+	back, err := KeyOf(native).Account()
+	require.NoError(t, err)
+	assert.True(t, native.Equal(back))
+	_, ok := back.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", back)
+
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	assert.Equal(t, sol.String(), KeyOf(sol).String(), "non-hex addresses keep their case")
+}
+
+func TestAccountKeyZero(t *testing.T) {
+	assert.True(t, KeyOf(nil).IsZero())
+	assert.True(t, KeyOf(&GenericAccountID{}).IsZero())
+	assert.True(t, (*GenericAccountID)(nil).Key().IsZero())
+	assert.Equal(t, "", AccountKey{}.String())
+
+	_, err := AccountKey{}.Account()
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
+
+func TestChainKey(t *testing.T) {
+	k := ChainIDSolanaMainnet.Key()
+	assert.Equal(t, ChainIDSolanaMainnet, k.ChainID())
+	assert.Equal(t, ChainIDSolanaMainnet.String(), k.String())
+	assert.False(t, k.IsZero())
+	assert.True(t, ChainID{}.Key().IsZero())
+}
+
+func TestFingerprint(t *testing.T) {
+	checksummed := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	lower := MustNewGeneric(NamespaceEIP155, "1", "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+
+	// Golden values: the fingerprint must never change.
+	assert.Equal(t, uint64(18232397084826044455), FingerprintOf(checksummed))
+	assert.Equal(t, uint64(14264091383526911903), FingerprintOf(sol))
+
+	assert.Equal(t, FingerprintOf(checksummed), FingerprintOf(lower))
+	assert.Equal(t, FingerprintOf(checksummed), KeyOf(checksummed).Fingerprint())
+	assert.Equal(t, uint64(0), (*GenericAccountID)(nil).Fingerprint())
+	assert.Equal(t, uint64(0), AccountKey{}.Fingerprint())
+}
@@ -0,0 +1,114 @@
+package caip10
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+// MulticodecAccountID is the multicodec code prefixed to the compact binary form
+// by EncodeMulticodec. It is in the multicodec private use range, as the table
+// has no code for CAIP-10 account IDs.
+const MulticodecAccountID = 0x300a10
+
+// Multibase is a multibase encoding, identified by its prefix character.
+type Multibase byte
+
+const (
+	MultibaseBase16    Multibase = 'f' // lowercase hex
+	MultibaseBase32    Multibase = 'b' // lowercase RFC 4648, no padding
+	MultibaseBase58BTC Multibase = 'z' // bitcoin alphabet
+	MultibaseBase64URL Multibase = 'u' // RFC 4648 URL alphabet, no padding
+)
+
+var multibase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EncodeMulticodec returns the self-describing binary form of an account: the
+// uvarint MulticodecAccountID followed by the MarshalBinaryCompact encoding.
+func EncodeMulticodec(a AccountID) ([]byte, error) {
+	if a == nil || a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	payload, err := MarshalBinaryCompact(a)
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen32+len(payload)), MulticodecAccountID)
+	return append(buf, payload...), nil
+}
+
+// DecodeMulticodec parses the output of EncodeMulticodec into the namespace's
+// native type.
+func DecodeMulticodec(data []byte) (AccountID, error) {
+	code, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: multicodec: invalid code prefix", ErrInvalidFormat)
+	}
+	if code != MulticodecAccountID {
+		return nil, fmt.Errorf("%w: multicodec: unexpected code %#x", ErrInvalidFormat, code)
+	}
+	var g GenericAccountID
+	if err := g.UnmarshalBinary(data[n:]); err != nil {
+		return nil, err
+	}
+	if g.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	return ParseWithNamespace(g.Namespace(), g.Reference(), g.Address())
+}
+
+// EncodeMultibase returns EncodeMulticodec in the given multibase encoding,
+// e.g. "z..." for base58btc.
+func EncodeMultibase(a AccountID, base Multibase) (string, error) {
+	data, err := EncodeMulticodec(a)
+	if err != nil {
+		return "", err
+	}
+	var body string
+	switch base {
+	case MultibaseBase16:
+		body = hex.EncodeToString(data)
+	case MultibaseBase32:
+		body = strings.ToLower(multibase32.EncodeToString(data))
+	case MultibaseBase58BTC:
+		body = base58.Encode(data)
+	case MultibaseBase64URL:
+		body = base64.RawURLEncoding.EncodeToString(data)
+	default:
+		return "", fmt.Errorf("%w: multibase: unsupported encoding %q", ErrInvalidFormat, byte(base))
+	}
+	return string(base) + body, nil
+}
+
+// DecodeMultibase parses the output of EncodeMultibase in any supported encoding.
+func DecodeMultibase(s string) (AccountID, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	var (
+		data []byte
+		err  error
+	)
+	body := s[1:]
+	switch Multibase(s[0]) {
+	case MultibaseBase16:
+		data, err = hex.DecodeString(body)
+	case MultibaseBase32:
+		data, err = multibase32.DecodeString(strings.ToUpper(body))
+	case MultibaseBase58BTC:
+		data, err = base58.Decode(body)
+	case MultibaseBase64URL:
+		data, err = base64.RawURLEncoding.DecodeString(body)
+	default:
+		return nil, fmt.Errorf("%w: multibase: unsupported prefix %q", ErrInvalidFormat, s[0])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: multibase: %v", ErrInvalidFormat, err)
+	}
+	return DecodeMulticodec(data)
+}
@@ -0,0 +1,58 @@
+package caip10
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticodecRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+		"bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+	} {
+		a := MustParse(s)
+		data, err := EncodeMulticodec(a)
+		require.NoError(t, err, s)
+		code, n := binary.Uvarint(data)
+		assert.Equal(t, uint64(MulticodecAccountID), code)
+		compact, _ := MarshalBinaryCompact(a)
+		assert.Equal(t, compact, data[n:])
+
+		got, err := DecodeMulticodec(data)
+		require.NoError(t, err, s)
+		assert.IsType(t, a, got)
+		assert.True(t, a.Equal(got), s)
+
+		for _, base := range []Multibase{MultibaseBase16, MultibaseBase32, MultibaseBase58BTC, MultibaseBase64URL} {
+			enc, err := EncodeMultibase(a, base)
+			require.NoError(t, err)
+			assert.Equal(t, byte(base), enc[0])
+			got, err := DecodeMultibase(enc)
+			require.NoError(t, err, enc)
+			assert.True(t, a.Equal(got), enc)
+		}
+	}
+}
+
+func TestMultibaseErrors(t *testing.T) {
+	a := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+
+	_, err := EncodeMulticodec(nil)
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+	_, err = EncodeMultibase(a, Multibase('x'))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+
+	for _, s := range []string{"x00", "zIOl0", "f0102", "u!!"} {
+		_, err = DecodeMultibase(s)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "%q: got %v", s, err)
+	}
+	_, err = DecodeMultibase("")
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+	_, err = DecodeMulticodec(nil)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
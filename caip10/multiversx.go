@@ -0,0 +1,207 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// NamespaceMultiversX is the CAIP namespace for MultiversX (formerly Elrond).
+// The registered namespace is "mvx"; "multiversx" exceeds the 8 character limit.
+// https://github.com/ChainAgnostic/namespaces/blob/main/mvx/caip10.md
+const NamespaceMultiversX Namespace = "mvx"
+
+// MultiversXNetwork represents a MultiversX network (chain reference).
+type MultiversXNetwork string
+
+// MultiversX networks
+const (
+	MultiversXMainnet MultiversXNetwork = "1"
+	MultiversXDevnet  MultiversXNetwork = "D"
+	MultiversXTestnet MultiversXNetwork = "T"
+)
+
+// String returns the network reference string.
+func (n MultiversXNetwork) String() string {
+	return string(n)
+}
+
+// MultiversXPublicKeyLength is the length of a MultiversX public key.
+const MultiversXPublicKeyLength = 32
+
+// multiversXHRP is the bech32 human-readable part of MultiversX addresses.
+const multiversXHRP = "erd"
+
+// multiversXContractPrefixLength is the number of leading zero bytes of a smart contract address.
+const multiversXContractPrefixLength = 8
+
+// EncodeMultiversXAddress encodes a public key as an erd1 bech32 address.
+func EncodeMultiversXAddress(pubkey [MultiversXPublicKeyLength]byte) string {
+	data, _ := convertBits(pubkey[:], 8, 5, true)
+	return encodeBech32(multiversXHRP, data, bech32Classic)
+}
+
+// DecodeMultiversXAddress decodes an erd1 bech32 address into its public key.
+//
+// Validation steps:
+//  1. Valid bech32 (not bech32m) with lower case characters only
+//  2. Human-readable part is "erd"
+//  3. Payload is a 32-byte public key
+func DecodeMultiversXAddress(address string) ([MultiversXPublicKeyLength]byte, error) {
+	var pubkey [MultiversXPublicKeyLength]byte
+	hrp, data, variant, err := decodeBech32(address, 0)
+	if err != nil {
+		return pubkey, err
+	}
+	if variant != bech32Classic {
+		return pubkey, fmt.Errorf("%w: multiversx addresses use bech32, not bech32m", ErrInvalidAddress)
+	}
+	if hrp != multiversXHRP {
+		return pubkey, fmt.Errorf("%w: multiversx address must use hrp %q, got %q", ErrInvalidAddress, multiversXHRP, hrp)
+	}
+	payload, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return pubkey, err
+	}
+	if len(payload) != MultiversXPublicKeyLength {
+		return pubkey, fmt.Errorf("%w: multiversx address must decode to %d bytes, got %d",
+			ErrInvalidAddress, MultiversXPublicKeyLength, len(payload))
+	}
+	copy(pubkey[:], payload)
+	if EncodeMultiversXAddress(pubkey) != address {
+		return pubkey, fmt.Errorf("%w: multiversx address must be lower case", ErrInvalidAddress)
+	}
+	return pubkey, nil
+}
+
+// ValidateMultiversXAddress validates an erd1 bech32 address.
+// Returns nil if valid, error otherwise.
+func ValidateMultiversXAddress(address string) error {
+	_, err := DecodeMultiversXAddress(address)
+	return err
+}
+
+// IsMultiversXContractAddress reports whether the public key belongs to a smart contract,
+// i.e. it starts with 8 zero bytes.
+func IsMultiversXContractAddress(pubkey [MultiversXPublicKeyLength]byte) bool {
+	return bytes.Equal(pubkey[:multiversXContractPrefixLength], make([]byte, multiversXContractPrefixLength))
+}
+
+// MultiversXAccountID is the interface for MultiversX account IDs.
+type MultiversXAccountID interface {
+	AccountID
+	// Network returns the MultiversX network.
+	Network() MultiversXNetwork
+	// PublicKey returns the 32-byte public key.
+	PublicKey() [MultiversXPublicKeyLength]byte
+	// IsContract returns true if the address is a smart contract.
+	IsContract() bool
+	// IsUser returns true if the address is a user account.
+	IsUser() bool
+}
+
+// Ensure multiversXAccountID implements MultiversXAccountID at compile time
+var _ MultiversXAccountID = (*multiversXAccountID)(nil)
+
+func init() {
+	RegisterParser(&multiversXParser{})
+}
+
+// multiversXAccountID represents a MultiversX account ID per CAIP-10.
+type multiversXAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	pubkey            [MultiversXPublicKeyLength]byte
+}
+
+// NewMultiversX creates a new MultiversXAccountID from a public key.
+func NewMultiversX(network MultiversXNetwork, pubkey [MultiversXPublicKeyLength]byte) MultiversXAccountID {
+	return &multiversXAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceMultiversX, network.String(), EncodeMultiversXAddress(pubkey)),
+		pubkey:           pubkey,
+	}
+}
+
+// NewMultiversXFromAddress creates a new MultiversXAccountID from an erd1 address.
+func NewMultiversXFromAddress(network MultiversXNetwork, address string) (MultiversXAccountID, error) {
+	if err := validateReference(NamespaceMultiversX, string(network)); err != nil {
+		return nil, err
+	}
+	pubkey, err := DecodeMultiversXAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return NewMultiversX(network, pubkey), nil
+}
+
+// MustNewMultiversXFromAddress creates a new MultiversXAccountID and panics if invalid.
+func MustNewMultiversXFromAddress(network MultiversXNetwork, address string) MultiversXAccountID {
+	a, err := NewMultiversXFromAddress(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the MultiversX network.
+func (a *multiversXAccountID) Network() MultiversXNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return MultiversXNetwork(a.Reference())
+}
+
+// PublicKey returns the 32-byte public key.
+func (a *multiversXAccountID) PublicKey() [MultiversXPublicKeyLength]byte {
+	if a == nil {
+		return [MultiversXPublicKeyLength]byte{}
+	}
+	return a.pubkey
+}
+
+// IsContract returns true if the address is a smart contract.
+func (a *multiversXAccountID) IsContract() bool {
+	return !a.IsZero() && IsMultiversXContractAddress(a.pubkey)
+}
+
+// IsUser returns true if the address is a user account.
+func (a *multiversXAccountID) IsUser() bool {
+	return !a.IsZero() && !IsMultiversXContractAddress(a.pubkey)
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *multiversXAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *multiversXAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- multiversXParser ---
+
+type multiversXParser struct{}
+
+func (p *multiversXParser) Namespace() Namespace {
+	return NamespaceMultiversX
+}
+
+func (p *multiversXParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceMultiversX {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceMultiversX, ns)
+	}
+	return NewMultiversXFromAddress(MultiversXNetwork(ref), addr)
+}
+
+func (p *multiversXParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewMultiversXFromAddress(MultiversXNetwork(reference), address)
+}
@@ -0,0 +1,80 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mvxAliceAddress    = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	mvxAlicePubKey     = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	mvxContractAddress = "erd1qqqqqqqqqqqqqpgqhe8t5jewej70zupmh44jurgn29psua5l2jps3ntjj3"
+)
+
+func TestMultiversXParse(t *testing.T) {
+	input := "mvx:D:" + mvxAliceAddress
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	mvx, ok := a.(MultiversXAccountID)
+	require.True(t, ok, "expected MultiversXAccountID, got %T", a)
+	assert.Equal(t, MultiversXDevnet, mvx.Network())
+	assert.Equal(t, ChainIDMultiversXDevnet, mvx.ChainID())
+	assert.True(t, mvx.IsUser())
+	assert.False(t, mvx.IsContract())
+
+	pubkey := mvx.PublicKey()
+	assert.Equal(t, mvxAlicePubKey, hex.EncodeToString(pubkey[:]))
+	assert.Equal(t, input, mvx.String())
+}
+
+func TestMultiversXContract(t *testing.T) {
+	a, err := NewMultiversXFromAddress(MultiversXMainnet, mvxContractAddress)
+	require.NoError(t, err)
+	assert.True(t, a.IsContract())
+	assert.False(t, a.IsUser())
+}
+
+func TestMultiversXEncode(t *testing.T) {
+	var pubkey [MultiversXPublicKeyLength]byte
+	b, _ := hex.DecodeString(mvxAlicePubKey)
+	copy(pubkey[:], b)
+
+	assert.Equal(t, mvxAliceAddress, EncodeMultiversXAddress(pubkey))
+	assert.Equal(t, "mvx:1:"+mvxAliceAddress, NewMultiversX(MultiversXMainnet, pubkey).String())
+}
+
+func TestMultiversXParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "mvx:mainnet:" + mvxAliceAddress, ErrInvalidReference},
+		{"bad checksum", "mvx:1:erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6tq", ErrInvalidAddress},
+		{"upper case", "mvx:1:ERD1QYU5WTHLDZR8WX5C9UCG8KJAGG0JFS53S8NR3ZPZ3HYPEFSDD8SSYCR6TH", ErrInvalidAddress},
+		{"wrong hrp", "mvx:1:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ErrInvalidAddress},
+		{"too short", "mvx:1:erd1qyu5wth", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestMultiversXNilReceiver(t *testing.T) {
+	var a *multiversXAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, MultiversXNetwork(""), a.Network())
+	assert.Equal(t, [MultiversXPublicKeyLength]byte{}, a.PublicKey())
+	assert.False(t, a.IsContract())
+	assert.False(t, a.IsUser())
+}
@@ -0,0 +1,208 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+)
+
+const NamespaceNEAR Namespace = "near"
+
+// NEARNetwork represents a NEAR network (chain reference).
+// https://github.com/ChainAgnostic/namespaces/blob/main/near/caip10.md
+type NEARNetwork string
+
+// NEAR networks
+const (
+	NEARMainnet NEARNetwork = "mainnet"
+	NEARTestnet NEARNetwork = "testnet"
+)
+
+// String returns the network reference string.
+func (n NEARNetwork) String() string {
+	return string(n)
+}
+
+// NEAR account ID length limits.
+// https://nomicon.io/DataStructures/Account#account-id-rules
+const (
+	NEARAccountIDMinLen = 2
+	NEARAccountIDMaxLen = 64
+)
+
+// NEARPublicKeyLength is the length of the ed25519 key behind an implicit account.
+const NEARPublicKeyLength = 32
+
+// nearAccountIDRegex validates NEAR account IDs: dot-separated parts of lowercase
+// alphanumerics, where '-' and '_' may only appear between alphanumerics.
+var nearAccountIDRegex = regexp.MustCompile(`^(([a-z\d]+[-_])*[a-z\d]+\.)*([a-z\d]+[-_])*[a-z\d]+$`)
+
+// nearImplicitRegex matches implicit accounts: the hex-encoded ed25519 public key.
+var nearImplicitRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// ValidateNEARAccountID validates a NEAR account ID (implicit or named).
+// Returns nil if valid, error otherwise.
+//
+// Validation steps:
+//  1. Length is between 2 and 64 characters
+//  2. Matches the NEAR account ID rules
+func ValidateNEARAccountID(accountID string) error {
+	if len(accountID) < NEARAccountIDMinLen || len(accountID) > NEARAccountIDMaxLen {
+		return fmt.Errorf("%w: NEAR account ID must be %d-%d characters, got %d",
+			ErrInvalidAddress, NEARAccountIDMinLen, NEARAccountIDMaxLen, len(accountID))
+	}
+	if !nearAccountIDRegex.MatchString(accountID) {
+		return fmt.Errorf("%w: invalid NEAR account ID %q", ErrInvalidAddress, accountID)
+	}
+	return nil
+}
+
+// IsNEARImplicitAccount reports whether accountID is an implicit account,
+// i.e. 64 lowercase hex characters encoding an ed25519 public key.
+func IsNEARImplicitAccount(accountID string) bool {
+	return nearImplicitRegex.MatchString(accountID)
+}
+
+// NEARAccountID is the interface for NEAR account IDs.
+type NEARAccountID interface {
+	AccountID
+	// Network returns the NEAR network.
+	Network() NEARNetwork
+	// IsImplicit returns true if the account is an implicit (hex public key) account.
+	IsImplicit() bool
+	// IsNamed returns true if the account is a named account such as alice.near.
+	IsNamed() bool
+	// PublicKey returns the ed25519 public key of an implicit account.
+	// The second return value is false for named accounts.
+	PublicKey() ([NEARPublicKeyLength]byte, bool)
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+}
+
+// Ensure nearAccountID implements NEARAccountID at compile time
+var _ NEARAccountID = (*nearAccountID)(nil)
+
+func init() {
+	RegisterParser(&nearParser{})
+}
+
+// nearAccountID represents a NEAR account ID per CAIP-10.
+type nearAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	implicit          bool
+	pubkey            [NEARPublicKeyLength]byte // set for implicit accounts only
+}
+
+// NewNEAR creates a new NEARAccountID from an implicit or named account ID.
+func NewNEAR(network NEARNetwork, accountID string) (NEARAccountID, error) {
+	if err := validateReference(NamespaceNEAR, string(network)); err != nil {
+		return nil, err
+	}
+	if err := ValidateNEARAccountID(accountID); err != nil {
+		return nil, err
+	}
+
+	a := &nearAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceNEAR, network.String(), accountID),
+	}
+	if IsNEARImplicitAccount(accountID) {
+		a.implicit = true
+		_, _ = hex.Decode(a.pubkey[:], []byte(accountID))
+	}
+	return a, nil
+}
+
+// MustNewNEAR creates a new NEARAccountID and panics if invalid.
+func MustNewNEAR(network NEARNetwork, accountID string) NEARAccountID {
+	a, err := NewNEAR(network, accountID)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewNEARImplicit creates an implicit NEARAccountID from an ed25519 public key.
+func NewNEARImplicit(network NEARNetwork, pubkey [NEARPublicKeyLength]byte) NEARAccountID {
+	return &nearAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceNEAR, network.String(), hex.EncodeToString(pubkey[:])),
+		implicit:         true,
+		pubkey:           pubkey,
+	}
+}
+
+// Network returns the NEAR network.
+func (a *nearAccountID) Network() NEARNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return NEARNetwork(a.Reference())
+}
+
+// IsImplicit returns true if the account is an implicit (hex public key) account.
+func (a *nearAccountID) IsImplicit() bool {
+	return a != nil && a.implicit
+}
+
+// IsNamed returns true if the account is a named account such as alice.near.
+func (a *nearAccountID) IsNamed() bool {
+	return !a.IsZero() && !a.implicit
+}
+
+// PublicKey returns the ed25519 public key of an implicit account.
+func (a *nearAccountID) PublicKey() ([NEARPublicKeyLength]byte, bool) {
+	if !a.IsImplicit() {
+		return [NEARPublicKeyLength]byte{}, false
+	}
+	return a.pubkey, true
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *nearAccountID) IsMainnet() bool {
+	return a.Network() == NEARMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *nearAccountID) IsTestnet() bool {
+	return a.Network() == NEARTestnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *nearAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *nearAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- nearParser ---
+
+type nearParser struct{}
+
+func (p *nearParser) Namespace() Namespace {
+	return NamespaceNEAR
+}
+
+func (p *nearParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceNEAR {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceNEAR, ns)
+	}
+	return NewNEAR(NEARNetwork(ref), addr)
+}
+
+func (p *nearParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewNEAR(NEARNetwork(reference), address)
+}
@@ -0,0 +1,106 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nearImplicit = "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de"
+
+func TestNEARParseNamed(t *testing.T) {
+	input := "near:mainnet:alice.near"
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	near, ok := a.(NEARAccountID)
+	require.True(t, ok, "expected NEARAccountID, got %T", a)
+	assert.Equal(t, NEARMainnet, near.Network())
+	assert.True(t, near.IsNamed())
+	assert.False(t, near.IsImplicit())
+	assert.True(t, near.IsMainnet())
+	assert.Equal(t, ChainIDNEARMainnet, near.ChainID())
+
+	_, ok = near.PublicKey()
+	assert.False(t, ok)
+	assert.Equal(t, input, near.String())
+}
+
+func TestNEARParseImplicit(t *testing.T) {
+	a, err := Parse("near:testnet:" + nearImplicit)
+	require.NoError(t, err)
+
+	near := a.(NEARAccountID)
+	assert.True(t, near.IsImplicit())
+	assert.False(t, near.IsNamed())
+	assert.True(t, near.IsTestnet())
+
+	pubkey, ok := near.PublicKey()
+	require.True(t, ok)
+	assert.Equal(t, near, NewNEARImplicit(NEARTestnet, pubkey))
+	assert.True(t, near.Equal(NewNEARImplicit(NEARTestnet, pubkey)))
+}
+
+func TestValidateNEARAccountID(t *testing.T) {
+	valid := []string{
+		"ok",
+		"bowen",
+		"ek-2",
+		"ek.near",
+		"com",
+		"google.com",
+		"bowen.google.com",
+		"near",
+		"illia.cheap-accounts.near",
+		"max_99.near",
+		"100",
+		"near2019",
+		"over.9000",
+		"a.bro",
+		"bro.a",
+		"0x06012c8cf97bead5deae237070f9587f8e7a266d", // ETH-implicit account
+		nearImplicit,
+	}
+	for _, id := range valid {
+		assert.NoError(t, ValidateNEARAccountID(id), id)
+	}
+
+	invalid := []string{
+		"a",
+		"100-",
+		"bo__wen",
+		"_illia",
+		".near",
+		"near.",
+		"a..near",
+		"$$$",
+		"WAT",
+		"me@google.com",
+		"system ",
+		"abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmno", // 65 chars
+	}
+	for _, id := range invalid {
+		err := ValidateNEARAccountID(id)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), "%q: got %v", id, err)
+	}
+}
+
+func TestNEARParseInvalid(t *testing.T) {
+	_, err := Parse("near:betanet:alice.near")
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+
+	_, err = Parse("near:mainnet:Alice.near")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestNEARNilReceiver(t *testing.T) {
+	var a *nearAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, NEARNetwork(""), a.Network())
+	assert.False(t, a.IsImplicit())
+	assert.False(t, a.IsNamed())
+	_, ok := a.PublicKey()
+	assert.False(t, ok)
+}
@@ -0,0 +1,121 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WithChainID returns the account on another chain of the same namespace, e.g.
+// eip155:1 to eip155:137 or Solana mainnet to devnet. The address is kept and
+// must be valid on the target chain; BIP122 accounts re-encode it for the target
+// network instead. Returns ErrInvalidNamespace if the namespaces differ.
+func (a *GenericAccountID) WithChainID(chainID ChainID) (AccountID, error) {
+	if err := checkSwitchChain(a, chainID); err != nil {
+		return nil, err
+	}
+	return rebuildAccount(chainID, a.address)
+}
+
+// WithChainID returns the account on another chain of its namespace, see
+// GenericAccountID.WithChainID.
+func WithChainID(a AccountID, chainID ChainID) (AccountID, error) {
+	if w, ok := a.(interface {
+		WithChainID(chainID ChainID) (AccountID, error)
+	}); ok {
+		return w.WithChainID(chainID)
+	}
+	if err := checkSwitchChain(a, chainID); err != nil {
+		return nil, err
+	}
+	return genericOf(a).WithChainID(chainID)
+}
+
+// SwitchNetwork returns the account on another network of its namespace, see
+// WithChainID.
+func SwitchNetwork(a AccountID, reference string) (AccountID, error) {
+	if a == nil || a.IsZero() {
+		return nil, ErrEmptyValue
+	}
+	return WithChainID(a, ChainID{Namespace: a.Namespace(), Reference: reference})
+}
+
+// checkSwitchChain checks that a can be moved to chainID.
+func checkSwitchChain(a AccountID, chainID ChainID) error {
+	if a == nil || a.IsZero() {
+		return ErrEmptyValue
+	}
+	if chainID.Namespace != a.Namespace() {
+		return fmt.Errorf("%w: cannot switch %s account to %s", ErrInvalidNamespace, a.Namespace(), chainID)
+	}
+	return nil
+}
+
+// rebuildAccount parses and validates address on chainID with the namespace parser.
+func rebuildAccount(chainID ChainID, address string) (AccountID, error) {
+	p, ok := GetParser(chainID.Namespace)
+	if !ok {
+		return NewGeneric(chainID.Namespace, chainID.Reference, address)
+	}
+	a, err := p.ParseAddress(chainID.Reference, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// WithChainID returns the account on another BIP122 network. Addresses of
+// registered networks are re-encoded with the target network's SegWit HRP or
+// base58check version byte, e.g. bc1q... to tb1q...; other addresses must be
+// valid on the target network as they are.
+func (a *bip122AccountID) WithChainID(chainID ChainID) (AccountID, error) {
+	if err := checkSwitchChain(a, chainID); err != nil {
+		return nil, err
+	}
+	address, err := convertBIP122Address(a.Network(), BIP122Network(chainID.Reference), a.Address())
+	if err != nil {
+		return nil, err
+	}
+	return rebuildAccount(chainID, address)
+}
+
+// convertBIP122Address re-encodes an address of one BIP122 network for another.
+// The address is returned unchanged if either network has no known encoding.
+func convertBIP122Address(from, to BIP122Network, address string) (string, error) {
+	src, ok := LookupBIP122Network(from)
+	if !ok || src.Validate != nil {
+		return address, nil
+	}
+	dst, ok := LookupBIP122Network(to)
+	if !ok || dst.Validate != nil {
+		return address, nil
+	}
+
+	if src.SegWitHRP != "" && strings.HasPrefix(strings.ToLower(address), src.SegWitHRP+"1") {
+		_, version, program, err := DecodeSegWit(address)
+		if err != nil {
+			return "", err
+		}
+		if dst.SegWitHRP == "" {
+			return "", fmt.Errorf("%w: %s does not support segwit addresses", ErrInvalidAddress, dst.Name)
+		}
+		return EncodeSegWit(dst.SegWitHRP, version, program)
+	}
+
+	data, err := decodeBase58Check(address)
+	if err != nil {
+		return "", err
+	}
+	p2pkh, p2sh := src.base58ScriptVersions()
+	script := classifyBase58Script(address, p2pkh, p2sh)
+	if script == ScriptUnknown {
+		return "", fmt.Errorf("%w: not a %s base58check address", ErrInvalidAddress, src.Name)
+	}
+	version, ok := base58ScriptVersion(dst, script)
+	if !ok {
+		return "", fmt.Errorf("%w: %s has no %s address version", ErrInvalidAddress, dst.Name, script)
+	}
+	return encodeBase58Check(append([]byte{version}, data[1:]...)), nil
+}
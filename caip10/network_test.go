@@ -0,0 +1,71 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithChainID(t *testing.T) {
+	evm := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	polygon, err := WithChainID(evm, ChainIDPolygon)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", polygon.String())
+	_, ok := polygon.(EIP155AccountID)
+	assert.True(t, ok, "got %T", polygon)
+
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	devnet, err := SwitchNetwork(sol, SolanaDevnet.String())
+	require.NoError(t, err)
+	assert.True(t, devnet.(SolanaAccountID).IsDevnet())
+
+	generic := MustNewGeneric("example", "a", "addr")
+	moved, err := generic.WithChainID(ChainID{Namespace: "example", Reference: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "example:b:addr", moved.String())
+
+	_, err = WithChainID(evm, ChainIDSolanaMainnet)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	_, err = SwitchNetwork(evm, "not-a-chain-id")
+	assert.Error(t, err)
+	_, err = SwitchNetwork(nil, "1")
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+
+	// The Filecoin address prefix is tied to the network
+	fil := MustNewFilecoin(FilecoinMainnet, "f01024")
+	_, err = WithChainID(fil, NewFilecoinChainID(FilecoinTestnet))
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestBIP122WithChainID(t *testing.T) {
+	tests := []struct {
+		fromNetwork BIP122Network
+		from        string
+		network     BIP122Network
+		to          string
+	}{
+		{BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", BitcoinTestnet, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+		{BitcoinTestnet, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", LitecoinMainnet, "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"},
+		{BitcoinMainnet, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", BitcoinTestnet, "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r"},
+		// P2SH maps to P2SH, legacy Litecoin versions included
+		{LitecoinMainnet, "3MSvaVbVFFLML86rt5eqgA9SvW23upaXdY", BitcoinMainnet, "3MSvaVbVFFLML86rt5eqgA9SvW23upaXdY"},
+		{LitecoinMainnet, "MTf4tP1TCNBn8dNkyxeBVoPrFCcVzxJvvh", BitcoinTestnet, "2ND18eEXWrhqhXujQZDGiJ78i8rEDj2SwNj"},
+		{BitcoinMainnet, "3MSvaVbVFFLML86rt5eqgA9SvW23upaXdY", LitecoinMainnet, "MTf4tP1TCNBn8dNkyxeBVoPrFCcVzxJvvh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from, func(t *testing.T) {
+			a := NewBIP122(tt.fromNetwork, tt.from)
+			switched, err := WithChainID(a, MustNewBIP122ChainID(tt.network))
+			require.NoError(t, err)
+			assert.Equal(t, tt.to, switched.Address())
+			assert.Equal(t, tt.network, switched.(BIP122AccountID).Network())
+		})
+	}
+
+	// Dogecoin has no segwit addresses
+	_, err := WithChainID(NewBitcoinMainnet("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"), MustNewBIP122ChainID(DogecoinMainnet))
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
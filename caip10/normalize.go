@@ -0,0 +1,110 @@
+package caip10
+
+import (
+	"strings"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// Normalize returns the canonical form of the account. Two accounts that identify the
+// same on-chain account normalize to equal strings:
+//
+//   - eip155: decimal references without leading zeros, EIP-55 checksummed addresses.
+//   - bip122, polkadot, antelope, icp: references are lower case hex truncated to 32
+//     characters, so a full genesis hash is accepted; bech32 and CashAddr addresses are
+//     lower cased.
+//   - solana: references are truncated to 32 characters.
+//   - cosmos: bech32 addresses are lower cased.
+//
+// Components that are not in a recognized encoding are left unchanged. The result is
+// the namespace-specific type when the normalized account parses, *GenericAccountID
+// otherwise. Zero values are returned as is.
+func (a *GenericAccountID) Normalize() AccountID {
+	if a.IsZero() {
+		return a
+	}
+	reference := normalizeReference(a.namespace, a.reference)
+	address := normalizeAddress(a.namespace, a.address)
+	if p, ok := GetParser(a.namespace); ok {
+		if native, err := p.ParseAddress(reference, address); err == nil {
+			return native
+		}
+	}
+	return newGenericUnchecked(a.namespace, reference, address)
+}
+
+// Normalize returns the canonical form of an account, see GenericAccountID.Normalize.
+func Normalize(a AccountID) AccountID {
+	if n, ok := a.(interface{ Normalize() AccountID }); ok {
+		return n.Normalize()
+	}
+	if a == nil || a.IsZero() {
+		return a
+	}
+	return genericOf(a).Normalize()
+}
+
+// NormalizeChainID returns the canonical form of a chain ID, using the reference
+// rules of Normalize.
+func NormalizeChainID(c ChainID) ChainID {
+	if c.IsZero() {
+		return c
+	}
+	return ChainID{Namespace: c.Namespace, Reference: normalizeReference(c.Namespace, c.Reference)}
+}
+
+func normalizeReference(ns Namespace, reference string) string {
+	switch ns {
+	case NamespaceEIP155:
+		if isDecimal(reference) {
+			if trimmed := strings.TrimLeft(reference, "0"); trimmed != "" {
+				return trimmed
+			}
+			return "0"
+		}
+	case NamespaceBIP122, NamespacePolkadot, NamespaceAntelope, NamespaceICP:
+		hash := strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(reference, "0x"), "0X"))
+		if isHex(hash) {
+			return hash[:min(len(hash), 32)]
+		}
+	case NamespaceSolana:
+		if len(reference) > 32 && solanaAddressRegex.MatchString(reference) {
+			return reference[:32]
+		}
+	}
+	return reference
+}
+
+func normalizeAddress(ns Namespace, address string) string {
+	switch ns {
+	case NamespaceEIP155:
+		if ecommon.IsHexAddress(address) {
+			return ecommon.HexToAddress(address).Hex()
+		}
+	case NamespaceBIP122:
+		if _, _, _, err := decodeBech32(address, 90); err == nil {
+			return strings.ToLower(address)
+		}
+		if _, _, err := decodeCashAddr(address); err == nil {
+			return strings.ToLower(address)
+		}
+	case "cosmos":
+		if _, _, _, err := decodeBech32(address, 0); err == nil {
+			return strings.ToLower(address)
+		}
+	}
+	return address
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return false
+		}
+	}
+	return true
+}
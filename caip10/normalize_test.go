@@ -0,0 +1,73 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   AccountID
+		want string
+	}{
+		{
+			name: "eip155 checksum and reference",
+			in:   newGenericUnchecked(NamespaceEIP155, "0137", "0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB"),
+			want: "eip155:137:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb",
+		},
+		{
+			name: "bip122 full genesis hash and upper case bech32",
+			in: newGenericUnchecked(NamespaceBIP122,
+				"000000000019D6689C085AE165831E934FF763AE46A2A6C172B3F1B60A8CE26F",
+				"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4"),
+			want: "bip122:000000000019d6689c085ae165831e93:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+		{
+			name: "bip122 upper case cashaddr",
+			in: newGenericUnchecked(NamespaceBIP122, string(BitcoinCashMainnet),
+				"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A"),
+			want: "bip122:" + string(BitcoinCashMainnet) + ":bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		},
+		{
+			name: "bip122 base58 unchanged",
+			in:   MustParse("bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6"),
+			want: "bip122:000000000019d6689c085ae165831e93:128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6",
+		},
+		{
+			name: "solana full genesis hash",
+			in: newGenericUnchecked(NamespaceSolana, "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d",
+				"7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"),
+			want: "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+		},
+		{
+			name: "unknown namespace unchanged",
+			in:   MustNewGeneric("foo", "bar", "Baz"),
+			want: "foo:bar:Baz",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.in)
+			assert.Equal(t, tt.want, got.String())
+			assert.Equal(t, tt.want, Normalize(got).String(), "normalization is idempotent")
+		})
+	}
+}
+
+func TestNormalizeNativeType(t *testing.T) {
+	got := newGenericUnchecked(NamespaceEIP155, "1", "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb").Normalize()
+	_, ok := got.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", got)
+
+	assert.True(t, (*GenericAccountID)(nil).Normalize().IsZero())
+}
+
+func TestNormalizeChainID(t *testing.T) {
+	assert.Equal(t, ChainIDBitcoinMainnet,
+		NormalizeChainID(ChainID{Namespace: NamespaceBIP122, Reference: "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"}))
+	assert.Equal(t, NewEIP155ChainID(1), NormalizeChainID(ChainID{Namespace: NamespaceEIP155, Reference: "001"}))
+	assert.Equal(t, NewEIP155ChainID(0), NormalizeChainID(ChainID{Namespace: NamespaceEIP155, Reference: "000"}))
+	assert.True(t, NormalizeChainID(ChainID{}).IsZero())
+}
@@ -0,0 +1,100 @@
+package caip10
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Component identifies a part of a CAIP-10 string.
+type Component int
+
+const (
+	ComponentNone      Component = iota // the input as a whole, e.g. a missing separator
+	ComponentNamespace                  // the namespace before the first colon
+	ComponentReference                  // the chain reference between the colons
+	ComponentAddress                    // the account address after the second colon
+)
+
+func (c Component) String() string {
+	switch c {
+	case ComponentNamespace:
+		return "namespace"
+	case ComponentReference:
+		return "reference"
+	case ComponentAddress:
+		return "address"
+	default:
+		return "input"
+	}
+}
+
+// ParseError is returned by Parse, MustParse, ParseWithNamespace and ParseWithChainID.
+// It wraps the underlying error, so errors.Is still matches the sentinel errors
+// such as ErrInvalidAddress.
+type ParseError struct {
+	Input     string    // the string being parsed
+	Component Component // the failing component
+	Offset    int       // byte offset in Input of the failing component or character
+	Rule      string    // the rule violated, e.g. "must match [-a-z0-9]{3,8}, got \"EIP155\""
+	Err       error     // the underlying error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v (%s at offset %d of %q)", e.Err, e.Component, e.Offset, e.Input)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the code of the underlying error, see the package-level ErrorCode.
+func (e *ParseError) ErrorCode() string {
+	return ErrorCode(e.Err)
+}
+
+// newParseError describes err, returned while parsing input. When the component
+// is not known from err, it is inferred from the sentinel err wraps.
+func newParseError(input string, component Component, offset int, err error) *ParseError {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		return pe
+	}
+	if component == ComponentNone {
+		switch {
+		case errors.Is(err, ErrInvalidNamespace):
+			component = ComponentNamespace
+		case errors.Is(err, ErrInvalidReference):
+			component = ComponentReference
+		case errors.Is(err, ErrInvalidAddress):
+			component = ComponentAddress
+		}
+	}
+	if offset < 0 {
+		offset = componentOffset(input, component)
+	}
+	rule := err.Error()
+	var sentinel *codedError
+	if errors.Is(err, ErrEmptyValue) {
+		rule = "must not be empty"
+	} else if errors.As(err, &sentinel) {
+		rule = strings.TrimPrefix(rule, sentinel.Error()+": ")
+	}
+	return &ParseError{Input: input, Component: component, Offset: offset, Rule: rule, Err: err}
+}
+
+// componentOffset returns the byte offset of a component in a CAIP-10 string.
+func componentOffset(input string, c Component) int {
+	ns, ref, _, err := SplitCAIP10(input)
+	if err != nil {
+		return 0
+	}
+	switch c {
+	case ComponentReference:
+		return len(ns) + 1
+	case ComponentAddress:
+		return len(ns) + 1 + len(ref) + 1
+	default:
+		return 0
+	}
+}
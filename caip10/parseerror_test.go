@@ -0,0 +1,62 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input     string
+		opts      []ParseOption
+		sentinel  error
+		component Component
+		offset    int
+		rule      string
+	}{
+		{"", nil, ErrEmptyValue, ComponentNone, 0, "must not be empty"},
+		{"eip155", nil, ErrInvalidFormat, ComponentNone, 6, "missing namespace separator"},
+		{"eip155:1", nil, ErrInvalidFormat, ComponentNone, 8, "missing reference separator"},
+		{"EIP155:1:0xab", nil, ErrInvalidNamespace, ComponentNamespace, 0, `must match [-a-z0-9]{3,8}, got "EIP155"`},
+		{"eip155:1:0xzz", nil, ErrInvalidAddress, ComponentAddress, 9, ""},
+		{"eip155:1.5:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil, ErrInvalidReference, ComponentReference, 7, ""},
+		{"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", []ParseOption{WithMaxLength(10)}, ErrInvalidFormat, ComponentNone, 10, "exceeds 10 characters"},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", []ParseOption{WithAllowedNamespaces(NamespaceEIP155)}, ErrInvalidNamespace, ComponentNamespace, 0, `namespace "solana" is not allowed`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input, tt.opts...)
+		var pe *ParseError
+		require.True(t, errors.As(err, &pe), "%q: got %v", tt.input, err)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+		assert.Equal(t, tt.input, pe.Input)
+		assert.Equal(t, tt.component, pe.Component, tt.input)
+		assert.Equal(t, tt.offset, pe.Offset, tt.input)
+		if tt.rule != "" {
+			assert.Equal(t, tt.rule, pe.Rule, tt.input)
+		} else {
+			assert.NotEmpty(t, pe.Rule, tt.input)
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := Parse("EIP155:1:0xab")
+	assert.EqualError(t, err, `caip10: invalid namespace: must match [-a-z0-9]{3,8}, got "EIP155" (namespace at offset 0 of "EIP155:1:0xab")`)
+
+	_, err = ParseWithNamespace(NamespaceEIP155, "1", "0x12")
+	var pe *ParseError
+	require.True(t, errors.As(err, &pe), "got %v", err)
+	assert.Equal(t, "eip155:1:0x12", pe.Input)
+	assert.Equal(t, ComponentAddress, pe.Component)
+	assert.Equal(t, 9, pe.Offset)
+}
+
+func TestComponentString(t *testing.T) {
+	assert.Equal(t, "input", ComponentNone.String())
+	assert.Equal(t, "namespace", ComponentNamespace.String())
+	assert.Equal(t, "reference", ComponentReference.String())
+	assert.Equal(t, "address", ComponentAddress.String())
+}
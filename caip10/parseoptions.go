@@ -0,0 +1,91 @@
+package caip10
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ParseOption configures Parse.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	policy     ValidationPolicy
+	namespaces []Namespace
+	maxLength  int
+	generic    bool
+	registry   *Registry
+}
+
+func newParseOptions(opts []ParseOption) parseOptions {
+	o := parseOptions{policy: DefaultValidationPolicy()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithPolicy validates with p instead of the default ValidationPolicy.
+func WithPolicy(p ValidationPolicy) ParseOption {
+	return func(o *parseOptions) {
+		o.policy = p
+	}
+}
+
+// WithAllowedNamespaces rejects accounts outside the given namespaces with ErrInvalidNamespace.
+// Calling it more than once extends the allowed set.
+func WithAllowedNamespaces(namespaces ...Namespace) ParseOption {
+	return func(o *parseOptions) {
+		o.namespaces = append(o.namespaces, namespaces...)
+	}
+}
+
+// WithMaxLength rejects CAIP-10 strings longer than n bytes with ErrInvalidFormat.
+func WithMaxLength(n int) ParseOption {
+	return func(o *parseOptions) {
+		o.maxLength = n
+	}
+}
+
+// WithoutNativeTypes makes Parse return *GenericAccountID instead of the
+// namespace-specific type. Validation is unchanged.
+func WithoutNativeTypes() ParseOption {
+	return func(o *parseOptions) {
+		o.generic = true
+	}
+}
+
+// WithRegistry parses with the parsers of r instead of the global registry.
+func WithRegistry(r *Registry) ParseOption {
+	return func(o *parseOptions) {
+		o.registry = r
+	}
+}
+
+// checkInput applies the input constraints that do not depend on the parsed account.
+func (o parseOptions) checkInput(s string) error {
+	if o.maxLength > 0 && len(s) > o.maxLength {
+		return fmt.Errorf("%w: exceeds %d characters", ErrInvalidFormat, o.maxLength)
+	}
+	return nil
+}
+
+// parse parses the components according to the options.
+func (o parseOptions) parse(ns Namespace, reference, address string) (AccountID, error) {
+	if len(o.namespaces) > 0 && !slices.Contains(o.namespaces, ns) {
+		return nil, fmt.Errorf("%w: namespace %q is not allowed", ErrInvalidNamespace, ns)
+	}
+	lookup := GetParser
+	if o.registry != nil {
+		lookup = o.registry.Lookup
+	}
+	a, err := parseWithPolicy(ns, reference, address, o.policy, lookup)
+	if err != nil {
+		return nil, err
+	}
+	if o.generic {
+		if _, ok := a.(*GenericAccountID); !ok {
+			return newGenericUnchecked(a.Namespace(), a.Reference(), a.Address()), nil
+		}
+	}
+	return a, nil
+}
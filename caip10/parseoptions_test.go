@@ -0,0 +1,52 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptions(t *testing.T) {
+	const evm = "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	const sol = "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"
+
+	t.Run("allowed namespaces", func(t *testing.T) {
+		_, err := Parse(evm, WithAllowedNamespaces(NamespaceEIP155))
+		assert.NoError(t, err)
+		_, err = Parse(sol, WithAllowedNamespaces(NamespaceEIP155))
+		assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+		_, err = Parse(sol, WithAllowedNamespaces(NamespaceEIP155), WithAllowedNamespaces(NamespaceSolana))
+		assert.NoError(t, err)
+	})
+
+	t.Run("max length", func(t *testing.T) {
+		_, err := Parse(evm, WithMaxLength(len(evm)))
+		assert.NoError(t, err)
+		_, err = Parse(evm, WithMaxLength(len(evm)-1))
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+		_, err = ParseWithNamespace(NamespaceEIP155, "1", "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", WithMaxLength(10))
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		_, err := Parse("eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", WithPolicy(ValidationStrict))
+		assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	})
+
+	t.Run("without native types", func(t *testing.T) {
+		a, err := Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", WithoutNativeTypes())
+		require.NoError(t, err)
+		g, ok := a.(*GenericAccountID)
+		require.True(t, ok, "expected *GenericAccountID, got %T", a)
+		assert.Equal(t, evm, g.String(), "native normalization still applies")
+
+		_, err = Parse("eip155:1:0xnothex", WithoutNativeTypes())
+		assert.Error(t, err)
+	})
+
+	t.Run("must parse", func(t *testing.T) {
+		assert.Panics(t, func() { MustParse(sol, WithAllowedNamespaces(NamespaceEIP155)) })
+	})
+}
@@ -0,0 +1,165 @@
+package caip10
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ValidationPolicy controls how strictly accounts are validated by Parse, NewGeneric
+// and Validate.
+type ValidationPolicy int
+
+const (
+	// ValidationStandard applies the namespace-specific rules of each registered
+	// namespace and the generic CAIP-10 rules to unknown namespaces. Checksums are
+	// verified where the encoding mandates one, mixed-case EIP-55 casing is not
+	// enforced and off-curve Solana addresses (PDAs) are accepted. This is the default.
+	ValidationStandard ValidationPolicy = iota
+	// ValidationStrict applies ValidationStandard and additionally enforces EIP-55
+	// checksums on mixed-case eip155 addresses, requires Solana addresses to be on the
+	// ed25519 curve and rejects namespaces without a registered parser.
+	ValidationStrict
+	// ValidationLoose only applies the generic CAIP-10 syntax rules. Accounts failing
+	// the namespace-specific rules are parsed as *GenericAccountID.
+	ValidationLoose
+	// ValidationNone skips validation; any string with three colon-separated
+	// components is accepted.
+	ValidationNone
+)
+
+// String returns the name of the policy.
+func (p ValidationPolicy) String() string {
+	switch p {
+	case ValidationStandard:
+		return "standard"
+	case ValidationStrict:
+		return "strict"
+	case ValidationLoose:
+		return "loose"
+	case ValidationNone:
+		return "none"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
+var defaultValidationPolicy atomic.Int32
+
+// DefaultValidationPolicy returns the policy used by Parse, NewGeneric and Validate.
+func DefaultValidationPolicy() ValidationPolicy {
+	return ValidationPolicy(defaultValidationPolicy.Load())
+}
+
+// SetDefaultValidationPolicy sets the policy used by Parse, NewGeneric and Validate.
+// It is safe for concurrent use.
+func SetDefaultValidationPolicy(p ValidationPolicy) {
+	defaultValidationPolicy.Store(int32(p))
+}
+
+// ParseWithPolicy parses a CAIP-10 string, validating it with the given policy
+// instead of the default one. It is shorthand for Parse(s, WithPolicy(policy)).
+func ParseWithPolicy(s string, policy ValidationPolicy) (AccountID, error) {
+	return Parse(s, WithPolicy(policy))
+}
+
+// ValidateWithPolicy validates an account with the given policy instead of the default one.
+func ValidateWithPolicy(a AccountID, policy ValidationPolicy) error {
+	var err error
+	if a == nil {
+		err = ErrEmptyValue
+	} else {
+		err = newGenericUnchecked(a.Namespace(), a.Reference(), a.Address()).validate(policy, GetParser)
+	}
+	fireValidateErrorHooks(a, err)
+	return err
+}
+
+// parserLookup returns the parser of a namespace, e.g. GetParser or Registry.Lookup.
+type parserLookup func(Namespace) (Parser, bool)
+
+func parseWithPolicy(ns Namespace, reference, address string, policy ValidationPolicy, lookup parserLookup) (AccountID, error) {
+	switch policy {
+	case ValidationLoose, ValidationNone:
+		if p, ok := lookup(ns); ok {
+			if a, err := p.ParseAddress(reference, address); err == nil {
+				return a, nil
+			}
+		}
+		a := newGenericUnchecked(ns, reference, address)
+		if err := a.validate(policy, lookup); err != nil {
+			return nil, err
+		}
+		return a, nil
+	case ValidationStrict:
+		p, ok := lookup(ns)
+		if !ok {
+			return nil, fmt.Errorf("%w: no parser registered for %q", ErrUnknownNamespace, ns)
+		}
+		a, err := p.ParseAddress(reference, address)
+		if err != nil {
+			return nil, err
+		}
+		if err := newGenericUnchecked(ns, reference, address).validate(policy, lookup); err != nil {
+			return nil, err
+		}
+		return a, nil
+	default:
+		if p, ok := lookup(ns); ok {
+			return p.ParseAddress(reference, address)
+		}
+		a := newGenericUnchecked(ns, reference, address)
+		if err := a.validate(policy, lookup); err != nil {
+			return nil, err
+		}
+		return a, nil
+	}
+}
+
+// validate checks the account against the policy. lookup decides which namespaces
+// are known under ValidationStrict.
+func (a *GenericAccountID) validate(policy ValidationPolicy, lookup parserLookup) error {
+	if a == nil {
+		return ErrEmptyValue
+	}
+	switch policy {
+	case ValidationNone:
+		return nil
+	case ValidationLoose:
+		return validateCAIP10Syntax(a.namespace, a.reference, a.address)
+	case ValidationStrict:
+		if _, ok := lookup(a.namespace); !ok {
+			return fmt.Errorf("%w: no parser registered for %q", ErrUnknownNamespace, a.namespace)
+		}
+		if err := a.validateStandard(); err != nil {
+			return err
+		}
+		return validateStrict(a.namespace, a.address)
+	default:
+		return a.validateStandard()
+	}
+}
+
+// validateStrict applies the checks ValidationStrict adds on top of ValidationStandard.
+func validateStrict(ns Namespace, address string) error {
+	switch ns {
+	case NamespaceEIP155:
+		return ValidateEIP55Checksum(address)
+	case NamespaceSolana:
+		return ValidateSolanaAddress(address)
+	}
+	return nil
+}
+
+// validateCAIP10Syntax checks the generic CAIP-10 component rules.
+func validateCAIP10Syntax(ns Namespace, reference, address string) error {
+	if !NamespaceRegex.MatchString(string(ns)) {
+		return fmt.Errorf("%w: must match [-a-z0-9]{3,8}, got %q", ErrInvalidNamespace, ns)
+	}
+	if !ReferenceRegex.MatchString(reference) {
+		return fmt.Errorf("%w: must match [-_a-zA-Z0-9]{1,32}, got %q", ErrInvalidReference, reference)
+	}
+	if !AddressRegex.MatchString(address) {
+		return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,128}, got %q", ErrInvalidAddress, address)
+	}
+	return nil
+}
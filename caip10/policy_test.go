@@ -0,0 +1,91 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setDefaultValidationPolicy sets the default policy for the duration of the test.
+func setDefaultValidationPolicy(t *testing.T, p ValidationPolicy) {
+	prev := DefaultValidationPolicy()
+	SetDefaultValidationPolicy(p)
+	t.Cleanup(func() { SetDefaultValidationPolicy(prev) })
+}
+
+func TestValidationPolicyParse(t *testing.T) {
+	pda, _, err := web3.FindProgramAddress([][]byte{[]byte("policy")}, SNSNameProgramID)
+	require.NoError(t, err)
+	offCurve := "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:" + pda.String()
+
+	tests := []struct {
+		input string
+		// wantErr per policy: standard, strict, loose, none
+		wantErr [4]bool
+	}{
+		{"eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", [4]bool{false, false, false, false}},
+		{"eip155:1:0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", [4]bool{false, true, false, false}},
+		{"eip155:1:0xnothex", [4]bool{true, true, false, false}},
+		{offCurve, [4]bool{false, true, false, false}},
+		{"foo:bar:baz", [4]bool{false, true, false, false}},
+		{"foo:bar:b@z", [4]bool{true, true, true, false}},
+	}
+	policies := []ValidationPolicy{ValidationStandard, ValidationStrict, ValidationLoose, ValidationNone}
+	for _, tt := range tests {
+		for i, p := range policies {
+			_, err := ParseWithPolicy(tt.input, p)
+			assert.Equal(t, tt.wantErr[i], err != nil, "%s with %s: %v", tt.input, p, err)
+		}
+	}
+}
+
+func TestValidationPolicyLooseFallback(t *testing.T) {
+	a, err := ParseWithPolicy("eip155:1:0xnothex", ValidationLoose)
+	require.NoError(t, err)
+	_, ok := a.(*GenericAccountID)
+	assert.True(t, ok, "expected *GenericAccountID, got %T", a)
+
+	a, err = ParseWithPolicy("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", ValidationLoose)
+	require.NoError(t, err)
+	_, ok = a.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", a)
+}
+
+func TestDefaultValidationPolicy(t *testing.T) {
+	assert.Equal(t, ValidationStandard, DefaultValidationPolicy())
+
+	setDefaultValidationPolicy(t, ValidationStrict)
+	_, err := Parse("foo:bar:baz")
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	_, err = NewGeneric("foo", "bar", "baz")
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+
+	a := newGenericUnchecked(NamespaceEIP155, "1", "0xAb16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	assert.True(t, errors.Is(a.Validate(), ErrInvalidAddress))
+	assert.NoError(t, ValidateWithPolicy(a, ValidationStandard))
+
+	SetDefaultValidationPolicy(ValidationLoose)
+	_, err = Parse("eip155:1:0xnothex")
+	assert.NoError(t, err)
+}
+
+func TestValidateWithPolicy(t *testing.T) {
+	assert.True(t, errors.Is(ValidateWithPolicy(nil, ValidationNone), ErrEmptyValue))
+	// bip122 parsing checks addresses, loose parsing falls back to a generic account
+	const s = "bip122:000000000019d6689c085ae165831e93:notanaddress"
+	_, err := ParseWithPolicy(s, ValidationStandard)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	a, err := ParseWithPolicy(s, ValidationLoose)
+	require.NoError(t, err)
+	assert.IsType(t, &GenericAccountID{}, a)
+	assert.Error(t, ValidateWithPolicy(a, ValidationStrict))
+	assert.NoError(t, ValidateWithPolicy(a, ValidationLoose))
+}
+
+func TestValidationPolicyString(t *testing.T) {
+	assert.Equal(t, "strict", ValidationStrict.String())
+	assert.Equal(t, "unknown(9)", ValidationPolicy(9).String())
+}
@@ -0,0 +1,270 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+const NamespacePolkadot Namespace = "polkadot"
+
+// PolkadotNetwork represents a Substrate-based network (chain reference).
+// The reference is the first 32 characters of the genesis block hash (hex encoded, without 0x).
+// https://github.com/ChainAgnostic/namespaces/blob/main/polkadot/caip10.md
+type PolkadotNetwork string
+
+// Common Polkadot networks (genesis block hash prefix)
+const (
+	PolkadotMainnet PolkadotNetwork = "91b171bb158e2d3848fa23a9f1c25182" // Polkadot relay chain
+	KusamaMainnet   PolkadotNetwork = "b0a8d493285c2df73290dfb7e61f870f" // Kusama relay chain
+	WestendTestnet  PolkadotNetwork = "e143f23803ac50e8f6f8e62695d1ce9e" // Westend testnet
+)
+
+// String returns the network reference string.
+// If the reference is longer than 32 characters, it will be truncated.
+func (n PolkadotNetwork) String() string {
+	s := string(n)
+	if len(s) > 32 {
+		return s[:32]
+	}
+	return s
+}
+
+// SS58 network prefixes
+// https://github.com/paritytech/ss58-registry/blob/main/ss58-registry.json
+const (
+	SS58PrefixPolkadot  uint16 = 0
+	SS58PrefixKusama    uint16 = 2
+	SS58PrefixSubstrate uint16 = 42 // generic Substrate, also used by Westend
+)
+
+// polkadotNetworkPrefixes maps well-known networks to their SS58 prefix.
+// Addresses on unknown networks may use any prefix.
+var polkadotNetworkPrefixes = map[PolkadotNetwork]uint16{
+	PolkadotMainnet: SS58PrefixPolkadot,
+	KusamaMainnet:   SS58PrefixKusama,
+	WestendTestnet:  SS58PrefixSubstrate,
+}
+
+// PolkadotPublicKeyLength is the length of an sr25519/ed25519 account public key.
+const PolkadotPublicKeyLength = 32
+
+// ss58Prefix is the domain separator hashed into every SS58 checksum.
+var ss58Prefix = []byte("SS58PRE")
+
+// ss58Checksum returns the first two bytes of blake2b-512("SS58PRE" || data).
+func ss58Checksum(data []byte) []byte {
+	h, _ := blake2b.New512(nil)
+	h.Write(ss58Prefix)
+	h.Write(data)
+	return h.Sum(nil)[:2]
+}
+
+// encodeSS58Prefix encodes a network prefix in its 1- or 2-byte SS58 form.
+func encodeSS58Prefix(prefix uint16) []byte {
+	if prefix < 64 {
+		return []byte{byte(prefix)}
+	}
+	return []byte{
+		byte((prefix&0xfc)>>2) | 0x40,
+		byte(prefix>>8) | byte(prefix&0x03)<<6,
+	}
+}
+
+// EncodeSS58 encodes a 32-byte public key as an SS58 address with the given network prefix.
+// Prefixes above 16383 are not representable and are masked.
+func EncodeSS58(prefix uint16, pubkey [PolkadotPublicKeyLength]byte) string {
+	prefix &= 0x3fff
+	data := append(encodeSS58Prefix(prefix), pubkey[:]...)
+	data = append(data, ss58Checksum(data)...)
+	return base58.Encode(data)
+}
+
+// DecodeSS58 decodes an SS58 account address, verifying the blake2b checksum.
+// It returns the network prefix and the 32-byte public key.
+func DecodeSS58(address string) (prefix uint16, pubkey [PolkadotPublicKeyLength]byte, err error) {
+	data, err := base58.Decode(address)
+	if err != nil || len(data) == 0 {
+		return 0, pubkey, fmt.Errorf("%w: invalid base58 encoding", ErrInvalidAddress)
+	}
+
+	prefixLen := 1
+	switch {
+	case data[0] < 64:
+		prefix = uint16(data[0])
+	case data[0] < 128:
+		if len(data) < 2 {
+			return 0, pubkey, fmt.Errorf("%w: truncated SS58 prefix", ErrInvalidAddress)
+		}
+		prefixLen = 2
+		prefix = uint16(data[0]&0x3f)<<2 | uint16(data[1])>>6 | uint16(data[1]&0x3f)<<8
+	default:
+		return 0, pubkey, fmt.Errorf("%w: reserved SS58 prefix byte 0x%02x", ErrInvalidAddress, data[0])
+	}
+
+	if len(data) != prefixLen+PolkadotPublicKeyLength+2 {
+		return 0, pubkey, fmt.Errorf("%w: SS58 account address must decode to %d bytes, got %d",
+			ErrInvalidAddress, prefixLen+PolkadotPublicKeyLength+2, len(data))
+	}
+
+	body := data[:len(data)-2]
+	if !bytes.Equal(ss58Checksum(body), data[len(data)-2:]) {
+		return 0, pubkey, fmt.Errorf("%w: SS58 checksum mismatch", ErrInvalidChecksum)
+	}
+	copy(pubkey[:], body[prefixLen:])
+	return prefix, pubkey, nil
+}
+
+// ValidatePolkadotAddress validates an SS58 address for a specific network.
+// For well-known networks the address prefix must match the network's SS58 prefix.
+func ValidatePolkadotAddress(network PolkadotNetwork, address string) error {
+	_, _, err := decodePolkadotAddress(network, address)
+	return err
+}
+
+func decodePolkadotAddress(network PolkadotNetwork, address string) (uint16, [PolkadotPublicKeyLength]byte, error) {
+	prefix, pubkey, err := DecodeSS58(address)
+	if err != nil {
+		return 0, pubkey, err
+	}
+	if want, ok := polkadotNetworkPrefixes[network]; ok && prefix != want {
+		return 0, pubkey, fmt.Errorf("%w: SS58 prefix %d does not match network %s (want %d)",
+			ErrInvalidAddress, prefix, network, want)
+	}
+	return prefix, pubkey, nil
+}
+
+// PolkadotAccountID is the interface for Polkadot (Substrate) account IDs.
+type PolkadotAccountID interface {
+	AccountID
+	// Network returns the Polkadot network.
+	Network() PolkadotNetwork
+	// PublicKey returns the raw 32-byte account public key.
+	PublicKey() [PolkadotPublicKeyLength]byte
+	// SS58Prefix returns the network prefix encoded in the address.
+	SS58Prefix() uint16
+}
+
+// Ensure polkadotAccountID implements PolkadotAccountID at compile time
+var _ PolkadotAccountID = (*polkadotAccountID)(nil)
+
+func init() {
+	RegisterParser(&polkadotParser{})
+}
+
+// polkadotAccountID represents a Polkadot account ID per CAIP-10.
+type polkadotAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	network           PolkadotNetwork
+	pubkey            [PolkadotPublicKeyLength]byte
+	prefix            uint16
+}
+
+// NewPolkadot creates a new PolkadotAccountID from a raw public key.
+// The address is encoded with the network's SS58 prefix, or the generic Substrate prefix for unknown networks.
+func NewPolkadot(network PolkadotNetwork, pubkey [PolkadotPublicKeyLength]byte) PolkadotAccountID {
+	prefix, ok := polkadotNetworkPrefixes[network]
+	if !ok {
+		prefix = SS58PrefixSubstrate
+	}
+	return newPolkadot(network, pubkey, prefix)
+}
+
+func newPolkadot(network PolkadotNetwork, pubkey [PolkadotPublicKeyLength]byte, prefix uint16) PolkadotAccountID {
+	return &polkadotAccountID{
+		GenericAccountID: newGenericUnchecked(NamespacePolkadot, network.String(), EncodeSS58(prefix, pubkey)),
+		network:          network,
+		pubkey:           pubkey,
+		prefix:           prefix,
+	}
+}
+
+// NewPolkadotFromSS58 creates a new PolkadotAccountID from an SS58 address string.
+// Validation:
+//  1. Base58 decode and SS58 prefix/length check
+//  2. blake2b checksum verification
+//  3. Prefix must match the network for well-known networks
+func NewPolkadotFromSS58(network PolkadotNetwork, address string) (PolkadotAccountID, error) {
+	if !polkadotReferenceRegex.MatchString(string(network)) {
+		return nil, fmt.Errorf("%w: invalid Polkadot genesis hash, must be 32 lowercase hex characters, got %q", ErrInvalidReference, network)
+	}
+	prefix, pubkey, err := decodePolkadotAddress(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return newPolkadot(network, pubkey, prefix), nil
+}
+
+// MustNewPolkadotFromSS58 creates a new PolkadotAccountID and panics if invalid.
+func MustNewPolkadotFromSS58(network PolkadotNetwork, address string) PolkadotAccountID {
+	a, err := NewPolkadotFromSS58(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Polkadot network.
+func (a *polkadotAccountID) Network() PolkadotNetwork {
+	if a == nil {
+		return ""
+	}
+	return a.network
+}
+
+// PublicKey returns the raw 32-byte account public key.
+func (a *polkadotAccountID) PublicKey() [PolkadotPublicKeyLength]byte {
+	if a == nil {
+		return [PolkadotPublicKeyLength]byte{}
+	}
+	return a.pubkey
+}
+
+// SS58Prefix returns the network prefix encoded in the address.
+func (a *polkadotAccountID) SS58Prefix() uint16 {
+	if a == nil {
+		return 0
+	}
+	return a.prefix
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *polkadotAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *polkadotAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- polkadotParser ---
+
+type polkadotParser struct{}
+
+func (p *polkadotParser) Namespace() Namespace {
+	return NamespacePolkadot
+}
+
+func (p *polkadotParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespacePolkadot {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespacePolkadot, ns)
+	}
+	return NewPolkadotFromSS58(PolkadotNetwork(ref), addr)
+}
+
+func (p *polkadotParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewPolkadotFromSS58(PolkadotNetwork(reference), address)
+}
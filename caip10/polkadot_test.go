@@ -0,0 +1,118 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// alicePubKey is the well-known //Alice development account public key.
+const alicePubKey = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+func mustAlicePubKey(t *testing.T) [PolkadotPublicKeyLength]byte {
+	t.Helper()
+	b, err := hex.DecodeString(alicePubKey)
+	require.NoError(t, err)
+	var pk [PolkadotPublicKeyLength]byte
+	copy(pk[:], b)
+	return pk
+}
+
+func TestSS58RoundTrip(t *testing.T) {
+	pk := mustAlicePubKey(t)
+	tests := []struct {
+		prefix  uint16
+		address string
+	}{
+		{SS58PrefixPolkadot, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+		{SS58PrefixKusama, "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"},
+		{SS58PrefixSubstrate, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			assert.Equal(t, tt.address, EncodeSS58(tt.prefix, pk))
+
+			prefix, decoded, err := DecodeSS58(tt.address)
+			require.NoError(t, err)
+			assert.Equal(t, tt.prefix, prefix)
+			assert.Equal(t, pk, decoded)
+		})
+	}
+
+	// Two-byte prefixes round-trip as well
+	for _, prefix := range []uint16{64, 255, 1284, 16383} {
+		got, decoded, err := DecodeSS58(EncodeSS58(prefix, pk))
+		require.NoError(t, err)
+		assert.Equal(t, prefix, got)
+		assert.Equal(t, pk, decoded)
+	}
+}
+
+func TestPolkadotParse(t *testing.T) {
+	input := "polkadot:91b171bb158e2d3848fa23a9f1c25182:15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	dot, ok := a.(PolkadotAccountID)
+	require.True(t, ok, "expected PolkadotAccountID, got %T", a)
+	assert.Equal(t, PolkadotMainnet, dot.Network())
+	assert.Equal(t, SS58PrefixPolkadot, dot.SS58Prefix())
+	assert.Equal(t, mustAlicePubKey(t), dot.PublicKey())
+	assert.Equal(t, ChainIDPolkadot, dot.ChainID())
+	assert.Equal(t, input, dot.String())
+}
+
+func TestNewPolkadot(t *testing.T) {
+	pk := mustAlicePubKey(t)
+
+	assert.Equal(t, "polkadot:b0a8d493285c2df73290dfb7e61f870f:HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F",
+		NewPolkadot(KusamaMainnet, pk).String())
+	assert.Equal(t, "polkadot:e143f23803ac50e8f6f8e62695d1ce9e:5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		NewPolkadot(WestendTestnet, pk).String())
+
+	// Unknown networks fall back to the generic Substrate prefix
+	para := NewPolkadot("fe58ea77779b7abda7da4ec526d14db9", pk)
+	assert.Equal(t, SS58PrefixSubstrate, para.SS58Prefix())
+	assert.Equal(t, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", para.Address())
+}
+
+func TestPolkadotUnknownNetworkAnyPrefix(t *testing.T) {
+	// Parachains use their own prefixes, which are accepted as-is
+	a, err := NewPolkadotFromSS58("fe58ea77779b7abda7da4ec526d14db9", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5")
+	require.NoError(t, err)
+	assert.Equal(t, SS58PrefixPolkadot, a.SS58Prefix())
+}
+
+func TestPolkadotParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "polkadot:91B171BB158E2D3848FA23A9F1C25182:15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", ErrInvalidReference},
+		{"prefix mismatch", "polkadot:91b171bb158e2d3848fa23a9f1c25182:5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", ErrInvalidAddress},
+		{"bad checksum", "polkadot:e143f23803ac50e8f6f8e62695d1ce9e:5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ", ErrInvalidAddress},
+		{"not base58", "polkadot:e143f23803ac50e8f6f8e62695d1ce9e:0GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", ErrInvalidAddress},
+		{"too short", "polkadot:e143f23803ac50e8f6f8e62695d1ce9e:5Grwva", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestPolkadotNilReceiver(t *testing.T) {
+	var a *polkadotAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, PolkadotNetwork(""), a.Network())
+	assert.Equal(t, [PolkadotPublicKeyLength]byte{}, a.PublicKey())
+	assert.Equal(t, uint16(0), a.SS58Prefix())
+}
@@ -0,0 +1,117 @@
+package caip10
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Condition is a SQL WHERE condition with "?" placeholders, as accepted by GORM's
+// Where and by the MySQL and SQLite drivers.
+type Condition struct {
+	SQL  string
+	Args []any
+}
+
+// Scope returns the condition as a GORM scope, for use with db.Scopes.
+func (c Condition) Scope() func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(c.SQL, c.Args...)
+	}
+}
+
+// matchNothing is returned for zero values, which are never stored.
+var matchNothing = Condition{SQL: "1 = 0"}
+
+// ColumnQuery builds conditions on accounts stored as AccountIDColumns or, if
+// Compact is set, as AccountIDColumnsCompact. Prefix is prepended to the column
+// names, matching the embeddedPrefix of the embedded columns.
+//
+// EVM addresses are matched in both their EIP-55 checksummed and lowercase forms,
+// so lookups find rows regardless of which casing was stored.
+type ColumnQuery struct {
+	Prefix  string
+	Compact bool
+}
+
+// ByChain matches accounts on chain c.
+func ByChain(c ChainID) Condition { return ColumnQuery{}.ByChain(c) }
+
+// ByAccount matches account a.
+func ByAccount(a AccountID) Condition { return ColumnQuery{}.ByAccount(a) }
+
+// InChains matches accounts on any of the given chains.
+func InChains(chains ...ChainID) Condition { return ColumnQuery{}.InChains(chains...) }
+
+// ByChain matches accounts on chain c.
+func (q ColumnQuery) ByChain(c ChainID) Condition {
+	if c.IsZero() {
+		return matchNothing
+	}
+	if q.Compact {
+		return Condition{SQL: q.Prefix + "chain_id = ?", Args: []any{c.String()}}
+	}
+	return Condition{
+		SQL:  q.Prefix + "namespace = ? AND " + q.Prefix + "reference = ?",
+		Args: []any{string(c.Namespace), c.Reference},
+	}
+}
+
+// ByAccount matches account a.
+func (q ColumnQuery) ByAccount(a AccountID) Condition {
+	if a == nil || a.IsZero() {
+		return matchNothing
+	}
+	chain := q.ByChain(a.ChainID())
+	addresses := lookupAddresses(a.Namespace(), a.Address())
+	sql := chain.SQL + " AND " + q.Prefix + "address"
+	if len(addresses) == 1 {
+		sql += " = ?"
+	} else {
+		sql += " IN (" + placeholders(len(addresses)) + ")"
+	}
+	return Condition{SQL: sql, Args: append(chain.Args, addresses...)}
+}
+
+// InChains matches accounts on any of the given chains. Zero chain IDs are ignored.
+func (q ColumnQuery) InChains(chains ...ChainID) Condition {
+	var args []any
+	var terms []string
+	for _, c := range chains {
+		if c.IsZero() {
+			continue
+		}
+		if q.Compact {
+			args = append(args, c.String())
+			continue
+		}
+		cond := q.ByChain(c)
+		terms = append(terms, "("+cond.SQL+")")
+		args = append(args, cond.Args...)
+	}
+	switch {
+	case len(args) == 0:
+		return matchNothing
+	case q.Compact:
+		return Condition{SQL: q.Prefix + "chain_id IN (" + placeholders(len(args)) + ")", Args: args}
+	default:
+		return Condition{SQL: "(" + strings.Join(terms, " OR ") + ")", Args: args}
+	}
+}
+
+// lookupAddresses returns the stored forms an address may take.
+func lookupAddresses(ns Namespace, address string) []any {
+	if ns != NamespaceEIP155 {
+		return []any{address}
+	}
+	checksummed := normalizeAddress(ns, address)
+	lower := strings.ToLower(checksummed)
+	if checksummed == lower {
+		return []any{lower}
+	}
+	return []any{checksummed, lower}
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
@@ -0,0 +1,61 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByChain(t *testing.T) {
+	assert.Equal(t, Condition{SQL: "namespace = ? AND reference = ?", Args: []any{"eip155", "137"}}, ByChain(ChainIDPolygon))
+	assert.Equal(t, Condition{SQL: "from_chain_id = ?", Args: []any{"eip155:137"}},
+		ColumnQuery{Prefix: "from_", Compact: true}.ByChain(ChainIDPolygon))
+	assert.Equal(t, matchNothing, ByChain(ChainID{}))
+}
+
+func TestByAccount(t *testing.T) {
+	const checksummed = "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+	const lower = "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"
+
+	for _, addr := range []string{checksummed, lower, "0xAB16A96D359EC26A11E2C2B3D8F8B8942D5BFCDB"} {
+		a := MustNewGeneric(NamespaceEIP155, "1", addr)
+		assert.Equal(t, Condition{
+			SQL:  "namespace = ? AND reference = ? AND address IN (?,?)",
+			Args: []any{"eip155", "1", checksummed, lower},
+		}, ByAccount(a), addr)
+	}
+
+	sol := MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	assert.Equal(t, Condition{
+		SQL:  "to_chain_id = ? AND to_address = ?",
+		Args: []any{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp", "7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"},
+	}, ColumnQuery{Prefix: "to_", Compact: true}.ByAccount(sol))
+
+	assert.Equal(t, matchNothing, ByAccount(nil))
+	assert.Equal(t, matchNothing, ByAccount(&GenericAccountID{}))
+}
+
+func TestInChains(t *testing.T) {
+	assert.Equal(t, Condition{
+		SQL:  "((namespace = ? AND reference = ?) OR (namespace = ? AND reference = ?))",
+		Args: []any{"eip155", "1", "eip155", "137"},
+	}, InChains(ChainIDEthereumMainnet, ChainID{}, ChainIDPolygon))
+	assert.Equal(t, Condition{SQL: "chain_id IN (?,?)", Args: []any{"eip155:1", "eip155:137"}},
+		ColumnQuery{Compact: true}.InChains(ChainIDEthereumMainnet, ChainIDPolygon))
+	assert.Equal(t, matchNothing, InChains())
+}
+
+func TestConditionScope(t *testing.T) {
+	a := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	q := ColumnQuery{Prefix: "from_"}
+	stmt := gormDryRun(t).Model(&gormTransfer{}).
+		Scopes(q.ByAccount(a).Scope(), q.InChains(ChainIDEthereumMainnet, ChainIDPolygon).Scope()).
+		Find(&[]gormTransfer{}).Statement
+	require.NoError(t, stmt.Error)
+	assert.Equal(t,
+		"SELECT * FROM `gorm_transfers` WHERE (from_namespace = ? AND from_reference = ? AND from_address IN (?,?)) AND "+
+			"(((from_namespace = ? AND from_reference = ?) OR (from_namespace = ? AND from_reference = ?)))",
+		stmt.SQL.String())
+	assert.Len(t, stmt.Vars, 8)
+}
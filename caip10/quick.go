@@ -0,0 +1,132 @@
+package caip10
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/mr-tron/base58"
+)
+
+// Random values for property-based tests. GenericAccountID, ChainID,
+// AccountIDColumns and AccountIDColumnsCompact implement testing/quick.Generator
+// with the functions below, so quick.Check generates spec-valid values for them:
+//
+//	quick.Check(func(c caip10.AccountIDColumns) bool {
+//		return roundTrip(c) == c
+//	}, nil)
+//
+// With pgregory.net/rapid, draw a seed:
+//
+//	accounts := rapid.Custom(func(t *rapid.T) *caip10.GenericAccountID {
+//		r := rand.New(rand.NewSource(rapid.Int64().Draw(t, "seed")))
+//		return caip10.RandomAccountID(r, 32)
+//	})
+//
+// The generated accounts are eip155, solana and bip122 accounts and accounts of
+// namespaces without a registered parser; all of them pass Validate.
+
+const (
+	randomLowerHex     = "0123456789abcdef"
+	randomNamespace    = "abcdefghijklmnopqrstuvwxyz0123456789-"
+	randomReference    = "-_abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	randomAddress      = "-.%abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	randomBase58       = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	randomMaxReference = 32
+	randomMaxAddress   = 128
+)
+
+// RandomAccountID returns a random valid account. size bounds the length of
+// free-form references and addresses, as in testing/quick.
+func RandomAccountID(r *rand.Rand, size int) *GenericAccountID {
+	switch r.Intn(4) {
+	case 0:
+		var address ecommon.Address
+		r.Read(address[:])
+		return newGenericUnchecked(NamespaceEIP155, randomEIP155Reference(r), address.Hex())
+	case 1:
+		var key [32]byte
+		r.Read(key[:])
+		networks := []SolanaNetwork{SolanaMainnet, SolanaDevnet, SolanaTestnet}
+		return newGenericUnchecked(NamespaceSolana, networks[r.Intn(len(networks))].String(), base58.Encode(key[:]))
+	case 2:
+		var hash [base58CheckHash160Length]byte
+		r.Read(hash[:])
+		versions := slices.Concat(bitcoinMainnetP2PKHVersions, bitcoinMainnetP2SHVersions)
+		version := versions[r.Intn(len(versions))]
+		return newGenericUnchecked(NamespaceBIP122, BitcoinMainnet.String(), encodeBase58Check(append([]byte{version}, hash[:]...)))
+	default:
+		return newGenericUnchecked(randomUnregisteredNamespace(r),
+			randomString(r, randomReference, 1+r.Intn(min(max(size, 1), randomMaxReference))),
+			randomString(r, randomAddress, 1+r.Intn(min(max(size, 1), randomMaxAddress))))
+	}
+}
+
+// RandomChainID returns a random valid chain ID of a namespace with a
+// reference syntax: eip155, solana, bip122 or polkadot.
+func RandomChainID(r *rand.Rand) ChainID {
+	switch r.Intn(4) {
+	case 0:
+		return ChainID{Namespace: NamespaceEIP155, Reference: randomEIP155Reference(r)}
+	case 1:
+		return ChainID{Namespace: NamespaceSolana, Reference: randomString(r, randomBase58, 32)}
+	case 2:
+		return ChainID{Namespace: NamespaceBIP122, Reference: randomString(r, randomLowerHex, 32)}
+	default:
+		return ChainID{Namespace: NamespacePolkadot, Reference: randomString(r, randomLowerHex, 32)}
+	}
+}
+
+// Generate implements testing/quick.Generator.
+func (*GenericAccountID) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(RandomAccountID(r, size))
+}
+
+// Generate implements testing/quick.Generator.
+func (ChainID) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomChainID(r))
+}
+
+// Generate implements testing/quick.Generator.
+func (AccountIDColumns) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(RandomAccountID(r, size).ToColumns())
+}
+
+// Generate implements testing/quick.Generator.
+func (AccountIDColumnsCompact) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(RandomAccountID(r, size).ToColumnsCompact())
+}
+
+// randomEIP155Reference returns a chain ID, mostly small ones as in practice.
+func randomEIP155Reference(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return strconv.FormatUint(uint64(1+r.Intn(100_000)), 10)
+	}
+	return strconv.FormatUint(r.Uint64(), 10)
+}
+
+// randomUnregisteredNamespace returns a namespace without a parser or
+// namespace-specific validation.
+func randomUnregisteredNamespace(r *rand.Rand) Namespace {
+	for {
+		ns := Namespace(randomString(r, randomNamespace, 3+r.Intn(6)))
+		if _, ok := GetParser(ns); ok {
+			continue
+		}
+		if !errors.Is(validateReference(ns, "x"), ErrUnknownNamespace) {
+			continue
+		}
+		return ns
+	}
+}
+
+func randomString(r *rand.Rand, alphabet string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return string(b)
+}
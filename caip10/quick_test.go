@@ -0,0 +1,47 @@
+package caip10
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ quick.Generator = (*GenericAccountID)(nil)
+	_ quick.Generator = ChainID{}
+	_ quick.Generator = AccountIDColumns{}
+	_ quick.Generator = AccountIDColumnsCompact{}
+)
+
+func TestQuickAccountID(t *testing.T) {
+	f := func(a *GenericAccountID) bool {
+		if err := a.Validate(); err != nil {
+			t.Logf("%s: %v", a, err)
+			return false
+		}
+		parsed, err := Parse(a.String())
+		return err == nil && parsed.String() == a.String()
+	}
+	assert.NoError(t, quick.Check(f, &quick.Config{MaxCount: 1000}))
+}
+
+func TestQuickChainID(t *testing.T) {
+	f := func(c ChainID) bool {
+		parsed, err := ParseChainID(c.String())
+		return err == nil && parsed == c
+	}
+	assert.NoError(t, quick.Check(f, &quick.Config{MaxCount: 1000}))
+}
+
+func TestQuickColumns(t *testing.T) {
+	f := func(c AccountIDColumns, compact AccountIDColumnsCompact) bool {
+		a, err := c.ToAccountID()
+		if err != nil || a.ToColumns() != c {
+			return false
+		}
+		full, err := compact.ToFull()
+		return err == nil && full.ToCompact() == compact
+	}
+	assert.NoError(t, quick.Check(f, &quick.Config{MaxCount: 1000}))
+}
@@ -0,0 +1,89 @@
+package caip10
+
+import (
+	"maps"
+	"slices"
+)
+
+// Registry is an immutable set of namespace parsers. Unlike the global registry
+// used by Parse, a Registry can be built per tenant or per request and passed to
+// Parse with WithRegistry. The zero value is an empty registry.
+type Registry struct {
+	parsers map[Namespace]Parser
+}
+
+// NewRegistry creates a registry with the given parsers. Later parsers replace
+// earlier ones for the same namespace.
+func NewRegistry(parsers ...Parser) *Registry {
+	return (&Registry{}).With(parsers...)
+}
+
+// DefaultRegistry returns a snapshot of the global registry. Later calls to
+// RegisterParser and UnregisterParser do not affect the snapshot.
+func DefaultRegistry() *Registry {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return &Registry{parsers: maps.Clone(registry)}
+}
+
+// Lookup returns the parser of a namespace.
+func (r *Registry) Lookup(namespace Namespace) (Parser, bool) {
+	if r == nil {
+		return nil, false
+	}
+	p, ok := r.parsers[namespace]
+	return p, ok
+}
+
+// Namespaces returns the registered namespaces in sorted order.
+func (r *Registry) Namespaces() []Namespace {
+	if r == nil {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(r.parsers))
+}
+
+// Parsers returns the registered parsers sorted by namespace.
+func (r *Registry) Parsers() []Parser {
+	out := make([]Parser, 0, r.Len())
+	for _, ns := range r.Namespaces() {
+		out = append(out, r.parsers[ns])
+	}
+	return out
+}
+
+// Len returns the number of registered parsers.
+func (r *Registry) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.parsers)
+}
+
+// With returns a copy of the registry with the given parsers added, replacing
+// existing parsers for the same namespace.
+func (r *Registry) With(parsers ...Parser) *Registry {
+	out := &Registry{parsers: make(map[Namespace]Parser, r.Len()+len(parsers))}
+	if r != nil {
+		maps.Copy(out.parsers, r.parsers)
+	}
+	for _, p := range parsers {
+		out.parsers[p.Namespace()] = p
+	}
+	return out
+}
+
+// Without returns a copy of the registry with the given namespaces removed.
+func (r *Registry) Without(namespaces ...Namespace) *Registry {
+	out := r.With()
+	for _, ns := range namespaces {
+		delete(out.parsers, ns)
+	}
+	return out
+}
+
+// Parse parses a CAIP-10 string using the parsers of this registry.
+// It is shorthand for Parse(s, WithRegistry(r), opts...).
+func (r *Registry) Parse(s string, opts ...ParseOption) (AccountID, error) {
+	return Parse(s, append([]ParseOption{WithRegistry(r)}, opts...)...)
+}
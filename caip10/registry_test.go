@@ -0,0 +1,109 @@
+package caip10
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterParser(t *testing.T) {
+	RegisterParser(NewGenericParser("xtest"))
+	t.Cleanup(func() { UnregisterParser("xtest") })
+
+	p, ok := GetParser("xtest")
+	require.True(t, ok)
+	assert.Equal(t, Namespace("xtest"), p.Namespace())
+	assert.Contains(t, ListParsers(), p)
+
+	UnregisterParser("xtest")
+	_, ok = GetParser("xtest")
+	assert.False(t, ok)
+	UnregisterParser("xtest") // no-op
+}
+
+func TestListParsersSorted(t *testing.T) {
+	parsers := ListParsers()
+	require.NotEmpty(t, parsers)
+	for i := 1; i < len(parsers); i++ {
+		assert.Less(t, parsers[i-1].Namespace(), parsers[i].Namespace())
+	}
+}
+
+func TestRegisterParserConcurrent(t *testing.T) {
+	t.Cleanup(func() { UnregisterParser("xtest") })
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterParser(NewGenericParser("xtest"))
+			UnregisterParser("xtest")
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+			_ = ListParsers()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(&eip155Parser{}, NewGenericParser("foo"))
+	assert.Equal(t, []Namespace{"eip155", "foo"}, r.Namespaces())
+	assert.Equal(t, 2, r.Len())
+
+	a, err := r.Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	require.NoError(t, err)
+	_, ok := a.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", a)
+
+	// solana is not in the registry, so it parses as a generic account
+	a, err = r.Parse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	require.NoError(t, err)
+	_, ok = a.(*GenericAccountID)
+	assert.True(t, ok, "expected *GenericAccountID, got %T", a)
+
+	// under the strict policy only namespaces of the registry are accepted
+	_, err = r.Parse("foo:bar:baz", WithPolicy(ValidationStrict))
+	assert.NoError(t, err)
+	_, err = r.Parse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv",
+		WithPolicy(ValidationStrict))
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestRegistryImmutable(t *testing.T) {
+	base := NewRegistry(&eip155Parser{})
+	extended := base.With(&solanaParser{})
+	reduced := extended.Without(NamespaceEIP155)
+
+	assert.Equal(t, []Namespace{NamespaceEIP155}, base.Namespaces())
+	assert.Equal(t, []Namespace{NamespaceEIP155, NamespaceSolana}, extended.Namespaces())
+	assert.Equal(t, []Namespace{NamespaceSolana}, reduced.Namespaces())
+
+	snapshot := DefaultRegistry()
+	RegisterParser(NewGenericParser("xtest"))
+	t.Cleanup(func() { UnregisterParser("xtest") })
+	_, ok := snapshot.Lookup("xtest")
+	assert.False(t, ok, "snapshot is not affected by later registrations")
+	_, ok = DefaultRegistry().Lookup("xtest")
+	assert.True(t, ok)
+}
+
+func TestRegistryZero(t *testing.T) {
+	var r *Registry
+	assert.Equal(t, 0, r.Len())
+	assert.Empty(t, r.Namespaces())
+	_, ok := r.Lookup(NamespaceEIP155)
+	assert.False(t, ok)
+	assert.Equal(t, 1, r.With(&eip155Parser{}).Len())
+
+	var empty Registry
+	a, err := empty.Parse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+	require.NoError(t, err)
+	_, ok = a.(*GenericAccountID)
+	assert.True(t, ok, "expected *GenericAccountID, got %T", a)
+}
@@ -0,0 +1,13 @@
+package caip10
+
+import "context"
+
+// Resolver resolves human-readable names (e.g. "vitalik.eth") to accounts and back.
+// Implementations return errors wrapping ErrNameNotFound for names or accounts
+// without a record.
+type Resolver interface {
+	// Resolve returns the account name points to on chainID.
+	Resolve(ctx context.Context, name string, chainID ChainID) (AccountID, error)
+	// ReverseResolve returns the primary name of an account.
+	ReverseResolve(ctx context.Context, account AccountID) (string, error)
+}
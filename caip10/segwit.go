@@ -0,0 +1,84 @@
+package caip10
+
+import "fmt"
+
+// SegWit address constraints per BIP-173 and BIP-350.
+const (
+	segWitMaxLength        = 90
+	segWitMaxVersion       = 16
+	segWitMinProgramLength = 2
+	segWitMaxProgramLength = 40
+)
+
+// DecodeSegWit decodes a SegWit (bc1q..., bech32) or Taproot and later (bc1p...,
+// bech32m) address into its lower case human-readable part, witness version and
+// witness program.
+//
+// Validation steps:
+//  1. Valid bech32 string of at most 90 characters, not mixed case
+//  2. Witness version 0 uses bech32, versions 1-16 use bech32m (BIP-350)
+//  3. Witness program is 2-40 bytes; version 0 programs are 20 (P2WPKH) or 32 (P2WSH) bytes
+func DecodeSegWit(address string) (hrp string, version byte, program []byte, err error) {
+	hrp, data, variant, err := decodeBech32(address, segWitMaxLength)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(data) == 0 {
+		return "", 0, nil, fmt.Errorf("%w: segwit address has no witness version", ErrInvalidAddress)
+	}
+	version = data[0]
+	if version > segWitMaxVersion {
+		return "", 0, nil, fmt.Errorf("%w: invalid witness version %d", ErrInvalidAddress, version)
+	}
+	if version == 0 && variant != bech32Classic {
+		return "", 0, nil, fmt.Errorf("%w: witness version 0 must use bech32", ErrInvalidAddress)
+	}
+	if version != 0 && variant != bech32M {
+		return "", 0, nil, fmt.Errorf("%w: witness version %d must use bech32m", ErrInvalidAddress, version)
+	}
+	program, err = convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(program) < segWitMinProgramLength || len(program) > segWitMaxProgramLength {
+		return "", 0, nil, fmt.Errorf("%w: invalid witness program length %d", ErrInvalidAddress, len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return "", 0, nil, fmt.Errorf("%w: witness version 0 program must be 20 or 32 bytes, got %d",
+			ErrInvalidAddress, len(program))
+	}
+	return hrp, version, program, nil
+}
+
+// EncodeSegWit encodes a witness version and program as a SegWit address with the
+// given human-readable part, using bech32 for version 0 and bech32m otherwise.
+func EncodeSegWit(hrp string, version byte, program []byte) (string, error) {
+	if version > segWitMaxVersion {
+		return "", fmt.Errorf("%w: invalid witness version %d", ErrInvalidAddress, version)
+	}
+	variant := bech32M
+	if version == 0 {
+		variant = bech32Classic
+	}
+	data, err := convertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	address := encodeBech32(hrp, append([]byte{version}, data...), variant)
+	if _, _, _, err := DecodeSegWit(address); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// validateSegWitAddress validates a SegWit address for the expected human-readable part.
+func validateSegWitAddress(address, hrp string) error {
+	got, _, _, err := DecodeSegWit(address)
+	if err != nil {
+		return err
+	}
+	if got != hrp {
+		return fmt.Errorf("%w: segwit address must use hrp %q, got %q", ErrInvalidAddress, hrp, got)
+	}
+	return nil
+}
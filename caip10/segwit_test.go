@@ -0,0 +1,89 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSegWit(t *testing.T) {
+	// BIP-173 and BIP-350 test vectors
+	tests := []struct {
+		address string
+		hrp     string
+		version byte
+		program string
+	}{
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc", 0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", "tb", 0,
+			"1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "bc", 1,
+			"79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		{"BC1SW50QGDZ25J", "bc", 16, "751e"},
+		{"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", "bc", 2, "751e76e8199196d454941c45d1b3a323"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			hrp, version, program, err := DecodeSegWit(tt.address)
+			require.NoError(t, err)
+			assert.Equal(t, tt.hrp, hrp)
+			assert.Equal(t, tt.version, version)
+			assert.Equal(t, tt.program, hex.EncodeToString(program))
+		})
+	}
+}
+
+func TestDecodeSegWitInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+	}{
+		{"v0 with bech32m", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh"},
+		{"v1 with bech32", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq5zuyut"},
+		{"invalid witness version", "BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R"},
+		{"program too short", "bc1pw5dgrnzv"},
+		{"program too long", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v8n0nx0muaewav253zgeav"},
+		{"v0 program length", "BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P"},
+		{"mixed case", "tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47Zagq"},
+		{"bad checksum", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"},
+		{"empty data", "bc1gmk9yu"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := DecodeSegWit(tt.address)
+			assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+		})
+	}
+}
+
+func TestEncodeSegWit(t *testing.T) {
+	program, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	address, err := EncodeSegWit("bc", 0, program)
+	require.NoError(t, err)
+	assert.Equal(t, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", address)
+
+	taproot, _ := hex.DecodeString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	address, err = EncodeSegWit("bc", 1, taproot)
+	require.NoError(t, err)
+	assert.Equal(t, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", address)
+
+	_, err = EncodeSegWit("bc", 0, program[:10])
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	_, err = EncodeSegWit("bc", 17, program)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestBIP122SegWitValidation(t *testing.T) {
+	assert.NoError(t, ValidateBIP122Address(BitcoinTestnet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"))
+
+	// testnet address on mainnet
+	err := ValidateBIP122Address(LitecoinMainnet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	// character-class match with a bad checksum
+	err = ValidateBIP122Address(BitcoinMainnet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
@@ -0,0 +1,101 @@
+package caip10
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// SignatureVerifier verifies message signatures of accounts in a namespace.
+type SignatureVerifier interface {
+	Namespace() Namespace
+	// VerifySignature verifies that signature over message was produced by account.
+	VerifySignature(account AccountID, message, signature []byte) error
+}
+
+// ContextSignatureVerifier is implemented by signature verifiers that may make
+// network calls, such as EIP155SignatureVerifier with a ContractVerifier.
+type ContextSignatureVerifier interface {
+	SignatureVerifier
+	// VerifySignatureContext is like VerifySignature with a context for network calls.
+	VerifySignatureContext(ctx context.Context, account AccountID, message, signature []byte) error
+}
+
+var (
+	signatureVerifiersMu sync.RWMutex
+	signatureVerifiers   = make(map[Namespace]SignatureVerifier)
+)
+
+func init() {
+	RegisterSignatureVerifier(&EIP155SignatureVerifier{})
+	RegisterSignatureVerifier(&solanaSIWXVerifier{})
+	RegisterSignatureVerifier(&bip122SignatureVerifier{})
+}
+
+// RegisterSignatureVerifier registers a signature verifier for a namespace,
+// replacing any verifier already registered for it.
+func RegisterSignatureVerifier(v SignatureVerifier) {
+	signatureVerifiersMu.Lock()
+	defer signatureVerifiersMu.Unlock()
+	signatureVerifiers[v.Namespace()] = v
+}
+
+// GetSignatureVerifier returns the signature verifier for a namespace.
+func GetSignatureVerifier(namespace Namespace) (SignatureVerifier, bool) {
+	signatureVerifiersMu.RLock()
+	defer signatureVerifiersMu.RUnlock()
+	v, ok := signatureVerifiers[namespace]
+	return v, ok
+}
+
+// VerifySignature verifies that signature over message was produced by account,
+// using the verifier registered for its namespace:
+//   - eip155: EIP-191 personal_sign, by public key recovery; EIP-1271 if a
+//     ContractVerifier is configured (see EIP155SignatureVerifier)
+//   - solana: ed25519 over the message bytes
+//   - bip122: Bitcoin signed message (BIP-137) for P2PKH, P2SH-P2WPKH and P2WPKH
+//
+// Returns nil if the signature is valid, an error wrapping ErrInvalidSignature
+// if not, and an error wrapping ErrInvalidNamespace if no verifier is registered.
+func VerifySignature(account AccountID, message, signature []byte) error {
+	return VerifySignatureContext(context.Background(), account, message, signature)
+}
+
+// VerifySignatureContext is like VerifySignature with a context for verifiers
+// that make network calls (see ContextSignatureVerifier).
+func VerifySignatureContext(ctx context.Context, account AccountID, message, signature []byte) error {
+	if account == nil || account.IsZero() {
+		return fmt.Errorf("%w: account is required", ErrEmptyValue)
+	}
+	v, ok := GetSignatureVerifier(account.Namespace())
+	if !ok {
+		return fmt.Errorf("%w: no signature verifier for namespace %q", ErrInvalidNamespace, account.Namespace())
+	}
+	if cv, ok := v.(ContextSignatureVerifier); ok {
+		return cv.VerifySignatureContext(ctx, account, message, signature)
+	}
+	return v.VerifySignature(account, message, signature)
+}
+
+// nativeAccount returns account as the native account type T. Accounts of
+// other types, such as a *GenericAccountID from WithoutNativeTypes or gob, are
+// parsed with the parser of their namespace first.
+func nativeAccount[T AccountID](account AccountID) (T, error) {
+	if a, ok := account.(T); ok {
+		return a, nil
+	}
+	var zero T
+	if account == nil || account.IsZero() {
+		return zero, fmt.Errorf("%w: expected %v, got %T", ErrInvalidNamespace, reflect.TypeFor[T](), account)
+	}
+	native, err := ParseWithNamespace(account.Namespace(), account.Reference(), account.Address())
+	if err != nil {
+		return zero, err
+	}
+	a, ok := native.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: expected %v, got %T", ErrInvalidNamespace, reflect.TypeFor[T](), account)
+	}
+	return a, nil
+}
@@ -0,0 +1,161 @@
+package caip10
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// bitcoinSignedMessageMagic is the message prefix of Bitcoin signed messages.
+const bitcoinSignedMessageMagic = "Bitcoin Signed Message:\n"
+
+// bip122SignedMessageMagic holds the message prefixes of networks that do not
+// use bitcoinSignedMessageMagic.
+var bip122SignedMessageMagic = map[BIP122Network]string{
+	LitecoinMainnet: "Litecoin Signed Message:\n",
+	LitecoinTestnet: "Litecoin Signed Message:\n",
+	DogecoinMainnet: "Dogecoin Signed Message:\n",
+	DogecoinTestnet: "Dogecoin Signed Message:\n",
+	DashMainnet:     "DarkCoin Signed Message:\n",
+	ZcashMainnet:    "Zcash Signed Message:\n",
+	ZcashTestnet:    "Zcash Signed Message:\n",
+}
+
+// BIP-137 signature header bytes: 27 + recovery id, plus 4 for a compressed
+// P2PKH key, 8 for P2SH-P2WPKH and 12 for P2WPKH.
+const (
+	bip137HeaderMin          = 27
+	bip137HeaderCompressed   = 31
+	bip137HeaderMax          = 42
+	bip137SignatureLength    = 65
+	bip137SignatureB64Length = 88
+)
+
+// bitcoinMessageHash returns the double SHA-256 digest signed by Bitcoin
+// signed messages: varstr(magic) || varstr(message).
+func bitcoinMessageHash(network BIP122Network, message []byte) []byte {
+	magic, ok := bip122SignedMessageMagic[network]
+	if !ok {
+		magic = bitcoinSignedMessageMagic
+	}
+	var buf bytes.Buffer
+	writeVarString(&buf, []byte(magic))
+	writeVarString(&buf, message)
+	first := sha256.Sum256(buf.Bytes())
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+// writeVarString writes a Bitcoin CompactSize length prefix followed by data.
+func writeVarString(buf *bytes.Buffer, data []byte) {
+	n := uint64(len(data))
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		buf.Write(binary.LittleEndian.AppendUint16(nil, uint16(n)))
+	case n <= 0xffffffff:
+		buf.WriteByte(0xfe)
+		buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(n)))
+	default:
+		buf.WriteByte(0xff)
+		buf.Write(binary.LittleEndian.AppendUint64(nil, n))
+	}
+	buf.Write(data)
+}
+
+// bip122SignatureVerifier verifies Bitcoin signed messages (BIP-137).
+type bip122SignatureVerifier struct{}
+
+func (v *bip122SignatureVerifier) Namespace() Namespace {
+	return NamespaceBIP122
+}
+
+// VerifySignature verifies a 65-byte BIP-137 signature, raw or base64 encoded
+// as produced by signmessage. The public key is recovered and checked against
+// the account's P2PKH, P2SH-P2WPKH or P2WPKH address. As with most wallets, a
+// signature made with a compressed P2PKH header is accepted for segwit
+// addresses of the same key.
+//
+// Taproot and P2WSH addresses need BIP-322 and are not supported.
+func (v *bip122SignatureVerifier) VerifySignature(account AccountID, message, signature []byte) error {
+	a, err := nativeAccount[BIP122AccountID](account)
+	if err != nil {
+		return err
+	}
+	if len(signature) == bip137SignatureB64Length {
+		if decoded, err := base64.StdEncoding.DecodeString(string(signature)); err == nil {
+			signature = decoded
+		}
+	}
+	if len(signature) != bip137SignatureLength {
+		return fmt.Errorf("%w: signature must be %d bytes, got %d",
+			ErrInvalidSignature, bip137SignatureLength, len(signature))
+	}
+	header := signature[0]
+	if header < bip137HeaderMin || header > bip137HeaderMax {
+		return fmt.Errorf("%w: invalid signature header %d", ErrInvalidSignature, header)
+	}
+
+	// decred compact format: <27 + recovery id (+4 if compressed)><R><S>
+	compressed := header >= bip137HeaderCompressed
+	compact := make([]byte, bip137SignatureLength)
+	compact[0] = bip137HeaderMin + (header-bip137HeaderMin)%4
+	if compressed {
+		compact[0] += 4
+	}
+	copy(compact[1:], signature[1:])
+	pub, _, err := ecdsa.RecoverCompact(compact, bitcoinMessageHash(a.Network(), message))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	pubkey := pub.SerializeUncompressed()
+	if compressed {
+		pubkey = pub.SerializeCompressed()
+	}
+
+	var want []byte
+	switch ScriptTypeOf(a) {
+	case ScriptP2PKH:
+		want = hash160(pubkey)
+	case ScriptP2SH:
+		// P2SH is only verifiable as P2SH-P2WPKH
+		want = hash160(append([]byte{0x00, 0x14}, hash160(pubkey)...))
+	case ScriptP2WPKH:
+		want = hash160(pubkey)
+	default:
+		return fmt.Errorf("%w: message signatures are not supported for %q addresses",
+			ErrInvalidSignature, ScriptTypeOf(a))
+	}
+	if ScriptTypeOf(a) != ScriptP2PKH && !compressed {
+		return fmt.Errorf("%w: segwit signatures require a compressed key", ErrInvalidSignature)
+	}
+	if got := bip122AddressHash(a); !bytes.Equal(got, want) {
+		return fmt.Errorf("%w: signature does not match %s", ErrInvalidSignature, a.Address())
+	}
+	return nil
+}
+
+// bip122AddressHash returns the hash160 or witness program encoded in a
+// classified address.
+func bip122AddressHash(a BIP122AccountID) []byte {
+	address := a.Address()
+	if ScriptTypeOf(a) == ScriptP2WPKH {
+		_, _, program, _ := DecodeSegWit(address)
+		return program
+	}
+	if a.Network() == BitcoinCashMainnet && !isLegacyBitcoinCashAddress(address) {
+		_, _, hash, _ := DecodeCashAddr(address)
+		return hash
+	}
+	data, err := decodeBase58Check(address)
+	if err != nil || len(data) < base58CheckHash160Length {
+		return nil
+	}
+	return data[len(data)-base58CheckHash160Length:]
+}
@@ -0,0 +1,328 @@
+package caip10
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// EIP-1271 standard signature validation for contracts.
+// https://eips.ethereum.org/EIPS/eip-1271
+
+// eip1271Selector is the isValidSignature(bytes32,bytes) selector, which is also
+// the magic value returned for valid signatures.
+var eip1271Selector = []byte{0x16, 0x26, 0xba, 0x7e}
+
+// ContractVerifier verifies signatures of smart-contract accounts, such as Safe
+// or Argent wallets, whose signatures are not recoverable.
+type ContractVerifier interface {
+	// VerifyContractSignature verifies signature over the 32-byte digest hash
+	// for the contract at account.
+	VerifyContractSignature(ctx context.Context, account EIP155AccountID, hash, signature []byte) error
+}
+
+// Ensure EIP1271Verifier implements ContractVerifier at compile time
+var _ ContractVerifier = (*EIP1271Verifier)(nil)
+
+// EIP1271Verifier is a ContractVerifier that calls isValidSignature on the
+// account contract.
+type EIP1271Verifier struct {
+	// Caller is used for accounts on chains without an entry in Callers.
+	Caller EthCaller
+	// Callers holds per-chain callers for multichain verification.
+	Callers map[ChainID]EthCaller
+}
+
+// NewEIP1271Verifier creates an EIP1271Verifier with a caller connected to the
+// chain of the accounts it verifies.
+func NewEIP1271Verifier(caller EthCaller) *EIP1271Verifier {
+	return &EIP1271Verifier{Caller: caller}
+}
+
+// caller returns the caller for a chain.
+func (v *EIP1271Verifier) caller(chainID ChainID) (EthCaller, error) {
+	if c, ok := v.Callers[chainID]; ok && c != nil {
+		return c, nil
+	}
+	if v.Caller == nil {
+		return nil, fmt.Errorf("%w: no eth_call client for chain %s", ErrInvalidReference, chainID)
+	}
+	return v.Caller, nil
+}
+
+// VerifyContractSignature calls isValidSignature(hash, signature) on the account
+// and checks that it returns the EIP-1271 magic value.
+func (v *EIP1271Verifier) VerifyContractSignature(ctx context.Context, account EIP155AccountID, hash, signature []byte) error {
+	if len(hash) != 32 {
+		return fmt.Errorf("%w: EIP-1271 hash must be 32 bytes, got %d", ErrInvalidSignature, len(hash))
+	}
+	caller, err := v.caller(account.ChainID())
+	if err != nil {
+		return err
+	}
+	out, err := caller.CallContract(ctx, account.Account(), eip1271CallData(hash, signature))
+	if err != nil {
+		return fmt.Errorf("%w: isValidSignature: %v", ErrInvalidSignature, err)
+	}
+	if len(out) < 32 || !bytes.Equal(out[:4], eip1271Selector) {
+		return fmt.Errorf("%w: isValidSignature rejected the signature for %s", ErrInvalidSignature, account)
+	}
+	return nil
+}
+
+// eip1271CallData encodes isValidSignature(bytes32 hash, bytes signature).
+func eip1271CallData(hash, signature []byte) []byte {
+	var offset [32]byte
+	offset[31] = 0x40
+	return ensCallData(eip1271Selector, hash, offset[:], abiEncodeBytesTail(signature))
+}
+
+// EIP-6492 signature validation for predeploy contracts.
+// https://eips.ethereum.org/EIPS/eip-6492
+
+// eip6492MagicSuffix ends EIP-6492 wrapped signatures.
+var eip6492MagicSuffix = bytes.Repeat([]byte{0x64, 0x92}, 16)
+
+// EIP6492Signature is an EIP-6492 wrapped signature of a counterfactual wallet:
+// the signature of the wallet together with the factory call that deploys it.
+type EIP6492Signature struct {
+	Factory         ecommon.Address
+	FactoryCalldata []byte
+	Signature       []byte
+}
+
+// IsEIP6492Signature reports whether signature ends with the EIP-6492 magic suffix.
+func IsEIP6492Signature(signature []byte) bool {
+	return bytes.HasSuffix(signature, eip6492MagicSuffix)
+}
+
+// ParseEIP6492Signature unwraps an EIP-6492 signature,
+// abi.encode(address factory, bytes factoryCalldata, bytes signature) || magic.
+func ParseEIP6492Signature(signature []byte) (*EIP6492Signature, error) {
+	if !IsEIP6492Signature(signature) {
+		return nil, fmt.Errorf("%w: missing EIP-6492 magic suffix", ErrInvalidSignature)
+	}
+	data := signature[:len(signature)-len(eip6492MagicSuffix)]
+	factory, err := abiAddress(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: EIP-6492 factory: %v", ErrInvalidSignature, err)
+	}
+	calldata, err := abiBytesAt(data, 1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: EIP-6492 factory calldata: %v", ErrInvalidSignature, err)
+	}
+	inner, err := abiBytesAt(data, 2)
+	if err != nil {
+		return nil, fmt.Errorf("%w: EIP-6492 signature: %v", ErrInvalidSignature, err)
+	}
+	return &EIP6492Signature{
+		Factory:         ecommon.BytesToAddress(factory),
+		FactoryCalldata: calldata,
+		Signature:       inner,
+	}, nil
+}
+
+// Bytes returns the wrapped signature.
+func (s *EIP6492Signature) Bytes() []byte {
+	var head [96]byte
+	copy(head[12:32], s.Factory[:])
+	head[63] = 0x60
+	binary.BigEndian.PutUint64(head[88:], uint64(96+abiBytesSize(s.FactoryCalldata)))
+	out := append(head[:], abiEncodeBytesTail(s.FactoryCalldata)...)
+	out = append(out, abiEncodeBytesTail(s.Signature)...)
+	return append(out, eip6492MagicSuffix...)
+}
+
+// abiBytesSize returns the size of the ABI encoded length and data of b.
+func abiBytesSize(b []byte) int {
+	return 32 + (len(b)+31)/32*32
+}
+
+// abiEncodeBytesTail returns the ABI encoded length and padded data of b.
+func abiEncodeBytesTail(b []byte) []byte {
+	out := make([]byte, abiBytesSize(b))
+	binary.BigEndian.PutUint64(out[24:32], uint64(len(b)))
+	copy(out[32:], b)
+	return out
+}
+
+// CounterfactualVerifier is implemented by ContractVerifiers that can verify
+// EIP-6492 signatures of wallets that are not deployed yet, for example by
+// simulating the factory call. Without it, a wrapped signature is unwrapped and
+// verified with VerifyContractSignature, which requires a deployed wallet.
+type CounterfactualVerifier interface {
+	VerifyCounterfactualSignature(ctx context.Context, account EIP155AccountID, hash []byte, signature *EIP6492Signature) error
+}
+
+// Ensure EIP1271Verifier implements CounterfactualVerifier at compile time
+var _ CounterfactualVerifier = (*EIP1271Verifier)(nil)
+
+// VerifyCounterfactualSignature verifies an EIP-6492 signature of account over
+// the 32-byte digest hash. If the caller of the chain implements EthCodeCaller,
+// a deployless eth_call deploys the wallet with the factory call when it has no
+// code yet and then calls isValidSignature on it. Otherwise the unwrapped
+// signature is verified with VerifyContractSignature.
+func (v *EIP1271Verifier) VerifyCounterfactualSignature(ctx context.Context, account EIP155AccountID, hash []byte, signature *EIP6492Signature) error {
+	if len(hash) != 32 {
+		return fmt.Errorf("%w: EIP-1271 hash must be 32 bytes, got %d", ErrInvalidSignature, len(hash))
+	}
+	caller, err := v.caller(account.ChainID())
+	if err != nil {
+		return err
+	}
+	codeCaller, ok := caller.(EthCodeCaller)
+	if !ok {
+		return v.VerifyContractSignature(ctx, account, hash, signature.Signature)
+	}
+	out, err := codeCaller.CallCode(ctx, eip6492ValidatorCode(account.Account(), hash, signature))
+	if err != nil {
+		return fmt.Errorf("%w: EIP-6492 validation: %v", ErrInvalidSignature, err)
+	}
+	if len(out) < 32 || !bytes.Equal(out[:4], eip1271Selector) {
+		return fmt.Errorf("%w: isValidSignature rejected the signature for %s", ErrInvalidSignature, account)
+	}
+	return nil
+}
+
+// eip6492ValidatorSize is the size of the code generated by
+// eip6492ValidatorCode, without the appended calldata.
+const eip6492ValidatorSize = 149
+
+// eip6492ValidatorCode returns creation code for a deployless eth_call that
+// calls the factory if account has no code, reverting if the factory call
+// fails, then calls isValidSignature(hash, signature) on account and returns
+// the first word of its result. The factory calldata and the isValidSignature
+// calldata are appended to the code and copied to memory before each call.
+func eip6492ValidatorCode(account ecommon.Address, hash []byte, s *EIP6492Signature) []byte {
+	check := eip1271CallData(hash, s.Signature)
+	factoryData := eip6492ValidatorSize
+	checkData := factoryData + len(s.FactoryCalldata)
+	// the result word is written past both calldatas, to zeroed memory
+	result := max(len(s.FactoryCalldata), len(check))
+
+	code := make([]byte, 0, checkData+len(check))
+	push1 := func(n byte) { code = append(code, 0x60, n) }
+	push4 := func(n int) { code = binary.BigEndian.AppendUint32(append(code, 0x63), uint32(n)) }
+	push20 := func(a ecommon.Address) { code = append(append(code, 0x73), a[:]...) }
+	var fail []int
+	jumpi := func() int {
+		code = append(code, 0x61, 0, 0, 0x57) // PUSH2 dest JUMPI
+		return len(code) - 3
+	}
+
+	// if extcodesize(account) == 0 { require(factory.call(factoryCalldata)) }
+	push20(account)
+	code = append(code, 0x3b) // EXTCODESIZE
+	deployed := jumpi()
+	push4(len(s.FactoryCalldata))
+	push4(factoryData)
+	push1(0)
+	code = append(code, 0x39) // CODECOPY
+	push1(0)
+	push1(0)
+	push4(len(s.FactoryCalldata))
+	push1(0)
+	push1(0)
+	push20(s.Factory)
+	code = append(code, 0x5a, 0xf1, 0x15) // GAS CALL ISZERO
+	fail = append(fail, jumpi())
+	binary.BigEndian.PutUint16(code[deployed:], uint16(len(code)))
+	code = append(code, 0x5b) // JUMPDEST
+
+	// require(account.staticcall(isValidSignature(hash, signature)))
+	push4(len(check))
+	push4(checkData)
+	push1(0)
+	code = append(code, 0x39) // CODECOPY
+	push1(32)
+	push4(result)
+	push4(len(check))
+	push1(0)
+	push20(account)
+	code = append(code, 0x5a, 0xfa, 0x15) // GAS STATICCALL ISZERO
+	fail = append(fail, jumpi())
+	push1(32)
+	push4(result)
+	code = append(code, 0xf3) // RETURN
+
+	for _, at := range fail {
+		binary.BigEndian.PutUint16(code[at:], uint16(len(code)))
+	}
+	code = append(code, 0x5b) // JUMPDEST
+	push1(0)
+	code = append(code, 0x80, 0xfd) // DUP1 REVERT
+
+	code = append(code, s.FactoryCalldata...)
+	return append(code, check...)
+}
+
+// Ensure EIP155SignatureVerifier implements ContextSignatureVerifier at compile time
+var _ ContextSignatureVerifier = (*EIP155SignatureVerifier)(nil)
+
+// EIP155SignatureVerifier verifies EIP-191 personal_sign signatures. If public
+// key recovery does not yield the account and Contract is set, the signature is
+// verified by the account contract over the EIP-191 digest instead. It is the
+// default eip155 verifier, without a Contract; register one with a Contract to
+// accept smart-contract wallets:
+//
+//	RegisterSignatureVerifier(&EIP155SignatureVerifier{
+//		Contract: NewEIP1271Verifier(NewEthJSONRPC(url)),
+//	})
+type EIP155SignatureVerifier struct {
+	Contract ContractVerifier
+}
+
+func (v *EIP155SignatureVerifier) Namespace() Namespace {
+	return NamespaceEIP155
+}
+
+// VerifySignature is VerifySignatureContext with a background context.
+func (v *EIP155SignatureVerifier) VerifySignature(account AccountID, message, signature []byte) error {
+	return v.VerifySignatureContext(context.Background(), account, message, signature)
+}
+
+// VerifySignatureContext verifies a personal_sign signature of account,
+// falling back to the Contract verifier. EIP-6492 wrapped signatures are
+// verified by the Contract verifier, with the deploy data if it implements
+// CounterfactualVerifier, falling back to public key recovery of the unwrapped
+// signature.
+func (v *EIP155SignatureVerifier) VerifySignatureContext(ctx context.Context, account AccountID, message, signature []byte) error {
+	a, err := nativeAccount[EIP155AccountID](account)
+	if err != nil {
+		return err
+	}
+	if IsEIP6492Signature(signature) {
+		wrapped, err := ParseEIP6492Signature(signature)
+		if err != nil {
+			return err
+		}
+		if err = v.verifyWrapped(ctx, a, message, wrapped); err == nil {
+			return nil
+		}
+		// EIP-6492 signatures of accounts without code are verified with ecrecover
+		if (&eip155SIWXVerifier{}).VerifySignature(a, message, wrapped.Signature) == nil {
+			return nil
+		}
+		return err
+	}
+
+	err = (&eip155SIWXVerifier{}).VerifySignature(a, message, signature)
+	if err == nil || v.Contract == nil {
+		return err
+	}
+	return v.Contract.VerifyContractSignature(ctx, a, eip191Hash(message), signature)
+}
+
+// verifyWrapped verifies an EIP-6492 signature with the Contract verifier.
+func (v *EIP155SignatureVerifier) verifyWrapped(ctx context.Context, a EIP155AccountID, message []byte, wrapped *EIP6492Signature) error {
+	if v.Contract == nil {
+		return fmt.Errorf("%w: EIP-6492 signatures require a contract verifier", ErrInvalidSignature)
+	}
+	if cv, ok := v.Contract.(CounterfactualVerifier); ok {
+		return cv.VerifyCounterfactualSignature(ctx, a, eip191Hash(message), wrapped)
+	}
+	return v.Contract.VerifyContractSignature(ctx, a, eip191Hash(message), wrapped.Signature)
+}
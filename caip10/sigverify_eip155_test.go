@@ -0,0 +1,202 @@
+package caip10
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEIP1271Selector(t *testing.T) {
+	assert.Equal(t, keccak256([]byte("isValidSignature(bytes32,bytes)"))[:4], eip1271Selector)
+}
+
+func TestEIP155SignatureVerifierContract(t *testing.T) {
+	safe := ecommon.HexToAddress("0x5aFE3855358E112B5647B952709E6165e1c1eEEe")
+	account := NewEIP155(1, safe)
+	message := []byte("Sign in to Example")
+	signature := []byte{0x01, 0x02, 0x03}
+
+	magic := make([]byte, 32)
+	copy(magic, eip1271Selector)
+	caller := &fakeEthCaller{}
+	caller.set(safe, eip1271CallData(eip191Hash(message), signature), magic)
+
+	v := &EIP155SignatureVerifier{Contract: NewEIP1271Verifier(caller)}
+	require.NoError(t, v.VerifySignature(account, message, signature))
+
+	// The contract rejects other messages
+	err := v.VerifySignature(account, []byte("other"), signature)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	// Without a contract verifier only recoverable signatures are accepted
+	err = (&EIP155SignatureVerifier{}).VerifySignature(account, message, signature)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	// Recoverable signatures do not call the contract
+	sig, _ := hex.DecodeString(siwxTestSignature)
+	eoa := MustParse("eip155:1:" + siwxTestAddress)
+	noCalls := &EIP155SignatureVerifier{Contract: &EIP1271Verifier{}}
+	require.NoError(t, noCalls.VerifySignatureContext(context.Background(), eoa, []byte(siwxTestMessage), sig))
+
+	// Callers are selected by chain
+	polygon := NewEIP155(137, safe)
+	err = noCalls.VerifySignature(polygon, message, signature)
+	assert.True(t, errors.Is(err, ErrInvalidReference), "got %v", err)
+	perChain := &EIP155SignatureVerifier{Contract: &EIP1271Verifier{Callers: map[ChainID]EthCaller{ChainIDPolygon: caller}}}
+	require.NoError(t, perChain.VerifySignature(polygon, message, signature))
+}
+
+func TestVerifySignatureContextContractWallet(t *testing.T) {
+	safe := ecommon.HexToAddress("0x5aFE3855358E112B5647B952709E6165e1c1eEEe")
+	message := []byte("hello")
+	signature := []byte{0xaa}
+	magic := make([]byte, 32)
+	copy(magic, eip1271Selector)
+	caller := &fakeEthCaller{}
+	caller.set(safe, eip1271CallData(eip191Hash(message), signature), magic)
+
+	RegisterSignatureVerifier(&EIP155SignatureVerifier{Contract: NewEIP1271Verifier(caller)})
+	defer RegisterSignatureVerifier(&EIP155SignatureVerifier{})
+
+	require.NoError(t, VerifySignatureContext(context.Background(), NewEIP155(1, safe), message, signature))
+}
+
+// fakeCounterfactualVerifier accepts EIP-6492 signatures deployed by factory.
+type fakeCounterfactualVerifier struct {
+	*EIP1271Verifier
+	factory ecommon.Address
+}
+
+func (f *fakeCounterfactualVerifier) VerifyCounterfactualSignature(_ context.Context, _ EIP155AccountID, _ []byte, s *EIP6492Signature) error {
+	if s.Factory != f.factory {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func TestEIP6492Signature(t *testing.T) {
+	s := &EIP6492Signature{
+		Factory:         ecommon.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"),
+		FactoryCalldata: []byte("deploy wallet with a calldata longer than one word"),
+		Signature:       []byte{0x01, 0x02, 0x03},
+	}
+	wrapped := s.Bytes()
+	assert.True(t, IsEIP6492Signature(wrapped))
+	assert.Equal(t, 0, (len(wrapped)-32)%32)
+	got, err := ParseEIP6492Signature(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	assert.False(t, IsEIP6492Signature(s.Signature))
+	_, err = ParseEIP6492Signature(s.Signature)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+	_, err = ParseEIP6492Signature(append(make([]byte, 32), eip6492MagicSuffix...))
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
+
+func TestEIP155SignatureVerifierEIP6492(t *testing.T) {
+	wallet := ecommon.HexToAddress("0x5aFE3855358E112B5647B952709E6165e1c1eEEe")
+	account := NewEIP155(1, wallet)
+	message := []byte("Sign in to Example")
+	s := &EIP6492Signature{
+		Factory:         ecommon.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"),
+		FactoryCalldata: []byte{0xde, 0xad},
+		Signature:       []byte{0x01, 0x02, 0x03},
+	}
+
+	// Deployed wallets verify the unwrapped signature with EIP-1271
+	magic := make([]byte, 32)
+	copy(magic, eip1271Selector)
+	caller := &fakeEthCaller{}
+	caller.set(wallet, eip1271CallData(eip191Hash(message), s.Signature), magic)
+	deployed := &EIP155SignatureVerifier{Contract: NewEIP1271Verifier(caller)}
+	require.NoError(t, deployed.VerifySignature(account, message, s.Bytes()))
+
+	// Counterfactual verifiers receive the deploy data
+	counterfactual := &EIP155SignatureVerifier{Contract: &fakeCounterfactualVerifier{factory: s.Factory}}
+	require.NoError(t, counterfactual.VerifySignature(account, message, s.Bytes()))
+	other := *s
+	other.Factory = wallet
+	err := counterfactual.VerifySignature(account, message, other.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	err = (&EIP155SignatureVerifier{}).VerifySignature(account, message, s.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
+
+// fakeEthCodeCaller simulates deployless calls of EIP-6492 validator code: the
+// wallet deployed with calldata by factory accepts signature over hash.
+type fakeEthCodeCaller struct {
+	fakeEthCaller
+	wallet, factory ecommon.Address
+	calldata        []byte
+	hash, signature []byte
+}
+
+func (f *fakeEthCodeCaller) CallCode(_ context.Context, code []byte) ([]byte, error) {
+	if len(code) < eip6492ValidatorSize {
+		return nil, errors.New("invalid opcode")
+	}
+	program, data := code[:eip6492ValidatorSize], code[eip6492ValidatorSize:]
+	if !bytes.Contains(program, f.wallet[:]) || !bytes.Contains(program, f.factory[:]) || !bytes.HasPrefix(data, f.calldata) {
+		return nil, errors.New("execution reverted")
+	}
+	out := make([]byte, 32)
+	if bytes.Equal(data[len(f.calldata):], eip1271CallData(f.hash, f.signature)) {
+		copy(out, eip1271Selector)
+	}
+	return out, nil
+}
+
+func TestEIP1271VerifierCounterfactual(t *testing.T) {
+	wallet := ecommon.HexToAddress("0x5aFE3855358E112B5647B952709E6165e1c1eEEe")
+	account := NewEIP155(1, wallet)
+	message := []byte("Sign in to Example")
+	s := &EIP6492Signature{
+		Factory:         ecommon.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"),
+		FactoryCalldata: []byte("deploy wallet with a calldata longer than one word"),
+		Signature:       []byte{0x01, 0x02, 0x03},
+	}
+	code := eip6492ValidatorCode(wallet, eip191Hash(message), s)
+	assert.Len(t, code, eip6492ValidatorSize+len(s.FactoryCalldata)+len(eip1271CallData(eip191Hash(message), s.Signature)))
+
+	// The wallet is not deployed, so isValidSignature is only answered by the
+	// deployless call
+	caller := &fakeEthCodeCaller{wallet: wallet, factory: s.Factory, calldata: s.FactoryCalldata, hash: eip191Hash(message), signature: s.Signature}
+	v := &EIP155SignatureVerifier{Contract: NewEIP1271Verifier(caller)}
+	require.NoError(t, v.VerifySignature(account, message, s.Bytes()))
+
+	err := v.VerifySignature(account, []byte("other"), s.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+	other := *s
+	other.Factory = wallet
+	err = v.VerifySignature(account, message, other.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+	err = NewEIP1271Verifier(caller).VerifyCounterfactualSignature(context.Background(), account, []byte{0x01}, s)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
+
+func TestEIP155SignatureVerifierEIP6492Recover(t *testing.T) {
+	sig, _ := hex.DecodeString(siwxTestSignature)
+	eoa := MustParse("eip155:1:" + siwxTestAddress)
+	s := &EIP6492Signature{
+		Factory:         ecommon.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"),
+		FactoryCalldata: []byte{0xde, 0xad},
+		Signature:       sig,
+	}
+
+	// Wrapped signatures of accounts without code are verified with ecrecover,
+	// with or without a contract verifier
+	require.NoError(t, (&EIP155SignatureVerifier{}).VerifySignature(eoa, []byte(siwxTestMessage), s.Bytes()))
+	v := &EIP155SignatureVerifier{Contract: NewEIP1271Verifier(&fakeEthCaller{})}
+	require.NoError(t, v.VerifySignature(eoa, []byte(siwxTestMessage), s.Bytes()))
+
+	err := v.VerifySignature(eoa, []byte("other"), s.Bytes())
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
@@ -0,0 +1,128 @@
+package caip10
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	sig, _ := hex.DecodeString(siwxTestSignature)
+	eth := MustParse("eip155:1:" + siwxTestAddress)
+	require.NoError(t, VerifySignature(eth, []byte(siwxTestMessage), sig))
+	err := VerifySignature(eth, []byte("other message"), sig)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	priv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	sol, err := SolanaFromEd25519(SolanaMainnet, priv.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+	require.NoError(t, VerifySignature(sol, []byte("hello"), ed25519.Sign(priv, []byte("hello"))))
+
+	// Generic accounts are verified as their native type
+	generic, err := Parse(eth.String(), WithoutNativeTypes())
+	require.NoError(t, err)
+	require.IsType(t, &GenericAccountID{}, generic)
+	require.NoError(t, VerifySignature(generic, []byte(siwxTestMessage), sig))
+	require.NoError(t, VerifySignature(AccountOf(sol).Generic(), []byte("hello"), ed25519.Sign(priv, []byte("hello"))))
+	err = VerifySignature(newGenericUnchecked(NamespaceEIP155, "1", "0x12"), []byte(siwxTestMessage), sig)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	err = VerifySignature(MustNewGeneric("cosmos", "cosmoshub-4", "cosmos1t2uflqwqe0fsj0shcfkrvpukewcw40yjj6hdc0"), nil, nil)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+	err = VerifySignature(nil, nil, nil)
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
+
+// signBitcoinMessage signs message as signmessage does, with header offset
+// 0 for uncompressed P2PKH, 4 for compressed P2PKH, 8 for P2SH-P2WPKH and 12
+// for P2WPKH.
+func signBitcoinMessage(key *secp256k1.PrivateKey, network BIP122Network, message string, offset byte) []byte {
+	sig := ecdsa.SignCompact(key, bitcoinMessageHash(network, []byte(message)), offset != 0)
+	sig[0] = bip137HeaderMin + (sig[0]-bip137HeaderMin)%4 + offset
+	return sig
+}
+
+func TestVerifyBitcoinSignedMessage(t *testing.T) {
+	key := secp256k1.PrivKeyFromBytes(mustHex(t, "0000000000000000000000000000000000000000000000000000000000000001"))
+	compressed := key.PubKey().SerializeCompressed()
+	const message = "Sign in to Example"
+
+	tests := []struct {
+		name       string
+		network    BIP122Network
+		pubkey     []byte
+		scriptType ScriptType
+		offset     byte
+	}{
+		{"p2pkh uncompressed", BitcoinMainnet, key.PubKey().SerializeUncompressed(), ScriptP2PKH, 0},
+		{"p2pkh compressed", BitcoinMainnet, compressed, ScriptP2PKH, 4},
+		{"p2sh-p2wpkh", BitcoinMainnet, compressed, ScriptP2SHP2WPKH, 8},
+		{"p2wpkh", BitcoinMainnet, compressed, ScriptP2WPKH, 12},
+		{"p2wpkh with p2pkh header", BitcoinMainnet, compressed, ScriptP2WPKH, 4},
+		{"litecoin p2wpkh", LitecoinMainnet, compressed, ScriptP2WPKH, 12},
+		{"dogecoin p2pkh", DogecoinMainnet, compressed, ScriptP2PKH, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := BIP122FromPubkey(tt.network, tt.pubkey, tt.scriptType)
+			require.NoError(t, err)
+			sig := signBitcoinMessage(key, tt.network, message, tt.offset)
+			require.NoError(t, VerifySignature(account, []byte(message), sig))
+
+			// base64 as printed by signmessage
+			b64 := []byte(base64.StdEncoding.EncodeToString(sig))
+			require.NoError(t, VerifySignature(account, []byte(message), b64))
+
+			require.NoError(t, VerifySignature(AccountOf(account).Generic(), []byte(message), sig))
+
+			err = VerifySignature(account, []byte(message+"!"), sig)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+		})
+	}
+
+	// Bitcoin Cash CashAddr addresses use the Bitcoin message prefix
+	p2pkh, err := BIP122FromPubkey(BitcoinMainnet, compressed, ScriptP2PKH)
+	require.NoError(t, err)
+	cash, err := BitcoinCashToCashAddr(p2pkh.Address())
+	require.NoError(t, err)
+	bch := NewBIP122(BitcoinCashMainnet, cash)
+	require.NoError(t, VerifySignature(bch, []byte(message), signBitcoinMessage(key, BitcoinMainnet, message, 4)))
+
+	// A network's signatures do not verify on another
+	ltc, err := BIP122FromPubkey(LitecoinMainnet, compressed, ScriptP2WPKH)
+	require.NoError(t, err)
+	err = VerifySignature(ltc, []byte(message), signBitcoinMessage(key, BitcoinMainnet, message, 12))
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	// Legacy Litecoin P2SH addresses share the Bitcoin version byte
+	btcP2SH, err := BIP122FromPubkey(BitcoinMainnet, compressed, ScriptP2SHP2WPKH)
+	require.NoError(t, err)
+	ltcP2SH := NewBIP122(LitecoinMainnet, btcP2SH.Address())
+	require.NoError(t, ltcP2SH.Validate())
+	require.NoError(t, VerifySignature(ltcP2SH, []byte(message), signBitcoinMessage(key, LitecoinMainnet, message, 8)))
+
+	// Segwit addresses need a compressed key
+	segwit, err := BIP122FromPubkey(BitcoinMainnet, compressed, ScriptP2WPKH)
+	require.NoError(t, err)
+	err = VerifySignature(segwit, []byte(message), signBitcoinMessage(key, BitcoinMainnet, message, 0))
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	taproot, err := BIP122FromPubkey(BitcoinMainnet, compressed, ScriptP2TR)
+	require.NoError(t, err)
+	err = VerifySignature(taproot, []byte(message), signBitcoinMessage(key, BitcoinMainnet, message, 4))
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	bad := signBitcoinMessage(key, BitcoinMainnet, message, 4)
+	bad[0] = 43
+	err = VerifySignature(p2pkh, []byte(message), bad)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+	err = VerifySignature(p2pkh, []byte(message), bad[:64])
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
@@ -0,0 +1,404 @@
+package caip10
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SIWXMessage is a CAIP-122 Sign-In-With-X message.
+// https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-122.md
+type SIWXMessage struct {
+	// Domain is the RFC 3986 authority requesting the signing.
+	Domain string
+	// Account is the account signing in; its chain reference is used as "Chain ID".
+	Account AccountID
+	// Statement is an optional human readable assertion without newlines.
+	Statement string
+	// URI is the RFC 3986 URI referring to the resource that is the subject of the signing.
+	URI string
+	// Version is the message version, "1" if empty.
+	Version string
+	// Nonce is a randomized token of at least 8 alphanumeric characters.
+	Nonce string
+	// IssuedAt is the time the message was generated.
+	IssuedAt time.Time
+	// ExpirationTime is when the signed message expires; zero if absent.
+	ExpirationTime time.Time
+	// NotBefore is when the signed message becomes valid; zero if absent.
+	NotBefore time.Time
+	// RequestID is an optional system-specific identifier.
+	RequestID string
+	// Resources is an optional list of URIs the user wishes to have resolved.
+	Resources []string
+}
+
+// siwxMinNonceLength is the minimum nonce length required by CAIP-122.
+const siwxMinNonceLength = 8
+
+// siwxDefaultVersion is the message version used when Version is empty.
+const siwxDefaultVersion = "1"
+
+// Message header and field tags
+const (
+	siwxHeaderSuffix  = " wants you to sign in with your "
+	siwxHeaderAccount = " account:"
+
+	siwxTagURI            = "URI: "
+	siwxTagVersion        = "Version: "
+	siwxTagChainID        = "Chain ID: "
+	siwxTagNonce          = "Nonce: "
+	siwxTagIssuedAt       = "Issued At: "
+	siwxTagExpirationTime = "Expiration Time: "
+	siwxTagNotBefore      = "Not Before: "
+	siwxTagRequestID      = "Request ID: "
+	siwxTagResources      = "Resources:"
+	siwxResourcePrefix    = "- "
+)
+
+// NewSIWXMessage creates a CAIP-122 message for the account and domain,
+// issued now, with URI "https://<domain>" and Version "1".
+func NewSIWXMessage(account AccountID, domain, nonce string, resources ...string) *SIWXMessage {
+	return &SIWXMessage{
+		Domain:    domain,
+		Account:   account,
+		URI:       "https://" + domain,
+		Version:   siwxDefaultVersion,
+		Nonce:     nonce,
+		IssuedAt:  time.Now().UTC(),
+		Resources: resources,
+	}
+}
+
+// Validate checks the message fields required by CAIP-122.
+func (m *SIWXMessage) Validate() error {
+	if m == nil {
+		return ErrEmptyValue
+	}
+	if m.Domain == "" || strings.ContainsAny(m.Domain, " \n") {
+		return fmt.Errorf("%w: invalid SIWX domain %q", ErrInvalidFormat, m.Domain)
+	}
+	if m.Account == nil || m.Account.IsZero() {
+		return fmt.Errorf("%w: SIWX account is required", ErrInvalidFormat)
+	}
+	if strings.Contains(m.Statement, "\n") {
+		return fmt.Errorf("%w: SIWX statement must not contain newlines", ErrInvalidFormat)
+	}
+	if m.URI == "" {
+		return fmt.Errorf("%w: SIWX URI is required", ErrInvalidFormat)
+	}
+	if len(m.Nonce) < siwxMinNonceLength || !isAlphanumeric(m.Nonce) {
+		return fmt.Errorf("%w: SIWX nonce must be at least %d alphanumeric characters", ErrInvalidFormat, siwxMinNonceLength)
+	}
+	if m.IssuedAt.IsZero() {
+		return fmt.Errorf("%w: SIWX issued-at is required", ErrInvalidFormat)
+	}
+	for _, r := range m.Resources {
+		if r == "" || strings.Contains(r, "\n") {
+			return fmt.Errorf("%w: invalid SIWX resource %q", ErrInvalidFormat, r)
+		}
+	}
+	return nil
+}
+
+// String returns the CAIP-122 message text to be signed.
+func (m *SIWXMessage) String() string {
+	if m == nil || m.Account == nil {
+		return ""
+	}
+	version := m.Version
+	if version == "" {
+		version = siwxDefaultVersion
+	}
+
+	var sb strings.Builder
+	sb.WriteString(m.Domain + siwxHeaderSuffix + siwxChainName(m.Account.Namespace()) + siwxHeaderAccount + "\n")
+	sb.WriteString(m.Account.Address() + "\n\n")
+	if m.Statement != "" {
+		sb.WriteString(m.Statement + "\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(siwxTagURI + m.URI + "\n")
+	sb.WriteString(siwxTagVersion + version + "\n")
+	sb.WriteString(siwxTagChainID + m.Account.Reference() + "\n")
+	sb.WriteString(siwxTagNonce + m.Nonce + "\n")
+	sb.WriteString(siwxTagIssuedAt + m.IssuedAt.Format(time.RFC3339Nano))
+	if !m.ExpirationTime.IsZero() {
+		sb.WriteString("\n" + siwxTagExpirationTime + m.ExpirationTime.Format(time.RFC3339Nano))
+	}
+	if !m.NotBefore.IsZero() {
+		sb.WriteString("\n" + siwxTagNotBefore + m.NotBefore.Format(time.RFC3339Nano))
+	}
+	if m.RequestID != "" {
+		sb.WriteString("\n" + siwxTagRequestID + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		sb.WriteString("\n" + siwxTagResources)
+		for _, r := range m.Resources {
+			sb.WriteString("\n" + siwxResourcePrefix + r)
+		}
+	}
+	return sb.String()
+}
+
+// CheckTime reports whether the message is valid at the given time,
+// based on ExpirationTime and NotBefore.
+func (m *SIWXMessage) CheckTime(now time.Time) error {
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return fmt.Errorf("%w: expired at %s", ErrMessageExpired, m.ExpirationTime.Format(time.RFC3339))
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return fmt.Errorf("%w: valid from %s", ErrMessageNotYetValid, m.NotBefore.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ParseSIWXMessage parses a CAIP-122 message text. The chain name in the
+// header selects the namespace (see RegisterSIWXVerifier); unknown chain
+// names are used as the namespace directly.
+func ParseSIWXMessage(s string) (*SIWXMessage, error) {
+	lines := strings.Split(s, "\n")
+	if len(lines) < 9 {
+		return nil, fmt.Errorf("%w: SIWX message too short", ErrInvalidFormat)
+	}
+
+	m := &SIWXMessage{}
+	header := lines[0]
+	i := strings.Index(header, siwxHeaderSuffix)
+	if i < 1 || !strings.HasSuffix(header, siwxHeaderAccount) {
+		return nil, fmt.Errorf("%w: invalid SIWX header %q", ErrInvalidFormat, header)
+	}
+	m.Domain = header[:i]
+	chainName := header[i+len(siwxHeaderSuffix) : len(header)-len(siwxHeaderAccount)]
+	address := lines[1]
+	if lines[2] != "" {
+		return nil, fmt.Errorf("%w: expected empty line after SIWX address", ErrInvalidFormat)
+	}
+
+	n := 3
+	if lines[n] != "" {
+		m.Statement = lines[n]
+		n++
+		if lines[n] != "" {
+			return nil, fmt.Errorf("%w: expected empty line after SIWX statement", ErrInvalidFormat)
+		}
+	}
+	n++
+
+	// field returns the value of the tagged line at n, advancing n if present.
+	field := func(tag string, required bool) (string, error) {
+		if n < len(lines) && strings.HasPrefix(lines[n], tag) {
+			n++
+			return lines[n-1][len(tag):], nil
+		}
+		if required {
+			return "", fmt.Errorf("%w: missing SIWX field %q", ErrInvalidFormat, strings.TrimSuffix(tag, ": "))
+		}
+		return "", nil
+	}
+	timeField := func(tag string, required bool) (time.Time, error) {
+		v, err := field(tag, required)
+		if err != nil || v == "" {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid SIWX time %q", ErrInvalidFormat, v)
+		}
+		return t, nil
+	}
+
+	var chainID string
+	var err error
+	if m.URI, err = field(siwxTagURI, true); err != nil {
+		return nil, err
+	}
+	if m.Version, err = field(siwxTagVersion, true); err != nil {
+		return nil, err
+	}
+	if chainID, err = field(siwxTagChainID, true); err != nil {
+		return nil, err
+	}
+	if m.Nonce, err = field(siwxTagNonce, true); err != nil {
+		return nil, err
+	}
+	if m.IssuedAt, err = timeField(siwxTagIssuedAt, true); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = timeField(siwxTagExpirationTime, false); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = timeField(siwxTagNotBefore, false); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = field(siwxTagRequestID, false); err != nil {
+		return nil, err
+	}
+	if n < len(lines) && lines[n] == siwxTagResources {
+		for n++; n < len(lines) && strings.HasPrefix(lines[n], siwxResourcePrefix); n++ {
+			m.Resources = append(m.Resources, lines[n][len(siwxResourcePrefix):])
+		}
+	}
+	if n != len(lines) {
+		return nil, fmt.Errorf("%w: unexpected SIWX line %q", ErrInvalidFormat, lines[n])
+	}
+
+	m.Account, err = ParseWithNamespace(siwxNamespace(chainName), chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SIWXVerifyOptions are the values a signed message is checked against by
+// VerifySIWXWithOptions.
+type SIWXVerifyOptions struct {
+	// Nonce is the nonce the server issued for this sign-in. Required.
+	Nonce string
+	// Domain is the expected domain; not checked if empty.
+	Domain string
+	// URI is the expected URI; not checked if empty.
+	URI string
+	// Account is the expected signer and chain; not checked if nil.
+	Account AccountID
+	// Time is checked against the validity window; time.Now() if zero.
+	Time time.Time
+}
+
+// VerifySIWXWithOptions parses a signed CAIP-122 message, applies the message
+// checks of its namespace profile (see SIWXMessageChecker), checks it against
+// opts and its validity window, and verifies the signature with the namespace
+// verifier. It returns the authenticated AccountID.
+func VerifySIWXWithOptions(message string, signature []byte, opts SIWXVerifyOptions) (AccountID, error) {
+	return verifySIWX(message, signature, opts, "")
+}
+
+// verifySIWX implements VerifySIWXWithOptions. A non-empty ns restricts the
+// message to accounts of that namespace.
+func verifySIWX(message string, signature []byte, opts SIWXVerifyOptions, ns Namespace) (AccountID, error) {
+	if opts.Nonce == "" {
+		return nil, fmt.Errorf("%w: SIWX verification requires the expected nonce", ErrEmptyValue)
+	}
+	m, err := parseSIWXProfile(message, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Nonce != opts.Nonce {
+		return nil, fmt.Errorf("%w: SIWX nonce %q, expected %q", ErrMessageMismatch, m.Nonce, opts.Nonce)
+	}
+	if opts.Domain != "" && m.Domain != opts.Domain {
+		return nil, fmt.Errorf("%w: SIWX domain %q, expected %q", ErrMessageMismatch, m.Domain, opts.Domain)
+	}
+	if opts.URI != "" && m.URI != opts.URI {
+		return nil, fmt.Errorf("%w: SIWX URI %q, expected %q", ErrMessageMismatch, m.URI, opts.URI)
+	}
+	if opts.Account != nil && !m.Account.Equal(opts.Account) {
+		return nil, fmt.Errorf("%w: SIWX account %s, expected %s", ErrMessageMismatch, m.Account, opts.Account)
+	}
+	now := opts.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if err := m.CheckTime(now); err != nil {
+		return nil, err
+	}
+	v, ok := GetSIWXVerifier(m.Account.Namespace())
+	if !ok {
+		return nil, fmt.Errorf("%w: no SIWX verifier for namespace %q", ErrInvalidNamespace, m.Account.Namespace())
+	}
+	if err := v.VerifySignature(m.Account, []byte(message), signature); err != nil {
+		return nil, err
+	}
+	return m.Account, nil
+}
+
+// parseSIWXProfile parses a message and applies the checks of its namespace
+// profile. A non-empty ns restricts the message to accounts of that namespace.
+func parseSIWXProfile(s string, ns Namespace) (*SIWXMessage, error) {
+	m, err := ParseSIWXMessage(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != "" && m.Account.Namespace() != ns {
+		return nil, fmt.Errorf("%w: message must sign in with a %s account, got %s",
+			ErrInvalidNamespace, siwxChainName(ns), m.Account.Namespace())
+	}
+	v, _ := GetSIWXVerifier(m.Account.Namespace())
+	if c, ok := v.(SIWXMessageChecker); ok {
+		if err := c.CheckMessage(s, m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// SIWXMessageChecker is implemented by SIWX verifiers whose namespace profile
+// restricts messages beyond CAIP-122, such as EIP-4361 for eip155.
+type SIWXMessageChecker interface {
+	// CheckMessage checks m, parsed from the message text s.
+	CheckMessage(s string, m *SIWXMessage) error
+}
+
+// SIWXVerifier verifies CAIP-122 signatures for a namespace.
+type SIWXVerifier interface {
+	SignatureVerifier
+	// ChainName is the name used in the message header, e.g. "Ethereum".
+	ChainName() string
+}
+
+// siwxVerifiers holds namespace-specific SIWX verifiers
+var (
+	siwxVerifiersMu sync.RWMutex
+	siwxVerifiers   = make(map[Namespace]SIWXVerifier)
+)
+
+// RegisterSIWXVerifier registers a SIWX verifier for a namespace, replacing any
+// verifier previously registered for it. It is safe for concurrent use.
+func RegisterSIWXVerifier(v SIWXVerifier) {
+	siwxVerifiersMu.Lock()
+	defer siwxVerifiersMu.Unlock()
+	siwxVerifiers[v.Namespace()] = v
+}
+
+// GetSIWXVerifier returns the SIWX verifier for a namespace.
+func GetSIWXVerifier(namespace Namespace) (SIWXVerifier, bool) {
+	siwxVerifiersMu.RLock()
+	defer siwxVerifiersMu.RUnlock()
+	v, ok := siwxVerifiers[namespace]
+	return v, ok
+}
+
+// siwxChainName returns the header chain name of a namespace.
+func siwxChainName(ns Namespace) string {
+	if v, ok := GetSIWXVerifier(ns); ok {
+		return v.ChainName()
+	}
+	return string(ns)
+}
+
+// siwxNamespace returns the namespace of a header chain name.
+func siwxNamespace(chainName string) Namespace {
+	siwxVerifiersMu.RLock()
+	defer siwxVerifiersMu.RUnlock()
+	for ns, v := range siwxVerifiers {
+		if v.ChainName() == chainName {
+			return ns
+		}
+	}
+	return Namespace(chainName)
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package caip10
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/donutnomad/eths/ecommon"
+	"golang.org/x/crypto/sha3"
+)
+
+// eip191SignatureLength is the length of an r || s || v signature.
+const eip191SignatureLength = 65
+
+func init() {
+	RegisterSIWXVerifier(&eip155SIWXVerifier{})
+}
+
+// eip191Hash returns keccak256("\x19Ethereum Signed Message:\n" + len(message) + message),
+// the digest signed by personal_sign.
+func eip191Hash(message []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte("\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))))
+	h.Write(message)
+	return h.Sum(nil)
+}
+
+// recoverEIP191Address recovers the signer address of a personal_sign signature.
+// The signature is r || s || v with v in {0, 1, 27, 28}.
+func recoverEIP191Address(message, signature []byte) (ecommon.Address, error) {
+	if len(signature) != eip191SignatureLength {
+		return ecommon.Address{}, fmt.Errorf("%w: signature must be %d bytes, got %d",
+			ErrInvalidSignature, eip191SignatureLength, len(signature))
+	}
+	v := signature[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return ecommon.Address{}, fmt.Errorf("%w: invalid recovery id %d", ErrInvalidSignature, signature[64])
+	}
+
+	// decred compact format: <27 + recovery id><R><S>
+	compact := make([]byte, eip191SignatureLength)
+	compact[0] = 27 + v
+	copy(compact[1:], signature[:64])
+	pub, _, err := ecdsa.RecoverCompact(compact, eip191Hash(message))
+	if err != nil {
+		return ecommon.Address{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(pub.SerializeUncompressed()[1:])
+	return ecommon.BytesToAddress(h.Sum(nil)[12:]), nil
+}
+
+// eip155SIWXVerifier verifies EIP-191 personal_sign signatures of externally owned accounts.
+type eip155SIWXVerifier struct{}
+
+func (v *eip155SIWXVerifier) Namespace() Namespace {
+	return NamespaceEIP155
+}
+
+func (v *eip155SIWXVerifier) ChainName() string {
+	return "Ethereum"
+}
+
+func (v *eip155SIWXVerifier) VerifySignature(account AccountID, message, signature []byte) error {
+	a, err := nativeAccount[EIP155AccountID](account)
+	if err != nil {
+		return err
+	}
+	signer, err := recoverEIP191Address(message, signature)
+	if err != nil {
+		return err
+	}
+	want := a.Account()
+	if !bytes.Equal(signer[:], want[:]) {
+		return fmt.Errorf("%w: signed by %s, not %s", ErrInvalidSignature, signer.Hex(), want.Hex())
+	}
+	return nil
+}
+
+// CheckMessage applies the EIP-4361 restrictions: the address must be EIP-55
+// checksummed and the version must be "1".
+func (v *eip155SIWXVerifier) CheckMessage(s string, m *SIWXMessage) error {
+	a, err := nativeAccount[EIP155AccountID](m.Account)
+	if err != nil {
+		return err
+	}
+	// ParseSIWXMessage has checked there are at least two lines.
+	address := strings.SplitN(s, "\n", 3)[1]
+	if want := a.Account().Hex(); address != want {
+		return fmt.Errorf("%w: SIWE address must be EIP-55 checksummed as %s", ErrInvalidChecksum, want)
+	}
+	if m.Version != siwxDefaultVersion {
+		return fmt.Errorf("%w: unsupported SIWE version %q", ErrInvalidFormat, m.Version)
+	}
+	return nil
+}
+
+// EIP-4361 Sign-In-With-Ethereum is the eip155 profile of CAIP-122.
+// https://eips.ethereum.org/EIPS/eip-4361
+
+// NewSIWEMessage creates an EIP-4361 message for an Ethereum account, issued now,
+// with URI "https://<domain>" and Version "1".
+func NewSIWEMessage(account EIP155AccountID, domain, nonce string, resources ...string) *SIWXMessage {
+	return NewSIWXMessage(account, domain, nonce, resources...)
+}
+
+// ParseSIWEMessage parses an EIP-4361 message. Beyond the CAIP-122 checks of
+// ParseSIWXMessage, the header must name Ethereum, the address must be EIP-55
+// checksummed and the version must be "1". The Account of the returned message
+// is an EIP155AccountID.
+func ParseSIWEMessage(s string) (*SIWXMessage, error) {
+	return parseSIWXProfile(s, NamespaceEIP155)
+}
+
+// VerifySIWE is like VerifySIWXWithOptions for EIP-4361 messages. It verifies
+// the personal_sign signature and returns the authenticated account.
+//
+// Smart contract wallets, whose signatures are not recoverable, are not supported.
+func VerifySIWE(message string, signature []byte, opts SIWXVerifyOptions) (EIP155AccountID, error) {
+	a, err := verifySIWX(message, signature, opts, NamespaceEIP155)
+	if err != nil {
+		return nil, err
+	}
+	return a.(EIP155AccountID), nil
+}
@@ -0,0 +1,143 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Signature of siwxTestMessage produced by go-ethereum personal_sign.
+const siwxTestSignature = "9df245c1ae99f92921b2277a839b7889803a2cbf29a2e4445e3dbd51c702309f" +
+	"4b114d6d177f2d9f0eb9abfe08d90020c47ee3438afffd98cb841bf37f11df711b"
+
+func TestVerifySIWXEIP155(t *testing.T) {
+	sig, _ := hex.DecodeString(siwxTestSignature)
+
+	account, err := VerifySIWXWithOptions(siwxTestMessage, sig, siwxTestOptions)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1:"+siwxTestAddress, account.String())
+
+	// v as recovery id 0/1 is accepted as well
+	sig[64] -= 27
+	_, err = VerifySIWXWithOptions(siwxTestMessage, sig, siwxTestOptions)
+	assert.NoError(t, err)
+}
+
+func TestVerifySIWXEIP155Invalid(t *testing.T) {
+	sig, _ := hex.DecodeString(siwxTestSignature)
+
+	// Message signed by a different account
+	other := siwxTestMessageStruct(t)
+	other.Account = MustParse("eip155:1:0xB9C5714089478a327F09197987f16f9E5d936E8a")
+	_, err := VerifySIWXWithOptions(other.String(), sig, siwxTestOptions)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	// Tampered signature
+	bad := append([]byte{}, sig...)
+	bad[64] = 29
+	_, err = VerifySIWXWithOptions(siwxTestMessage, bad, siwxTestOptions)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	_, err = VerifySIWXWithOptions(siwxTestMessage, sig[:64], siwxTestOptions)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	// Expired message
+	expired := siwxTestMessageStruct(t)
+	expired.ExpirationTime = expired.IssuedAt.Add(time.Hour)
+	_, err = VerifySIWXWithOptions(expired.String(), sig, siwxTestOptions)
+	assert.True(t, errors.Is(err, ErrMessageExpired), "got %v", err)
+}
+
+func TestEIP155SIWXVerifierWrongType(t *testing.T) {
+	v, ok := GetSIWXVerifier(NamespaceEIP155)
+	require.True(t, ok)
+	assert.Equal(t, "Ethereum", v.ChainName())
+
+	err := v.VerifySignature(MustNewGeneric("cosmos", "cosmoshub-3", "addr"), nil, nil)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace))
+
+	// Generic eip155 accounts are verified as their native type
+	sig, _ := hex.DecodeString(siwxTestSignature)
+	generic, err := Parse("eip155:1:"+siwxTestAddress, WithoutNativeTypes())
+	require.NoError(t, err)
+	require.IsType(t, &GenericAccountID{}, generic)
+	assert.NoError(t, v.VerifySignature(generic, []byte(siwxTestMessage), sig))
+	m := siwxTestMessageStruct(t)
+	m.Account = generic
+	assert.NoError(t, v.(SIWXMessageChecker).CheckMessage(siwxTestMessage, m))
+}
+
+func TestNewSIWEMessage(t *testing.T) {
+	account := NewEIP155(1, ecommon.HexToAddress(siwxTestAddress))
+	m := NewSIWEMessage(account, "example.com", "32891756",
+		"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+		"https://example.com/my-web2-claim.json")
+	m.Statement = "Sign in to Example"
+	m.URI = "https://example.com/login"
+	m.IssuedAt = time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)
+	assert.Equal(t, siwxTestMessage, m.String())
+
+	parsed, err := ParseSIWEMessage(siwxTestMessage)
+	require.NoError(t, err)
+	assert.True(t, parsed.Account.Equal(account))
+}
+
+func TestParseSIWEMessageInvalid(t *testing.T) {
+	lower := strings.Replace(siwxTestMessage, siwxTestAddress, strings.ToLower(siwxTestAddress), 1)
+	_, err := ParseSIWEMessage(lower)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum), "got %v", err)
+
+	v2 := strings.Replace(siwxTestMessage, "Version: 1", "Version: 2", 1)
+	_, err = ParseSIWEMessage(v2)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+
+	sol := siwxTestMessageStruct(t)
+	sol.Account = MustParse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	_, err = ParseSIWEMessage(sol.String())
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestVerifySIWE(t *testing.T) {
+	sig, _ := hex.DecodeString(siwxTestSignature)
+	account := NewEIP155(1, ecommon.HexToAddress(siwxTestAddress))
+
+	got, err := VerifySIWE(siwxTestMessage, sig, SIWXVerifyOptions{
+		Nonce:   "32891756",
+		Domain:  "example.com",
+		Account: account,
+	})
+	require.NoError(t, err)
+	assert.True(t, got.Equal(account))
+
+	tests := []struct {
+		name    string
+		opts    SIWXVerifyOptions
+		wantErr error
+	}{
+		{"missing nonce", SIWXVerifyOptions{}, ErrEmptyValue},
+		{"wrong nonce", SIWXVerifyOptions{Nonce: "00000000"}, ErrMessageMismatch},
+		{"wrong domain", SIWXVerifyOptions{Nonce: "32891756", Domain: "evil.com"}, ErrMessageMismatch},
+		{"wrong uri", SIWXVerifyOptions{Nonce: "32891756", URI: "https://evil.com/login"}, ErrMessageMismatch},
+		{"wrong chain", SIWXVerifyOptions{Nonce: "32891756", Account: NewEIP155(137, account.Account())}, ErrMessageMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifySIWE(siwxTestMessage, sig, tt.opts)
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+
+	// Validity window is checked against opts.Time
+	expired := siwxTestMessageStruct(t)
+	expired.ExpirationTime = expired.IssuedAt.Add(time.Hour)
+	_, err = VerifySIWE(expired.String(), sig, SIWXVerifyOptions{Nonce: "32891756", Time: expired.IssuedAt})
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+	_, err = VerifySIWE(expired.String(), sig, SIWXVerifyOptions{Nonce: "32891756"})
+	assert.True(t, errors.Is(err, ErrMessageExpired), "got %v", err)
+}
@@ -0,0 +1,60 @@
+package caip10
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+func init() {
+	RegisterSIWXVerifier(&solanaSIWXVerifier{})
+}
+
+// solanaSIWXVerifier verifies ed25519 signatures over the raw message bytes.
+type solanaSIWXVerifier struct{}
+
+func (v *solanaSIWXVerifier) Namespace() Namespace {
+	return NamespaceSolana
+}
+
+func (v *solanaSIWXVerifier) ChainName() string {
+	return "Solana"
+}
+
+func (v *solanaSIWXVerifier) VerifySignature(account AccountID, message, signature []byte) error {
+	a, err := nativeAccount[SolanaAccountID](account)
+	if err != nil {
+		return err
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: signature must be %d bytes, got %d",
+			ErrInvalidSignature, ed25519.SignatureSize, len(signature))
+	}
+	pubkey := a.Account()
+	if !ed25519.Verify(pubkey[:], message, signature) {
+		return fmt.Errorf("%w: ed25519 verification failed", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// NewSIWSMessage creates a Sign-In-With-Solana message for a Solana account,
+// issued now, with URI "https://<domain>" and Version "1".
+func NewSIWSMessage(account SolanaAccountID, domain, nonce string, resources ...string) *SIWXMessage {
+	return NewSIWXMessage(account, domain, nonce, resources...)
+}
+
+// ParseSIWSMessage parses a Sign-In-With-Solana message. The header must name
+// Solana; the Account of the returned message is a SolanaAccountID.
+func ParseSIWSMessage(s string) (*SIWXMessage, error) {
+	return parseSIWXProfile(s, NamespaceSolana)
+}
+
+// VerifySIWS is like VerifySIWXWithOptions for Sign-In-With-Solana messages. It
+// verifies the ed25519 signature over the message bytes and returns the
+// authenticated account.
+func VerifySIWS(message string, signature []byte, opts SIWXVerifyOptions) (SolanaAccountID, error) {
+	a, err := verifySIWX(message, signature, opts, NamespaceSolana)
+	if err != nil {
+		return nil, err
+	}
+	return a.(SolanaAccountID), nil
+}
@@ -0,0 +1,77 @@
+package caip10
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySIWXSolana(t *testing.T) {
+	priv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	address := base58.Encode(priv.Public().(ed25519.PublicKey))
+
+	account, err := Parse("solana:" + string(SolanaMainnet) + ":" + address)
+	require.NoError(t, err)
+	m := NewSIWXMessage(account, "example.com", "abcdef123456")
+	m.IssuedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	msg := m.String()
+	assert.Contains(t, msg, "wants you to sign in with your Solana account:\n"+address+"\n")
+
+	opts := SIWXVerifyOptions{Nonce: "abcdef123456", Domain: "example.com"}
+	sig := ed25519.Sign(priv, []byte(msg))
+	got, err := VerifySIWXWithOptions(msg, sig, opts)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(account))
+
+	sig[0] ^= 0xff
+	_, err = VerifySIWXWithOptions(msg, sig, opts)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	_, err = VerifySIWXWithOptions(msg, sig[:10], opts)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+}
+
+func TestVerifySIWS(t *testing.T) {
+	priv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	account, err := SolanaFromEd25519(SolanaMainnet, priv.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+
+	m := NewSIWSMessage(account, "example.com", "abcdef123456")
+	m.IssuedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.ExpirationTime = m.IssuedAt.Add(time.Hour)
+	msg := m.String()
+	sig := ed25519.Sign(priv, []byte(msg))
+
+	parsed, err := ParseSIWSMessage(msg)
+	require.NoError(t, err)
+	assert.True(t, parsed.Account.Equal(account))
+
+	opts := SIWXVerifyOptions{Nonce: "abcdef123456", Domain: "example.com", Account: account, Time: m.IssuedAt}
+	got, err := VerifySIWS(msg, sig, opts)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(account))
+
+	// The same options verify through the namespace-keyed path
+	generic, err := VerifySIWXWithOptions(msg, sig, opts)
+	require.NoError(t, err)
+	assert.True(t, generic.Equal(account))
+
+	_, err = VerifySIWS(msg, sig, SIWXVerifyOptions{Nonce: "abcdef123457", Time: m.IssuedAt})
+	assert.True(t, errors.Is(err, ErrMessageMismatch), "got %v", err)
+	_, err = VerifySIWS(msg, sig, SIWXVerifyOptions{Nonce: "abcdef123456", Time: m.ExpirationTime})
+	assert.True(t, errors.Is(err, ErrMessageExpired), "got %v", err)
+
+	devnet, err := SolanaFromEd25519(SolanaDevnet, priv.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+	_, err = VerifySIWS(msg, sig, SIWXVerifyOptions{Nonce: "abcdef123456", Account: devnet, Time: m.IssuedAt})
+	assert.True(t, errors.Is(err, ErrMessageMismatch), "got %v", err)
+
+	// SIWE messages are rejected
+	_, err = ParseSIWSMessage(siwxTestMessage)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
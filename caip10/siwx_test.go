@@ -0,0 +1,188 @@
+package caip10
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const siwxTestAddress = "0x0Fd31AEE0116306A025c13a1Da2a6FaE64b3f782"
+
+const siwxTestMessage = "example.com wants you to sign in with your Ethereum account:\n" +
+	siwxTestAddress + "\n" +
+	"\n" +
+	"Sign in to Example\n" +
+	"\n" +
+	"URI: https://example.com/login\n" +
+	"Version: 1\n" +
+	"Chain ID: 1\n" +
+	"Nonce: 32891756\n" +
+	"Issued At: 2021-09-30T16:25:24Z\n" +
+	"Resources:\n" +
+	"- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/\n" +
+	"- https://example.com/my-web2-claim.json"
+
+// siwxTestOptions are the verify options matching siwxTestMessage.
+var siwxTestOptions = SIWXVerifyOptions{Nonce: "32891756", Domain: "example.com", URI: "https://example.com/login"}
+
+func siwxTestMessageStruct(t *testing.T) *SIWXMessage {
+	account, err := Parse("eip155:1:" + siwxTestAddress)
+	require.NoError(t, err)
+	m := NewSIWXMessage(account, "example.com", "32891756",
+		"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+		"https://example.com/my-web2-claim.json")
+	m.Statement = "Sign in to Example"
+	m.URI = "https://example.com/login"
+	m.IssuedAt = time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)
+	return m
+}
+
+func TestSIWXMessageString(t *testing.T) {
+	m := siwxTestMessageStruct(t)
+	require.NoError(t, m.Validate())
+	assert.Equal(t, siwxTestMessage, m.String())
+}
+
+func TestSIWXMessageStringOptionalFields(t *testing.T) {
+	m := siwxTestMessageStruct(t)
+	m.Statement = ""
+	m.Resources = nil
+	m.ExpirationTime = m.IssuedAt.Add(time.Hour)
+	m.NotBefore = m.IssuedAt
+	m.RequestID = "req-1"
+
+	want := "example.com wants you to sign in with your Ethereum account:\n" +
+		siwxTestAddress + "\n" +
+		"\n" +
+		"\n" +
+		"URI: https://example.com/login\n" +
+		"Version: 1\n" +
+		"Chain ID: 1\n" +
+		"Nonce: 32891756\n" +
+		"Issued At: 2021-09-30T16:25:24Z\n" +
+		"Expiration Time: 2021-09-30T17:25:24Z\n" +
+		"Not Before: 2021-09-30T16:25:24Z\n" +
+		"Request ID: req-1"
+	assert.Equal(t, want, m.String())
+
+	parsed, err := ParseSIWXMessage(want)
+	require.NoError(t, err)
+	assert.Equal(t, want, parsed.String())
+	assert.Equal(t, "req-1", parsed.RequestID)
+	assert.True(t, parsed.ExpirationTime.Equal(m.ExpirationTime))
+}
+
+func TestParseSIWXMessage(t *testing.T) {
+	m, err := ParseSIWXMessage(siwxTestMessage)
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", m.Domain)
+	assert.Equal(t, "Sign in to Example", m.Statement)
+	assert.Equal(t, "https://example.com/login", m.URI)
+	assert.Equal(t, "1", m.Version)
+	assert.Equal(t, "32891756", m.Nonce)
+	assert.Len(t, m.Resources, 2)
+	assert.Equal(t, "eip155:1:"+siwxTestAddress, m.Account.String())
+
+	_, ok := m.Account.(EIP155AccountID)
+	assert.True(t, ok, "expected EIP155AccountID, got %T", m.Account)
+	assert.Equal(t, siwxTestMessage, m.String())
+}
+
+func TestParseSIWXMessageUnknownChain(t *testing.T) {
+	msg := strings.Replace(siwxTestMessage, "Ethereum account", "bip122 account", 1)
+	msg = strings.Replace(msg, siwxTestAddress, "128Lkh3S7CkDTBZ8W7BbpsN3YYizJMp8p6", 1)
+	msg = strings.Replace(msg, "Chain ID: 1", "Chain ID: 000000000019d6689c085ae165831e93", 1)
+
+	m, err := ParseSIWXMessage(msg)
+	require.NoError(t, err)
+	assert.Equal(t, NamespaceBIP122, m.Account.Namespace())
+
+	_, err = VerifySIWXWithOptions(msg, make([]byte, 65), siwxTestOptions)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestParseSIWXMessageInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		errType error
+	}{
+		{"empty", "", ErrInvalidFormat},
+		{"bad header", strings.Replace(siwxTestMessage, "wants you to sign in", "asks you to log in", 1), ErrInvalidFormat},
+		{"missing uri", strings.Replace(siwxTestMessage, "URI: https://example.com/login\n", "", 1), ErrInvalidFormat},
+		{"short nonce", strings.Replace(siwxTestMessage, "Nonce: 32891756", "Nonce: 1234", 1), ErrInvalidFormat},
+		{"bad time", strings.Replace(siwxTestMessage, "2021-09-30T16:25:24Z", "yesterday", 1), ErrInvalidFormat},
+		{"trailing line", siwxTestMessage + "\nextra", ErrInvalidFormat},
+		{"bad chain id", strings.Replace(siwxTestMessage, "Chain ID: 1", "Chain ID: mainnet", 1), ErrInvalidReference},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSIWXMessage(tt.message)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestSIWXMessageValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *SIWXMessage)
+	}{
+		{"empty domain", func(m *SIWXMessage) { m.Domain = "" }},
+		{"nil account", func(m *SIWXMessage) { m.Account = nil }},
+		{"multiline statement", func(m *SIWXMessage) { m.Statement = "a\nb" }},
+		{"empty uri", func(m *SIWXMessage) { m.URI = "" }},
+		{"non alphanumeric nonce", func(m *SIWXMessage) { m.Nonce = "1234-5678" }},
+		{"zero issued at", func(m *SIWXMessage) { m.IssuedAt = time.Time{} }},
+		{"empty resource", func(m *SIWXMessage) { m.Resources = []string{""} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siwxTestMessageStruct(t)
+			tt.modify(m)
+			assert.True(t, errors.Is(m.Validate(), ErrInvalidFormat))
+		})
+	}
+
+	var nilMessage *SIWXMessage
+	assert.True(t, errors.Is(nilMessage.Validate(), ErrEmptyValue))
+	assert.Equal(t, "", nilMessage.String())
+}
+
+func TestSIWXMessageCheckTime(t *testing.T) {
+	m := siwxTestMessageStruct(t)
+	m.NotBefore = m.IssuedAt.Add(time.Minute)
+	m.ExpirationTime = m.IssuedAt.Add(time.Hour)
+
+	assert.True(t, errors.Is(m.CheckTime(m.IssuedAt), ErrMessageNotYetValid))
+	assert.NoError(t, m.CheckTime(m.IssuedAt.Add(30*time.Minute)))
+	assert.True(t, errors.Is(m.CheckTime(m.ExpirationTime), ErrMessageExpired))
+}
+
+func TestRegisterSIWXVerifierConcurrent(t *testing.T) {
+	v, ok := GetSIWXVerifier(NamespaceEIP155)
+	require.True(t, ok)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterSIWXVerifier(v)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = ParseSIWXMessage(siwxTestMessage)
+			_, _ = GetSIWXVerifier(NamespaceSolana)
+		}()
+	}
+	wg.Wait()
+}
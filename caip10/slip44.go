@@ -0,0 +1,210 @@
+package caip10
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// AssetNamespaceSLIP44 identifies native chain coins by their SLIP-44 coin type.
+// https://github.com/ChainAgnostic/namespaces/blob/main/slip44/caip19.md
+const AssetNamespaceSLIP44 AssetNamespace = "slip44"
+
+// SLIP44CoinType is a coin type registered in SLIP-44.
+// https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+type SLIP44CoinType uint32
+
+// maxSLIP44CoinType is the largest coin type; the hardened bit is not part of the value.
+const maxSLIP44CoinType = 1<<31 - 1
+
+// Common SLIP-44 coin types
+const (
+	SLIP44Bitcoin     SLIP44CoinType = 0
+	SLIP44Testnet     SLIP44CoinType = 1 // shared by all testnets
+	SLIP44Litecoin    SLIP44CoinType = 2
+	SLIP44Dogecoin    SLIP44CoinType = 3
+	SLIP44Dash        SLIP44CoinType = 5
+	SLIP44Ethereum    SLIP44CoinType = 60
+	SLIP44Cosmos      SLIP44CoinType = 118
+	SLIP44BitcoinCash SLIP44CoinType = 145
+	SLIP44Solana      SLIP44CoinType = 501
+	SLIP44Gnosis      SLIP44CoinType = 700
+	SLIP44BNB         SLIP44CoinType = 714
+	SLIP44Polygon     SLIP44CoinType = 966
+	SLIP44Fantom      SLIP44CoinType = 1007
+	SLIP44Avalanche   SLIP44CoinType = 9000
+	SLIP44Celo        SLIP44CoinType = 52752
+)
+
+// String returns the decimal coin type.
+func (c SLIP44CoinType) String() string {
+	return strconv.FormatUint(uint64(c), 10)
+}
+
+// namespaceCoinTypes maps a CAIP namespace to the coin type of its native asset,
+// used when a chain has no entry in chainCoinTypes.
+var namespaceCoinTypes = map[Namespace]SLIP44CoinType{
+	NamespaceEIP155: SLIP44Ethereum,
+	NamespaceSolana: SLIP44Solana,
+	"cosmos":        SLIP44Cosmos,
+}
+
+// chainCoinTypes maps chains whose native asset differs from the namespace default.
+var chainCoinTypes = map[ChainID]SLIP44CoinType{
+	// EVM chains with their own native coin
+	ChainIDBSC:        SLIP44BNB,
+	ChainIDBSCTestnet: SLIP44BNB,
+	ChainIDOpBNB:      SLIP44BNB,
+	ChainIDPolygon:    SLIP44Polygon,
+	ChainIDAvalanche:  SLIP44Avalanche,
+	ChainIDFantom:     SLIP44Fantom,
+	ChainIDGnosis:     SLIP44Gnosis,
+	ChainIDCelo:       SLIP44Celo,
+
+	// BIP122 chains, where every network has its own coin
+	ChainIDBitcoinMainnet:                    SLIP44Bitcoin,
+	ChainIDBitcoinTestnet:                    SLIP44Testnet,
+	MustNewBIP122ChainID(BitcoinCashMainnet): SLIP44BitcoinCash,
+	MustNewBIP122ChainID(LitecoinMainnet):    SLIP44Litecoin,
+	MustNewBIP122ChainID(LitecoinTestnet):    SLIP44Testnet,
+	MustNewBIP122ChainID(DogecoinMainnet):    SLIP44Dogecoin,
+	MustNewBIP122ChainID(DogecoinTestnet):    SLIP44Testnet,
+	MustNewBIP122ChainID(DashMainnet):        SLIP44Dash,
+}
+
+// coinTypeChains maps a coin type to the mainnet chain whose native coin it is.
+var coinTypeChains = map[SLIP44CoinType]ChainID{
+	SLIP44Bitcoin:     ChainIDBitcoinMainnet,
+	SLIP44Litecoin:    MustNewBIP122ChainID(LitecoinMainnet),
+	SLIP44Dogecoin:    MustNewBIP122ChainID(DogecoinMainnet),
+	SLIP44Dash:        MustNewBIP122ChainID(DashMainnet),
+	SLIP44Ethereum:    ChainIDEthereumMainnet,
+	SLIP44Cosmos:      {Namespace: "cosmos", Reference: "cosmoshub-4"},
+	SLIP44BitcoinCash: MustNewBIP122ChainID(BitcoinCashMainnet),
+	SLIP44Solana:      ChainIDSolanaMainnet,
+	SLIP44Gnosis:      ChainIDGnosis,
+	SLIP44BNB:         ChainIDBSC,
+	SLIP44Polygon:     ChainIDPolygon,
+	SLIP44Fantom:      ChainIDFantom,
+	SLIP44Avalanche:   ChainIDAvalanche,
+	SLIP44Celo:        ChainIDCelo,
+}
+
+// CoinTypeFor returns the SLIP-44 coin type of a chain's native coin, e.g. 60 for
+// eip155:1 and 1 for Bitcoin testnet. Chains without their own entry use the
+// default of their namespace, see CoinTypeForNamespace.
+func CoinTypeFor(chainID ChainID) (SLIP44CoinType, bool) {
+	if coinType, ok := chainCoinTypes[chainID]; ok {
+		return coinType, true
+	}
+	return CoinTypeForNamespace(chainID.Namespace)
+}
+
+// CoinTypeForNamespace returns the default SLIP-44 coin type of a namespace, e.g.
+// 60 for eip155. Namespaces such as bip122, where every chain has its own coin,
+// have no default.
+func CoinTypeForNamespace(ns Namespace) (SLIP44CoinType, bool) {
+	coinType, ok := namespaceCoinTypes[ns]
+	return coinType, ok
+}
+
+// ChainForCoinType returns the mainnet chain whose native coin has the coin type,
+// e.g. eip155:1 for 60. The shared testnet coin type 1 has no chain.
+func ChainForCoinType(coinType SLIP44CoinType) (ChainID, bool) {
+	chainID, ok := coinTypeChains[coinType]
+	return chainID, ok
+}
+
+// NativeAssetFor returns the canonical SLIP-44 asset ID of a chain's native coin,
+// e.g. eip155:1/slip44:60 for Ethereum mainnet.
+func NativeAssetFor(chainID ChainID) (SLIP44AssetID, error) {
+	if err := validateAssetChainID(chainID); err != nil {
+		return nil, err
+	}
+	coinType, ok := CoinTypeFor(chainID)
+	if !ok {
+		return nil, fmt.Errorf("%w: no known native asset for chain %q", ErrInvalidNamespace, chainID)
+	}
+	return NewSLIP44Asset(chainID, coinType), nil
+}
+
+// SLIP44AssetID is the interface for native coin asset IDs.
+type SLIP44AssetID interface {
+	AssetID
+	// CoinType returns the SLIP-44 coin type.
+	CoinType() SLIP44CoinType
+}
+
+// Ensure slip44AssetID implements SLIP44AssetID at compile time
+var _ SLIP44AssetID = (*slip44AssetID)(nil)
+
+func init() {
+	RegisterAssetParser(&slip44Parser{})
+}
+
+// slip44AssetID represents a native coin asset ID per CAIP-19.
+type slip44AssetID struct {
+	*GenericAssetID // embedded, inherits all serialization methods
+	coinType        SLIP44CoinType
+}
+
+// NewSLIP44Asset creates a new SLIP44AssetID.
+func NewSLIP44Asset(chainID ChainID, coinType SLIP44CoinType) SLIP44AssetID {
+	return &slip44AssetID{
+		GenericAssetID: newGenericAssetUnchecked(chainID, AssetNamespaceSLIP44, coinType.String(), ""),
+		coinType:       coinType,
+	}
+}
+
+// CoinType returns the SLIP-44 coin type.
+func (a *slip44AssetID) CoinType() SLIP44CoinType {
+	if a == nil {
+		return 0
+	}
+	return a.coinType
+}
+
+// IsZero reports whether the AssetID is the zero value.
+func (a *slip44AssetID) IsZero() bool {
+	return a == nil || a.GenericAssetID == nil || a.GenericAssetID.IsZero()
+}
+
+// Equal reports whether two AssetIDs are equal.
+func (a *slip44AssetID) Equal(other AssetID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAssetID.Equal(other)
+}
+
+// --- slip44Parser ---
+
+type slip44Parser struct{}
+
+// Namespace returns "" because slip44 applies to every chain namespace.
+func (p *slip44Parser) Namespace() Namespace {
+	return ""
+}
+
+func (p *slip44Parser) AssetNamespace() AssetNamespace {
+	return AssetNamespaceSLIP44
+}
+
+func (p *slip44Parser) ParseAsset(chainID ChainID, reference, tokenID string) (AssetID, error) {
+	if err := validateAssetChainID(chainID); err != nil {
+		return nil, err
+	}
+	coinType, err := strconv.ParseUint(reference, 10, 32)
+	if err != nil || coinType > maxSLIP44CoinType {
+		return nil, fmt.Errorf("%w: invalid slip44 coin type %q", ErrInvalidAssetReference, reference)
+	}
+	if strconv.FormatUint(coinType, 10) != reference {
+		return nil, fmt.Errorf("%w: slip44 coin type must not have leading zeros, got %q", ErrInvalidAssetReference, reference)
+	}
+	if tokenID != "" {
+		return nil, fmt.Errorf("%w: slip44 assets have no token id, got %q", ErrInvalidTokenID, tokenID)
+	}
+	return NewSLIP44Asset(chainID, SLIP44CoinType(coinType)), nil
+}
@@ -0,0 +1,125 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLIP44AssetParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		coinType SLIP44CoinType
+	}{
+		{"eip155:1/slip44:60", SLIP44Ethereum},
+		{"bip122:000000000019d6689c085ae165831e93/slip44:0", SLIP44Bitcoin},
+		{"cosmos:cosmoshub-3/slip44:118", SLIP44Cosmos},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/slip44:501", SLIP44Solana},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			a, err := ParseAssetID(tt.input)
+			require.NoError(t, err)
+
+			native, ok := a.(SLIP44AssetID)
+			require.True(t, ok, "expected SLIP44AssetID, got %T", a)
+			assert.Equal(t, tt.coinType, native.CoinType())
+			assert.Equal(t, AssetNamespaceSLIP44, native.AssetNamespace())
+			assert.Equal(t, tt.input, native.String())
+		})
+	}
+}
+
+func TestSLIP44AssetParseInvalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		errType error
+	}{
+		{"eip155:1/slip44:eth", ErrInvalidAssetReference},
+		{"eip155:1/slip44:060", ErrInvalidAssetReference},
+		{"eip155:1/slip44:-1", ErrInvalidAssetReference},
+		{"eip155:1/slip44:2147483648", ErrInvalidAssetReference},
+		{"eip155:1/slip44:60/1", ErrInvalidTokenID},
+		{"bip122:invalid/slip44:0", ErrInvalidReference},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseAssetID(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestNativeAssetFor(t *testing.T) {
+	tests := []struct {
+		chainID ChainID
+		want    string
+	}{
+		{ChainIDEthereumMainnet, "eip155:1/slip44:60"},
+		{ChainIDArbitrumOne, "eip155:42161/slip44:60"},
+		{ChainIDBSC, "eip155:56/slip44:714"},
+		{ChainIDPolygon, "eip155:137/slip44:966"},
+		{ChainIDSolanaMainnet, "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/slip44:501"},
+		{ChainIDBitcoinMainnet, "bip122:000000000019d6689c085ae165831e93/slip44:0"},
+		{ChainIDBitcoinTestnet, "bip122:000000000933ea01ad0ee984209779ba/slip44:1"},
+		{MustNewBIP122ChainID(LitecoinMainnet), "bip122:12a765e31ffd4059bada1e25190f6e98/slip44:2"},
+		{ChainID{Namespace: "cosmos", Reference: "cosmoshub-4"}, "cosmos:cosmoshub-4/slip44:118"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.chainID.String(), func(t *testing.T) {
+			a, err := NativeAssetFor(tt.chainID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.String())
+		})
+	}
+
+	// Unknown BIP122 fork has no namespace-wide default
+	_, err := NativeAssetFor(MustNewBIP122ChainID("00000000000000000000000000000000"))
+	assert.Error(t, err)
+
+	_, err = NativeAssetFor(ChainID{})
+	assert.Error(t, err)
+}
+
+func TestCoinTypeMapping(t *testing.T) {
+	coinType, ok := CoinTypeFor(ChainIDBase)
+	assert.True(t, ok)
+	assert.Equal(t, SLIP44Ethereum, coinType)
+	coinType, ok = CoinTypeFor(ChainIDBitcoinTestnet)
+	assert.True(t, ok)
+	assert.Equal(t, SLIP44Testnet, coinType)
+	_, ok = CoinTypeFor(MustNewBIP122ChainID("00000000000000000000000000000000"))
+	assert.False(t, ok)
+
+	coinType, ok = CoinTypeForNamespace(NamespaceSolana)
+	assert.True(t, ok)
+	assert.Equal(t, SLIP44Solana, coinType)
+	_, ok = CoinTypeForNamespace(NamespaceBIP122)
+	assert.False(t, ok)
+
+	chainID, ok := ChainForCoinType(60)
+	assert.True(t, ok)
+	assert.Equal(t, ChainIDEthereumMainnet, chainID)
+	_, ok = ChainForCoinType(SLIP44Testnet)
+	assert.False(t, ok)
+
+	// Every mapped chain maps back to its coin type
+	for want, chainID := range coinTypeChains {
+		got, ok := CoinTypeFor(chainID)
+		assert.True(t, ok, chainID.String())
+		assert.Equal(t, want, got, chainID.String())
+	}
+}
+
+func TestSLIP44NilReceiver(t *testing.T) {
+	var a *slip44AssetID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, SLIP44CoinType(0), a.CoinType())
+	assert.True(t, a.Equal(nil))
+}
@@ -0,0 +1,53 @@
+package caip10
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. The account is logged as a group with
+// namespace, reference and address attributes; use LogShort to truncate the
+// address. A zero account logs as an empty string.
+func (a *GenericAccountID) LogValue() slog.Value {
+	return accountLogValue(a, false)
+}
+
+// LogValue implements slog.LogValuer. The chain ID is logged as a group with
+// namespace and reference attributes; a zero chain ID logs as an empty string.
+func (c ChainID) LogValue() slog.Value {
+	if c.IsZero() {
+		return slog.StringValue("")
+	}
+	return slog.GroupValue(
+		slog.String("namespace", string(c.Namespace)),
+		slog.String("reference", c.Reference),
+	)
+}
+
+// LogShort returns a slog.LogValuer that logs the account like its LogValue
+// method with the address truncated as by Short:
+//
+//	logger.Info("withdrawal", "to", caip10.LogShort(account))
+func LogShort(a AccountID) slog.LogValuer {
+	return shortLogValuer{a}
+}
+
+type shortLogValuer struct {
+	account AccountID
+}
+
+func (v shortLogValuer) LogValue() slog.Value {
+	return accountLogValue(v.account, true)
+}
+
+func accountLogValue(a AccountID, short bool) slog.Value {
+	if a == nil || a.IsZero() {
+		return slog.StringValue("")
+	}
+	address := a.Address()
+	if short {
+		address = shortenAddress(a.Namespace(), address)
+	}
+	return slog.GroupValue(
+		slog.String("namespace", string(a.Namespace())),
+		slog.String("reference", a.Reference()),
+		slog.String("address", address),
+	)
+}
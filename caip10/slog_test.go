@@ -0,0 +1,36 @@
+package caip10
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	eth := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	logger.Info("transfer", "from", eth, "to", LogShort(eth), "chain", ChainIDPolygon)
+	assert.JSONEq(t, `{
+		"level": "INFO",
+		"msg": "transfer",
+		"from": {"namespace": "eip155", "reference": "1", "address": "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		"to": {"namespace": "eip155", "reference": "1", "address": "0xab16…fcdb"},
+		"chain": {"namespace": "eip155", "reference": "137"}
+	}`, buf.String())
+
+	buf.Reset()
+	var zero *GenericAccountID
+	logger.Info("empty", "account", zero, "chain", ChainID{}, "short", LogShort(nil))
+	assert.JSONEq(t, `{"level": "INFO", "msg": "empty", "account": "", "chain": "", "short": ""}`, buf.String())
+}
@@ -0,0 +1,173 @@
+package caip10
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// SNS (Solana Name Service) .sol domain resolution.
+// https://docs.sns.id
+
+// SNS program and account addresses
+var (
+	SNSNameProgramID       = web3.MustPublicKey("namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX")
+	SNSRootDomain          = web3.MustPublicKey("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx") // .sol TLD
+	SNSReverseLookupClass  = web3.MustPublicKey("33m47vH6Eav6jJ5tNs7oAyyh1ms8RbpUjJ4zUxgx3Bpt")
+	SNSNameOffersProgramID = web3.MustPublicKey("85iDfUvr3HJyLM2zcq5BGSBdGGSNSJzsMfj9ywuSZFgt")
+)
+
+const (
+	snsHashPrefix = "SPL Name Service"
+	snsTLD        = ".sol"
+
+	// snsHeaderLength is the NameRegistryState header: parent, owner and class keys.
+	snsHeaderLength = 96
+)
+
+// snsNameAccountKey derives the name account address from the hashed name, class and parent.
+func snsNameAccountKey(name string, class, parent web3.PublicKey) (web3.PublicKey, error) {
+	hashed := sha256.Sum256([]byte(snsHashPrefix + name))
+	key, _, err := web3.FindProgramAddress([][]byte{hashed[:], class.Bytes(), parent.Bytes()}, SNSNameProgramID)
+	return key, err
+}
+
+// SNSDomainKey derives the name account of a .sol domain or subdomain,
+// e.g. "bonfida.sol" or "dex.bonfida.sol". The .sol suffix is optional.
+func SNSDomainKey(domain string) (web3.PublicKey, error) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), snsTLD)
+	labels := strings.Split(domain, ".")
+	if len(labels) > 2 {
+		return web3.PublicKey{}, fmt.Errorf("%w: sns supports domains and subdomains, got %q", ErrInvalidFormat, domain)
+	}
+	for _, l := range labels {
+		if l == "" {
+			return web3.PublicKey{}, fmt.Errorf("%w: empty label in sns domain %q", ErrInvalidFormat, domain)
+		}
+	}
+
+	key, err := snsNameAccountKey(labels[len(labels)-1], web3.PublicKey{}, SNSRootDomain)
+	if err != nil || len(labels) == 1 {
+		return key, err
+	}
+	// subdomain labels are prefixed with a zero byte
+	return snsNameAccountKey("\x00"+labels[0], web3.PublicKey{}, key)
+}
+
+// Ensure SNSResolver implements Resolver at compile time
+var _ Resolver = (*SNSResolver)(nil)
+
+// SNSResolver resolves .sol domains to the owner of their name account.
+// Tokenized domains resolve to the token escrow; records (e.g. SOL records) are not read.
+type SNSResolver struct {
+	reader SolanaAccountReader
+}
+
+// NewSNSResolver creates an SNSResolver reading accounts through reader.
+func NewSNSResolver(reader SolanaAccountReader) *SNSResolver {
+	return &SNSResolver{reader: reader}
+}
+
+// NewSNSResolverFromURL creates an SNSResolver using a Solana JSON-RPC endpoint.
+func NewSNSResolverFromURL(url string) *SNSResolver {
+	return NewSNSResolver(NewSolanaJSONRPC(url))
+}
+
+// Resolve returns the owner of a .sol domain as a SolanaAccountID on chainID.
+func (r *SNSResolver) Resolve(ctx context.Context, name string, chainID ChainID) (AccountID, error) {
+	if chainID.Namespace != NamespaceSolana {
+		return nil, fmt.Errorf("%w: sns resolves %q chains, got %q", ErrInvalidNamespace, NamespaceSolana, chainID)
+	}
+	if err := chainID.Validate(); err != nil {
+		return nil, err
+	}
+	key, err := SNSDomainKey(name)
+	if err != nil {
+		return nil, err
+	}
+	_, owner, err := r.nameAccount(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if owner.IsZero() {
+		return nil, fmt.Errorf("%w: sns domain %q not found", ErrNameNotFound, name)
+	}
+	return NewSolana(SolanaNetwork(chainID.Reference), owner), nil
+}
+
+// ReverseResolve returns the primary (favourite) .sol domain of a Solana account,
+// verifying that the account still owns it.
+func (r *SNSResolver) ReverseResolve(ctx context.Context, account AccountID) (string, error) {
+	if account == nil || account.IsZero() {
+		return "", ErrEmptyValue
+	}
+	if account.Namespace() != NamespaceSolana {
+		return "", fmt.Errorf("%w: sns resolves %q accounts, got %q", ErrInvalidNamespace, NamespaceSolana, account.Namespace())
+	}
+	owner, err := web3.NewPublicKey(account.Address())
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+
+	favourite, _, err := web3.FindProgramAddress([][]byte{[]byte("favourite_domain"), owner.Bytes()}, SNSNameOffersProgramID)
+	if err != nil {
+		return "", err
+	}
+	data, err := r.reader.GetAccountData(ctx, favourite)
+	if err != nil {
+		return "", err
+	}
+	// FavouriteDomain: tag (1 byte) followed by the name account key
+	if len(data) < 33 {
+		return "", fmt.Errorf("%w: no primary sns domain for %s", ErrNameNotFound, owner.Base58())
+	}
+	domainKey := web3.NewPublicKeyFromBs(data[1:33])
+
+	parent, domainOwner, err := r.nameAccount(ctx, domainKey)
+	if err != nil {
+		return "", err
+	}
+	if !parent.Equals(SNSRootDomain) {
+		return "", fmt.Errorf("%w: primary sns domain of %s is not a .sol domain", ErrInvalidFormat, owner.Base58())
+	}
+	if !domainOwner.Equals(owner) {
+		return "", fmt.Errorf("%w: primary sns domain of %s is owned by another account", ErrNameNotFound, owner.Base58())
+	}
+
+	reverseKey, err := snsNameAccountKey(domainKey.Base58(), SNSReverseLookupClass, web3.PublicKey{})
+	if err != nil {
+		return "", err
+	}
+	data, err = r.reader.GetAccountData(ctx, reverseKey)
+	if err != nil {
+		return "", err
+	}
+	// reverse record data: borsh string (u32 little-endian length, bytes)
+	if len(data) < snsHeaderLength+4 {
+		return "", fmt.Errorf("%w: no sns reverse record for %s", ErrNameNotFound, domainKey.Base58())
+	}
+	n := binary.LittleEndian.Uint32(data[snsHeaderLength:])
+	if uint64(n) > uint64(len(data)-snsHeaderLength-4) {
+		return "", fmt.Errorf("%w: invalid sns reverse record length", ErrInvalidFormat)
+	}
+	return string(data[snsHeaderLength+4:snsHeaderLength+4+int(n)]) + snsTLD, nil
+}
+
+// nameAccount reads the parent and owner of a name account; a missing account has a zero owner.
+func (r *SNSResolver) nameAccount(ctx context.Context, key web3.PublicKey) (parent, owner web3.PublicKey, err error) {
+	data, err := r.reader.GetAccountData(ctx, key)
+	if err != nil {
+		return parent, owner, err
+	}
+	if data == nil {
+		return parent, owner, nil
+	}
+	if len(data) < snsHeaderLength {
+		return parent, owner, fmt.Errorf("%w: short sns name account (%d bytes)", ErrInvalidFormat, len(data))
+	}
+	return web3.NewPublicKeyFromBs(data[:32]), web3.NewPublicKeyFromBs(data[32:64]), nil
+}
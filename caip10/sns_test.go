@@ -0,0 +1,110 @@
+package caip10
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSolanaReader serves account data from a map; missing accounts return nil.
+type fakeSolanaReader map[web3.PublicKey][]byte
+
+func (f fakeSolanaReader) GetAccountData(_ context.Context, account web3.PublicKey) ([]byte, error) {
+	return f[account], nil
+}
+
+func snsNameAccountData(parent, owner web3.PublicKey, payload []byte) []byte {
+	data := make([]byte, snsHeaderLength, snsHeaderLength+len(payload))
+	copy(data[:32], parent.Bytes())
+	copy(data[32:64], owner.Bytes())
+	return append(data, payload...)
+}
+
+func TestSNSDomainKey(t *testing.T) {
+	// vectors from the SNS SDK
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{"bonfida", "Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"},
+		{"bonfida.sol", "Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb"},
+		{"dex.bonfida.sol", "HoFfFXqFHAC8RP3duuQNzag1ieUwJRBv1HtRNiWFq4Qu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			key, err := SNSDomainKey(tt.domain)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, key.Base58())
+		})
+	}
+
+	for _, domain := range []string{"a.b.c.sol", ".sol", "dex..sol"} {
+		_, err := SNSDomainKey(domain)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "%s: got %v", domain, err)
+	}
+}
+
+func newTestSNS(t *testing.T) (*SNSResolver, web3.PublicKey) {
+	t.Helper()
+	owner := web3.MustPublicKey("HKKp49qGWXd639QsuH7JiLijfVW5UtCVY4s1n2HANwEA")
+	domainKey, err := SNSDomainKey("bonfida.sol")
+	require.NoError(t, err)
+
+	favourite, _, err := web3.FindProgramAddress([][]byte{[]byte("favourite_domain"), owner.Bytes()}, SNSNameOffersProgramID)
+	require.NoError(t, err)
+	reverseKey, err := snsNameAccountKey(domainKey.Base58(), SNSReverseLookupClass, web3.PublicKey{})
+	require.NoError(t, err)
+
+	name := binary.LittleEndian.AppendUint32(nil, uint32(len("bonfida")))
+	name = append(name, "bonfida"...)
+
+	reader := fakeSolanaReader{
+		domainKey:  snsNameAccountData(SNSRootDomain, owner, nil),
+		favourite:  append([]byte{1}, domainKey.Bytes()...),
+		reverseKey: snsNameAccountData(web3.PublicKey{}, web3.PublicKey{}, name),
+	}
+	return NewSNSResolver(reader), owner
+}
+
+func TestSNSResolve(t *testing.T) {
+	r, owner := newTestSNS(t)
+	ctx := context.Background()
+
+	a, err := r.Resolve(ctx, "bonfida.sol", ChainIDSolanaMainnet)
+	require.NoError(t, err)
+	sol, ok := a.(SolanaAccountID)
+	require.True(t, ok, "expected SolanaAccountID, got %T", a)
+	assert.Equal(t, owner, sol.Account())
+	assert.Equal(t, ChainIDSolanaMainnet, a.ChainID())
+
+	_, err = r.Resolve(ctx, "nobody.sol", ChainIDSolanaMainnet)
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.Resolve(ctx, "bonfida.sol", ChainIDEthereumMainnet)
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
+
+func TestSNSReverseResolve(t *testing.T) {
+	r, owner := newTestSNS(t)
+	ctx := context.Background()
+
+	name, err := r.ReverseResolve(ctx, NewSolanaMainnet(owner))
+	require.NoError(t, err)
+	assert.Equal(t, "bonfida.sol", name)
+
+	// the favourite domain was transferred to someone else
+	domainKey, _ := SNSDomainKey("bonfida.sol")
+	r.reader.(fakeSolanaReader)[domainKey] = snsNameAccountData(SNSRootDomain, SNSNameProgramID, nil)
+	_, err = r.ReverseResolve(ctx, NewSolanaMainnet(owner))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+
+	_, err = r.ReverseResolve(ctx, NewSolanaMainnet(SNSRootDomain))
+	assert.True(t, errors.Is(err, ErrNameNotFound), "got %v", err)
+	_, err = r.ReverseResolve(ctx, MustParse("eip155:1:0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"))
+	assert.True(t, errors.Is(err, ErrInvalidNamespace), "got %v", err)
+}
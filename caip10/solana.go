@@ -1,6 +1,7 @@
 package caip10
 
 import (
+	"crypto/ed25519"
 	"fmt"
 	"regexp"
 
@@ -121,8 +122,10 @@ type SolanaAccountID interface {
 // Ensure solanaAccountID implements SolanaAccountID at compile time
 var _ SolanaAccountID = (*solanaAccountID)(nil)
 
-func init() {
-	RegisterParser(&solanaParser{})
+// NewSolanaParser returns the parser of solana account IDs, registered by
+// package solanareg.
+func NewSolanaParser() Parser {
+	return &solanaParser{}
 }
 
 // solanaAccountID represents a Solana account ID per CAIP-10.
@@ -177,6 +180,27 @@ func MustNewSolanaFromBase58(network SolanaNetwork, base58Address string) Solana
 	return a
 }
 
+// SolanaFromPubkeyBytes creates a SolanaAccountID from raw ed25519 public key
+// bytes. Returns ErrInvalidAddress if the key is not on the ed25519 curve; use
+// NewSolana for off-curve addresses such as PDAs.
+func SolanaFromPubkeyBytes(network SolanaNetwork, pubkey [SolanaAddressLength]byte) (SolanaAccountID, error) {
+	key := web3.PublicKey(pubkey)
+	if !IsOnCurve(key) {
+		return nil, fmt.Errorf("%w: not a valid ed25519 public key", ErrInvalidAddress)
+	}
+	return NewSolana(network, key), nil
+}
+
+// SolanaFromEd25519 creates a SolanaAccountID from a crypto/ed25519 public key,
+// see SolanaFromPubkeyBytes.
+func SolanaFromEd25519(network SolanaNetwork, pubkey ed25519.PublicKey) (SolanaAccountID, error) {
+	if len(pubkey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: ed25519 public key must be %d bytes, got %d",
+			ErrInvalidAddress, ed25519.PublicKeySize, len(pubkey))
+	}
+	return SolanaFromPubkeyBytes(network, [SolanaAddressLength]byte(pubkey))
+}
+
 // NewSolanaMainnet creates a SolanaAccountID for mainnet.
 func NewSolanaMainnet(address web3.PublicKey) SolanaAccountID {
 	return NewSolana(SolanaMainnet, address)
@@ -248,6 +272,16 @@ func (a *solanaAccountID) Equal(other AccountID) bool {
 	return a.GenericAccountID.Equal(other)
 }
 
+// Format implements fmt.Formatter; %+v and %#v include whether the account is
+// on the ed25519 curve.
+func (a *solanaAccountID) Format(f fmt.State, verb rune) {
+	if a == nil || a.GenericAccountID == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	formatAccount(f, verb, a, formatField{"onCurve", a.IsOnCurve()})
+}
+
 // --- solanaParser ---
 
 type solanaParser struct{}
@@ -0,0 +1,82 @@
+package caip10
+
+import (
+	"fmt"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// SPL token program addresses
+var (
+	SolanaTokenProgramID                  = web3.MustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	SolanaToken2022ProgramID              = web3.MustPublicKey("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+	SolanaAssociatedTokenAccountProgramID = web3.MustPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+)
+
+// Program derived address limits
+const (
+	SolanaMaxSeeds      = 16 // including the bump seed
+	SolanaMaxSeedLength = 32
+)
+
+// DerivePDA finds the program derived address of programID and seeds, trying
+// bump seeds from 255 down as Solana's find_program_address does. It returns
+// the off-curve address and its canonical bump.
+func DerivePDA(network SolanaNetwork, programID web3.PublicKey, seeds ...[]byte) (SolanaAccountID, uint8, error) {
+	if err := checkPDASeeds(seeds); err != nil {
+		return nil, 0, err
+	}
+	for bump := 255; bump >= 0; bump-- {
+		if a, err := DerivePDAWithBump(network, programID, uint8(bump), seeds...); err == nil {
+			return a, uint8(bump), nil
+		}
+	}
+	return nil, 0, fmt.Errorf("%w: no viable bump seed for program %s", ErrInvalidAddress, programID)
+}
+
+// DerivePDAWithBump returns the program derived address of programID, seeds and
+// an explicit bump seed, as Solana's create_program_address does. Fails if the
+// address is on the ed25519 curve.
+func DerivePDAWithBump(network SolanaNetwork, programID web3.PublicKey, bump uint8, seeds ...[]byte) (SolanaAccountID, error) {
+	if err := checkPDASeeds(seeds); err != nil {
+		return nil, err
+	}
+	withBump := append(append(make([][]byte, 0, len(seeds)+1), seeds...), []byte{bump})
+	address, err := web3.CreateProgramAddress(withBump, programID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	return NewSolana(network, address), nil
+}
+
+// checkPDASeeds checks the seeds of a program derived address, leaving room for
+// the bump seed.
+func checkPDASeeds(seeds [][]byte) error {
+	if len(seeds) >= SolanaMaxSeeds {
+		return fmt.Errorf("%w: at most %d seeds besides the bump, got %d", ErrInvalidFormat, SolanaMaxSeeds-1, len(seeds))
+	}
+	for i, s := range seeds {
+		if len(s) > SolanaMaxSeedLength {
+			return fmt.Errorf("%w: seed %d is %d bytes, max %d", ErrInvalidFormat, i, len(s), SolanaMaxSeedLength)
+		}
+	}
+	return nil
+}
+
+// AssociatedTokenAccount returns the associated token account of owner for an
+// SPL Token mint, on the owner's network.
+func AssociatedTokenAccount(owner SolanaAccountID, mint web3.PublicKey) (SolanaAccountID, error) {
+	return AssociatedTokenAccountWithProgram(owner, mint, SolanaTokenProgramID)
+}
+
+// AssociatedTokenAccountWithProgram is like AssociatedTokenAccount for mints of
+// another token program, such as SolanaToken2022ProgramID.
+func AssociatedTokenAccountWithProgram(owner SolanaAccountID, mint, tokenProgramID web3.PublicKey) (SolanaAccountID, error) {
+	if owner == nil || owner.IsZero() {
+		return nil, fmt.Errorf("%w: associated token account requires an owner", ErrEmptyValue)
+	}
+	ownerKey := owner.Account()
+	a, _, err := DerivePDA(SolanaNetwork(owner.Reference()), SolanaAssociatedTokenAccountProgramID,
+		ownerKey.Bytes(), tokenProgramID.Bytes(), mint.Bytes())
+	return a, err
+}
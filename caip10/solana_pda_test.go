@@ -0,0 +1,86 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivePDAWithBump(t *testing.T) {
+	// solana-program create_program_address test vectors
+	program := web3.MustPublicKey("BPFLoaderUpgradeab1e11111111111111111111111")
+	seedKey := web3.MustPublicKey("SeedPubey1111111111111111111111111111111111")
+
+	tests := []struct {
+		seeds [][]byte
+		bump  uint8
+		want  string
+	}{
+		{[][]byte{{}}, 1, "BwqrghZA2htAcqq8dzP1WDAhTXYTYWj7CHxF5j7TDBAe"},
+		{[][]byte{[]byte("☉")}, 0, "13yWmRpaTR4r5nAktwLqMpRNr28tnVUZw26rTvPSSB19"},
+		{[][]byte{seedKey.Bytes()}, 1, "976ymqVnfE32QFe6NfGDctSvVa36LWnvYxhU6G2232YL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			a, err := DerivePDAWithBump(SolanaMainnet, program, tt.bump, tt.seeds...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.Address())
+			assert.False(t, a.IsOnCurve())
+		})
+	}
+}
+
+func TestDerivePDA(t *testing.T) {
+	program := web3.MustPublicKey("BPFLoaderUpgradeab1e11111111111111111111111")
+	seeds := [][]byte{[]byte("Talking"), []byte("Squirrels")}
+
+	a, bump, err := DerivePDA(SolanaDevnet, program, seeds...)
+	require.NoError(t, err)
+	assert.False(t, a.IsOnCurve())
+	assert.True(t, a.IsDevnet())
+
+	// The canonical bump is the highest viable one
+	again, err := DerivePDAWithBump(SolanaDevnet, program, bump, seeds...)
+	require.NoError(t, err)
+	assert.True(t, a.Equal(again))
+	for b := 255; b > int(bump); b-- {
+		_, err := DerivePDAWithBump(SolanaDevnet, program, uint8(b), seeds...)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), "bump %d: got %v", b, err)
+	}
+
+	_, _, err = DerivePDA(SolanaMainnet, program, make([]byte, SolanaMaxSeedLength+1))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, _, err = DerivePDA(SolanaMainnet, program, make([][]byte, SolanaMaxSeeds)...)
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
+
+func TestAssociatedTokenAccount(t *testing.T) {
+	owner := MustNewSolanaFromBase58(SolanaMainnet, "7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	usdc := web3.MustPublicKey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	ata, err := AssociatedTokenAccount(owner, usdc)
+	require.NoError(t, err)
+	assert.True(t, ata.IsMainnet())
+	assert.False(t, ata.IsOnCurve())
+	want, _, err := DerivePDA(SolanaMainnet, SolanaAssociatedTokenAccountProgramID,
+		owner.Account().Bytes(), SolanaTokenProgramID.Bytes(), usdc.Bytes())
+	require.NoError(t, err)
+	assert.True(t, ata.Equal(want))
+
+	// Token-2022 mints have other token accounts
+	ata2022, err := AssociatedTokenAccountWithProgram(owner, usdc, SolanaToken2022ProgramID)
+	require.NoError(t, err)
+	assert.False(t, ata.Equal(ata2022))
+
+	// The network follows the owner
+	devnet, err := AssociatedTokenAccount(NewSolanaDevnet(owner.Account()), usdc)
+	require.NoError(t, err)
+	assert.True(t, devnet.IsDevnet())
+	assert.Equal(t, ata.Address(), devnet.Address())
+
+	_, err = AssociatedTokenAccount(nil, usdc)
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+}
@@ -0,0 +1,111 @@
+package caip10
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// Native and memo program addresses
+var (
+	SolanaSystemProgramID = web3.MustPublicKey("11111111111111111111111111111111")
+	SolanaStakeProgramID  = web3.MustPublicKey("Stake11111111111111111111111111111111111111")
+	SolanaMemoProgramID   = web3.MustPublicKey("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr")
+)
+
+// SolanaProgram is a named Solana program. Program addresses are the same on
+// every cluster.
+type SolanaProgram struct {
+	ID   web3.PublicKey
+	Name string // e.g. "Token Program"
+}
+
+// defaultSolanaPrograms are the programs registered at package initialization.
+var defaultSolanaPrograms = []SolanaProgram{
+	{ID: SolanaSystemProgramID, Name: "System Program"},
+	{ID: SolanaTokenProgramID, Name: "Token Program"},
+	{ID: SolanaToken2022ProgramID, Name: "Token-2022 Program"},
+	{ID: SolanaAssociatedTokenAccountProgramID, Name: "Associated Token Account Program"},
+	{ID: SolanaMemoProgramID, Name: "Memo Program"},
+	{ID: SolanaStakeProgramID, Name: "Stake Program"},
+}
+
+var (
+	solanaProgramsMu sync.RWMutex
+	solanaPrograms   = map[web3.PublicKey]SolanaProgram{}
+)
+
+func init() {
+	for _, p := range defaultSolanaPrograms {
+		solanaPrograms[p.ID] = p
+	}
+}
+
+// RegisterSolanaPrograms registers or replaces a set of programs, e.g. the
+// programs of a protocol. No program is registered if one of them has no name.
+func RegisterSolanaPrograms(programs ...SolanaProgram) error {
+	for _, p := range programs {
+		if p.Name == "" {
+			return fmt.Errorf("%w: name of solana program %s", ErrEmptyValue, p.ID)
+		}
+	}
+
+	solanaProgramsMu.Lock()
+	defer solanaProgramsMu.Unlock()
+	for _, p := range programs {
+		solanaPrograms[p.ID] = p
+	}
+	return nil
+}
+
+// LookupSolanaProgram returns the program registered at an address.
+func LookupSolanaProgram(id web3.PublicKey) (SolanaProgram, bool) {
+	solanaProgramsMu.RLock()
+	defer solanaProgramsMu.RUnlock()
+	p, ok := solanaPrograms[id]
+	return p, ok
+}
+
+// RegisteredSolanaPrograms returns the registered programs sorted by name.
+func RegisteredSolanaPrograms() []SolanaProgram {
+	solanaProgramsMu.RLock()
+	defer solanaProgramsMu.RUnlock()
+	out := make([]SolanaProgram, 0, len(solanaPrograms))
+	for _, p := range solanaPrograms {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// IsKnownProgram reports whether the account is a registered program.
+func (a *solanaAccountID) IsKnownProgram() bool {
+	return IsKnownProgram(a)
+}
+
+// ProgramName returns the name of the registered program at the account, or ""
+// if the account is not a registered program.
+func (a *solanaAccountID) ProgramName() string {
+	return ProgramName(a)
+}
+
+// IsKnownProgram reports whether a is a registered program.
+func IsKnownProgram(a SolanaAccountID) bool {
+	if a == nil || a.IsZero() {
+		return false
+	}
+	_, ok := LookupSolanaProgram(a.Account())
+	return ok
+}
+
+// ProgramName returns the name of the registered program at a, or "" if a is
+// not a registered program.
+func ProgramName(a SolanaAccountID) string {
+	if a == nil || a.IsZero() {
+		return ""
+	}
+	p, _ := LookupSolanaProgram(a.Account())
+	return p.Name
+}
@@ -0,0 +1,62 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolanaKnownPrograms(t *testing.T) {
+	tests := []struct {
+		address string
+		name    string
+	}{
+		{"11111111111111111111111111111111", "System Program"},
+		{"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "Token Program"},
+		{"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb", "Token-2022 Program"},
+		{"ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL", "Associated Token Account Program"},
+		{"MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr", "Memo Program"},
+		{"Stake11111111111111111111111111111111111111", "Stake Program"},
+		{"7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			a := MustNewSolanaFromBase58(SolanaDevnet, tt.address)
+			assert.Equal(t, tt.name != "", IsKnownProgram(a))
+			assert.Equal(t, tt.name, ProgramName(a))
+		})
+	}
+
+	var a *solanaAccountID
+	assert.False(t, a.IsKnownProgram())
+	assert.Equal(t, "", a.ProgramName())
+}
+
+func TestRegisterSolanaPrograms(t *testing.T) {
+	jupiter := web3.MustPublicKey("JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4")
+	dflow := web3.MustPublicKey("DF1ow4tspfHX9JwWJsAb9epbkA8hmpSEAtxXy1V27QBH")
+
+	err := RegisterSolanaPrograms(SolanaProgram{ID: jupiter, Name: "Jupiter Aggregator v6"}, SolanaProgram{ID: dflow})
+	assert.True(t, errors.Is(err, ErrEmptyValue), "got %v", err)
+	_, ok := LookupSolanaProgram(jupiter)
+	assert.False(t, ok, "no program of an invalid set is registered")
+
+	require.NoError(t, RegisterSolanaPrograms(
+		SolanaProgram{ID: jupiter, Name: "Jupiter Aggregator v6"},
+		SolanaProgram{ID: dflow, Name: "DFlow Swap"},
+	))
+	assert.Equal(t, "Jupiter Aggregator v6", ProgramName(NewSolanaMainnet(jupiter)))
+	p, ok := LookupSolanaProgram(dflow)
+	require.True(t, ok)
+	assert.Equal(t, "DFlow Swap", p.Name)
+
+	names := make([]string, 0)
+	for _, p := range RegisteredSolanaPrograms() {
+		names = append(names, p.Name)
+	}
+	assert.IsIncreasing(t, names)
+	assert.Contains(t, names, "Memo Program")
+}
@@ -1,11 +1,14 @@
 package caip10
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/donutnomad/solana-web3/web3"
 	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
 )
 
 func TestSolanaParse(t *testing.T) {
@@ -82,6 +85,50 @@ func TestSolanaNewFromPublicKey(t *testing.T) {
 	}
 }
 
+func TestSolanaFromPubkeyBytes(t *testing.T) {
+	pk, err := web3.NewPublicKey("7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	if err != nil {
+		t.Fatalf("NewPublicKey failed: %v", err)
+	}
+
+	a, err := SolanaFromPubkeyBytes(SolanaDevnet, pk)
+	if err != nil {
+		t.Fatalf("SolanaFromPubkeyBytes failed: %v", err)
+	}
+	if a.Address() != "7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv" || !a.IsDevnet() {
+		t.Errorf("got %q", a.String())
+	}
+
+	a, err = SolanaFromEd25519(SolanaMainnet, ed25519.PublicKey(pk[:]))
+	if err != nil {
+		t.Fatalf("SolanaFromEd25519 failed: %v", err)
+	}
+	if a.Account() != pk {
+		t.Errorf("Account mismatch")
+	}
+
+	// A generated key round-trips
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	a, err = SolanaFromEd25519(SolanaMainnet, pub)
+	if err != nil {
+		t.Fatalf("SolanaFromEd25519 failed: %v", err)
+	}
+	if a.Address() != base58.Encode(pub) {
+		t.Errorf("got %q, want %q", a.Address(), base58.Encode(pub))
+	}
+
+	// y = 2 is not on the curve
+	if _, err := SolanaFromPubkeyBytes(SolanaMainnet, [32]byte{2}); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("off-curve key: got %v", err)
+	}
+	if _, err := SolanaFromEd25519(SolanaMainnet, pub[:31]); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("short key: got %v", err)
+	}
+}
+
 func TestSolanaMainnetDevnet(t *testing.T) {
 	pk, _ := web3.NewPublicKey("7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
 
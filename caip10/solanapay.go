@@ -0,0 +1,128 @@
+package caip10
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// Solana Pay transfer request URIs, e.g.
+// "solana:RECIPIENT?amount=1.5&spl-token=MINT&reference=KEY&label=Shop".
+// https://docs.solanapay.com/spec
+
+// SolanaPayScheme is the URI scheme of Solana Pay requests.
+const SolanaPayScheme = "solana"
+
+// solanaPayAmountRegex matches Solana Pay amounts: non-negative decimals in user
+// units with a leading digit, e.g. "0.01".
+var solanaPayAmountRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// SolanaPayRequest is a parsed Solana Pay transfer request.
+type SolanaPayRequest struct {
+	Recipient  SolanaAccountID    // native SOL account receiving the transfer
+	Amount     string             // decimal amount in SOL or token units, empty if absent
+	SPLToken   SolanaTokenAssetID // token transferred, nil for SOL
+	References []web3.PublicKey   // keys identifying the transaction, in order
+	Label      string             // merchant or source of the request
+	Message    string             // payment description
+	Memo       string             // memo included in the transaction
+}
+
+// NewSolanaPayTransfer returns a request to transfer amount SOL, or amount of
+// token if token is not nil, to the recipient.
+func NewSolanaPayTransfer(recipient SolanaAccountID, amount string, token SolanaTokenAssetID) *SolanaPayRequest {
+	return &SolanaPayRequest{Recipient: recipient, Amount: amount, SPLToken: token}
+}
+
+// ParseSolanaPay parses a Solana Pay transfer request URI. Solana Pay URIs do
+// not carry a cluster, so the recipient and token are created on network.
+// Transaction requests (with an https link instead of a recipient) are rejected.
+func ParseSolanaPay(s string, network SolanaNetwork) (*SolanaPayRequest, error) {
+	if s == "" {
+		return nil, ErrEmptyValue
+	}
+	scheme, rest, ok := strings.Cut(s, ":")
+	if !ok || scheme != SolanaPayScheme {
+		return nil, fmt.Errorf("%w: solana pay: missing %q scheme", ErrInvalidFormat, SolanaPayScheme+":")
+	}
+	recipient, query, _ := strings.Cut(rest, "?")
+	if strings.HasPrefix(recipient, "https") {
+		return nil, fmt.Errorf("%w: solana pay: transaction requests are not supported", ErrInvalidFormat)
+	}
+
+	r := &SolanaPayRequest{}
+	var err error
+	if r.Recipient, err = NewSolanaFromBase58(network, recipient); err != nil {
+		return nil, err
+	}
+
+	if query == "" {
+		return r, nil
+	}
+	for _, pair := range strings.Split(query, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: solana pay: invalid parameter %q", ErrInvalidFormat, pair)
+		}
+		if value, err = url.QueryUnescape(value); err != nil {
+			return nil, fmt.Errorf("%w: solana pay: parameter %q: %v", ErrInvalidFormat, key, err)
+		}
+		switch key {
+		case "amount":
+			if !solanaPayAmountRegex.MatchString(value) {
+				return nil, fmt.Errorf("%w: solana pay: invalid amount %q", ErrInvalidFormat, value)
+			}
+			r.Amount = value
+		case "spl-token":
+			if r.SPLToken, err = NewSolanaTokenAssetFromBase58(network, value); err != nil {
+				return nil, err
+			}
+		case "reference":
+			ref, err := NewSolanaFromBase58(network, value)
+			if err != nil {
+				return nil, err
+			}
+			r.References = append(r.References, ref.Account())
+		case "label":
+			r.Label = value
+		case "message":
+			r.Message = value
+		case "memo":
+			r.Memo = value
+		}
+	}
+	return r, nil
+}
+
+// String returns the Solana Pay URI of the request.
+func (r *SolanaPayRequest) String() string {
+	if r == nil || r.Recipient == nil || r.Recipient.IsZero() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(SolanaPayScheme + ":")
+	b.WriteString(r.Recipient.Address())
+	sep := byte('?')
+	write := func(key, value string) {
+		if value == "" {
+			return
+		}
+		b.WriteByte(sep)
+		b.WriteString(key + "=" + strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+		sep = '&'
+	}
+	write("amount", r.Amount)
+	if r.SPLToken != nil {
+		write("spl-token", r.SPLToken.Mint().String())
+	}
+	for _, ref := range r.References {
+		write("reference", ref.String())
+	}
+	write("label", r.Label)
+	write("message", r.Message)
+	write("memo", r.Memo)
+	return b.String()
+}
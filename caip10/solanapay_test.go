@@ -0,0 +1,75 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	solanaPayRecipient = "mvines9iiHiQTysrwkJjGf2gb9Ex9jXJX8ns3qwf2kN"
+	solanaPayUSDC      = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	solanaPayReference = "82ZJ7nbGpixjeDCmEhUcmwXYfvurzAgGdtSMuHnUgyny"
+)
+
+func TestParseSolanaPay(t *testing.T) {
+	r, err := ParseSolanaPay("solana:"+solanaPayRecipient+"?amount=0.01&spl-token="+solanaPayUSDC+
+		"&reference="+solanaPayReference+"&label=Michael&message=Thanks%20for%20all%20the%20fish&memo=OrderId12345", SolanaMainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "solana:"+string(SolanaMainnet)+":"+solanaPayRecipient, r.Recipient.String())
+	assert.Equal(t, "0.01", r.Amount)
+	require.NotNil(t, r.SPLToken)
+	assert.Equal(t, "solana:"+string(SolanaMainnet)+"/token:"+solanaPayUSDC, r.SPLToken.String())
+	require.Len(t, r.References, 1)
+	assert.Equal(t, solanaPayReference, r.References[0].String())
+	assert.Equal(t, "Michael", r.Label)
+	assert.Equal(t, "Thanks for all the fish", r.Message)
+	assert.Equal(t, "OrderId12345", r.Memo)
+
+	r, err = ParseSolanaPay("solana:"+solanaPayRecipient, SolanaDevnet)
+	require.NoError(t, err)
+	assert.True(t, r.Recipient.IsDevnet())
+	assert.Empty(t, r.Amount)
+	assert.Nil(t, r.SPLToken)
+}
+
+func TestSolanaPayString(t *testing.T) {
+	token, err := NewSolanaTokenAssetFromBase58(SolanaMainnet, solanaPayUSDC)
+	require.NoError(t, err)
+	r := NewSolanaPayTransfer(MustNewSolanaFromBase58(SolanaMainnet, solanaPayRecipient), "1.5", token)
+	r.References = append(r.References, MustNewSolanaFromBase58(SolanaMainnet, solanaPayReference).Account())
+	r.Label = "Coffee shop"
+	uri := r.String()
+	assert.Equal(t, "solana:"+solanaPayRecipient+"?amount=1.5&spl-token="+solanaPayUSDC+"&reference="+solanaPayReference+"&label=Coffee%20shop", uri)
+
+	back, err := ParseSolanaPay(uri, SolanaMainnet)
+	require.NoError(t, err)
+	assert.Equal(t, uri, back.String())
+	assert.True(t, r.Recipient.Equal(back.Recipient))
+
+	assert.Equal(t, "solana:"+solanaPayRecipient, NewSolanaPayTransfer(r.Recipient, "", nil).String())
+	assert.Equal(t, "", (*SolanaPayRequest)(nil).String())
+}
+
+func TestParseSolanaPayErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		sentinel error
+	}{
+		{"", ErrEmptyValue},
+		{"bitcoin:" + solanaPayRecipient, ErrInvalidFormat},
+		{"solana:https%3A%2F%2Fexample.com%2Fpay", ErrInvalidFormat},
+		{"solana:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", ErrInvalidAddress},
+		{"solana:" + solanaPayRecipient + "?amount=.5", ErrInvalidFormat},
+		{"solana:" + solanaPayRecipient + "?amount=-1", ErrInvalidFormat},
+		{"solana:" + solanaPayRecipient + "?spl-token=abc", ErrInvalidAddress},
+		{"solana:" + solanaPayRecipient + "?reference=abc", ErrInvalidAddress},
+		{"solana:" + solanaPayRecipient + "?label", ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		_, err := ParseSolanaPay(tt.input, SolanaMainnet)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+	}
+}
@@ -0,0 +1,15 @@
+// Package solanareg registers the solana parser of package caip10, so that
+// solana account IDs are parsed as caip10.SolanaAccountID:
+//
+//	import _ "github.com/donutnomad/xchain/caip10/solanareg"
+//
+// caip10 itself still registers the parser unless built with the caip10_optin
+// tag. That registration is deprecated; import this package to keep solana
+// parsing when it is removed.
+package solanareg
+
+import "github.com/donutnomad/xchain/caip10"
+
+func init() {
+	caip10.RegisterParser(caip10.NewSolanaParser())
+}
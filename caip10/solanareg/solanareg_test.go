@@ -0,0 +1,20 @@
+package solanareg_test
+
+import (
+	"testing"
+
+	"github.com/donutnomad/xchain/caip10"
+	"github.com/donutnomad/xchain/caip10/caip10test"
+	_ "github.com/donutnomad/xchain/caip10/solanareg"
+)
+
+func TestRegistered(t *testing.T) {
+	a, err := caip10.Parse("solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := a.(caip10.SolanaAccountID); !ok {
+		t.Errorf("Parse() = %T, want caip10.SolanaAccountID", a)
+	}
+	caip10test.TestParser(t, caip10.NewSolanaParser())
+}
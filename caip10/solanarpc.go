@@ -0,0 +1,96 @@
+package caip10
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// SolanaAccountReader reads raw account data from a Solana node.
+// GetAccountData returns nil data and a nil error for accounts that do not exist.
+type SolanaAccountReader interface {
+	GetAccountData(ctx context.Context, account web3.PublicKey) ([]byte, error)
+}
+
+// Ensure SolanaJSONRPC implements SolanaAccountReader at compile time
+var _ SolanaAccountReader = (*SolanaJSONRPC)(nil)
+
+// SolanaJSONRPC is a SolanaAccountReader backed by a Solana JSON-RPC HTTP endpoint.
+type SolanaJSONRPC struct {
+	URL    string
+	Client *http.Client // nil uses http.DefaultClient
+	nextID atomic.Uint64
+}
+
+// NewSolanaJSONRPC creates a SolanaJSONRPC for the endpoint URL.
+func NewSolanaJSONRPC(url string) *SolanaJSONRPC {
+	return &SolanaJSONRPC{URL: url}
+}
+
+type solanaRPCResponse struct {
+	Result *struct {
+		Value *struct {
+			Data []string `json:"data"` // [base64 data, "base64"]
+		} `json:"value"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// GetAccountData calls getAccountInfo with base64 encoding and returns the decoded data.
+func (c *SolanaJSONRPC) GetAccountData(ctx context.Context, account web3.PublicKey) ([]byte, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      c.nextID.Add(1),
+		Method:  "getAccountInfo",
+		Params:  []any{account.Base58(), map[string]string{"encoding": "base64"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getAccountInfo: unexpected HTTP status %s", resp.Status)
+	}
+
+	var out solanaRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("getAccountInfo: decode response: %w", err)
+	}
+	if out.Error != nil {
+		return nil, fmt.Errorf("getAccountInfo: rpc error %d: %s", out.Error.Code, out.Error.Message)
+	}
+	if out.Result == nil || out.Result.Value == nil {
+		return nil, nil
+	}
+	if len(out.Result.Value.Data) != 2 || out.Result.Value.Data[1] != "base64" {
+		return nil, fmt.Errorf("getAccountInfo: unexpected data encoding")
+	}
+	data, err := base64.StdEncoding.DecodeString(out.Result.Value.Data[0])
+	if err != nil {
+		return nil, fmt.Errorf("getAccountInfo: invalid base64 data: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,61 @@
+package caip10
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolanaJSONRPCGetAccountData(t *testing.T) {
+	existing := web3.MustPublicKey("Crf8hzfthWGbGbLTVCiqRqV5MVnbpHB1L9KQMd6gsinb")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "getAccountInfo", req.Method)
+		require.Len(t, req.Params, 2)
+		assert.Equal(t, map[string]any{"encoding": "base64"}, req.Params[1])
+
+		if req.Params[0] == existing.Base58() {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"context":{"slot":1},"value":{"data":["AQID","base64"],"owner":"namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"context":{"slot":1},"value":null}}`))
+	}))
+	defer srv.Close()
+
+	c := NewSolanaJSONRPC(srv.URL)
+	data, err := c.GetAccountData(context.Background(), existing)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+
+	data, err = c.GetAccountData(context.Background(), SNSRootDomain)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestSolanaJSONRPCErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rpc-error":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid param"}}`))
+		case "/bad-encoding":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"value":{"data":["AQID","base58"]}}}`))
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/rpc-error", "/bad-encoding", "/down"} {
+		t.Run(path, func(t *testing.T) {
+			_, err := NewSolanaJSONRPC(srv.URL+path).GetAccountData(context.Background(), SNSRootDomain)
+			assert.Error(t, err)
+		})
+	}
+}
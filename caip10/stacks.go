@@ -0,0 +1,276 @@
+package caip10
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const NamespaceStacks Namespace = "stacks"
+
+// StacksNetwork represents a Stacks network (chain reference), identified by its chain id.
+// https://github.com/ChainAgnostic/namespaces/blob/main/stacks/caip2.md
+type StacksNetwork string
+
+// Stacks networks
+const (
+	StacksMainnet StacksNetwork = "1"
+	StacksTestnet StacksNetwork = "2147483648"
+)
+
+// String returns the network reference string.
+func (n StacksNetwork) String() string {
+	return string(n)
+}
+
+// Stacks address version bytes
+const (
+	StacksVersionMainnetP2PKH byte = 22 // SP
+	StacksVersionMainnetP2SH  byte = 20 // SM
+	StacksVersionTestnetP2PKH byte = 26 // ST
+	StacksVersionTestnetP2SH  byte = 21 // SN
+)
+
+// StacksHashLength is the length of the hash160 payload.
+const StacksHashLength = 20
+
+const c32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// c32Encode encodes data in Crockford base32, keeping one '0' per leading zero byte.
+func c32Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+	n := new(big.Int).SetBytes(data)
+	var out []byte
+	mod := new(big.Int)
+	base := big.NewInt(32)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append(out, c32Alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		out = append(out, '0')
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// c32Decode decodes a Crockford base32 string encoded by c32Encode.
+func c32Decode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '0' {
+		zeros++
+	}
+	n := new(big.Int)
+	for i := zeros; i < len(s); i++ {
+		v := strings.IndexByte(c32Alphabet, s[i])
+		if v < 0 {
+			return nil, fmt.Errorf("%w: invalid c32 character %q", ErrInvalidAddress, s[i])
+		}
+		n.Lsh(n, 5)
+		n.Or(n, big.NewInt(int64(v)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+func stacksChecksum(version byte, hash []byte) []byte {
+	first := sha256.Sum256(append([]byte{version}, hash...))
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
+
+// EncodeStacksAddress encodes a version byte and hash160 as a c32check address.
+func EncodeStacksAddress(version byte, hash [StacksHashLength]byte) string {
+	payload := append(hash[:], stacksChecksum(version, hash[:])...)
+	return "S" + string(c32Alphabet[version&31]) + c32Encode(payload)
+}
+
+// DecodeStacksAddress decodes a c32check address into its version byte and hash160.
+//
+// Validation steps:
+//  1. Starts with 'S' followed by a known version character (P, M, T, N)
+//  2. c32 decodes to a 20-byte hash and 4-byte checksum
+//  3. Double SHA-256 checksum over version and hash matches
+//  4. Address is in canonical (upper case) form
+func DecodeStacksAddress(address string) (byte, [StacksHashLength]byte, error) {
+	var hash [StacksHashLength]byte
+	if len(address) < 3 || address[0] != 'S' {
+		return 0, hash, fmt.Errorf("%w: stacks address must start with S", ErrInvalidAddress)
+	}
+
+	version := byte(strings.IndexByte(c32Alphabet, address[1]))
+	switch version {
+	case StacksVersionMainnetP2PKH, StacksVersionMainnetP2SH, StacksVersionTestnetP2PKH, StacksVersionTestnetP2SH:
+	default:
+		return 0, hash, fmt.Errorf("%w: unknown stacks address version %q", ErrInvalidAddress, address[1])
+	}
+
+	data, err := c32Decode(address[2:])
+	if err != nil {
+		return 0, hash, err
+	}
+	if len(data) != StacksHashLength+4 {
+		return 0, hash, fmt.Errorf("%w: stacks address must decode to %d bytes, got %d",
+			ErrInvalidAddress, StacksHashLength+4, len(data))
+	}
+	if !bytes.Equal(stacksChecksum(version, data[:StacksHashLength]), data[StacksHashLength:]) {
+		return 0, hash, fmt.Errorf("%w: stacks checksum mismatch", ErrInvalidChecksum)
+	}
+	copy(hash[:], data)
+	if EncodeStacksAddress(version, hash) != address {
+		return 0, hash, fmt.Errorf("%w: non-canonical stacks address", ErrInvalidAddress)
+	}
+	return version, hash, nil
+}
+
+// StacksAccountID is the interface for Stacks account IDs.
+type StacksAccountID interface {
+	AccountID
+	// Network returns the Stacks network.
+	Network() StacksNetwork
+	// Version returns the address version byte.
+	Version() byte
+	// Hash160 returns the hash160 of the public key or redeem script,
+	// the same value used by the equivalent Bitcoin address.
+	Hash160() [StacksHashLength]byte
+	// IsMultisig returns true for P2SH (SM/SN) addresses.
+	IsMultisig() bool
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+}
+
+// Ensure stacksAccountID implements StacksAccountID at compile time
+var _ StacksAccountID = (*stacksAccountID)(nil)
+
+func init() {
+	RegisterParser(&stacksParser{})
+}
+
+// stacksAccountID represents a Stacks account ID per CAIP-10.
+type stacksAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	version           byte
+	hash              [StacksHashLength]byte
+}
+
+// NewStacks creates a new StacksAccountID from a version byte and hash160.
+func NewStacks(network StacksNetwork, version byte, hash [StacksHashLength]byte) StacksAccountID {
+	return &stacksAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceStacks, network.String(), EncodeStacksAddress(version, hash)),
+		version:          version,
+		hash:             hash,
+	}
+}
+
+// NewStacksFromAddress creates a new StacksAccountID from a c32check address.
+// Mainnet requires SP/SM addresses and testnet requires ST/SN addresses.
+func NewStacksFromAddress(network StacksNetwork, address string) (StacksAccountID, error) {
+	if err := validateReference(NamespaceStacks, string(network)); err != nil {
+		return nil, err
+	}
+	version, hash, err := DecodeStacksAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	mainnet := version == StacksVersionMainnetP2PKH || version == StacksVersionMainnetP2SH
+	if (network == StacksMainnet) != mainnet {
+		return nil, fmt.Errorf("%w: stacks address version %d does not match network %s", ErrInvalidAddress, version, network)
+	}
+	return NewStacks(network, version, hash), nil
+}
+
+// MustNewStacksFromAddress creates a new StacksAccountID and panics if invalid.
+func MustNewStacksFromAddress(network StacksNetwork, address string) StacksAccountID {
+	a, err := NewStacksFromAddress(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Stacks network.
+func (a *stacksAccountID) Network() StacksNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return StacksNetwork(a.Reference())
+}
+
+// Version returns the address version byte.
+func (a *stacksAccountID) Version() byte {
+	if a == nil {
+		return 0
+	}
+	return a.version
+}
+
+// Hash160 returns the hash160 of the public key or redeem script.
+func (a *stacksAccountID) Hash160() [StacksHashLength]byte {
+	if a == nil {
+		return [StacksHashLength]byte{}
+	}
+	return a.hash
+}
+
+// IsMultisig returns true for P2SH (SM/SN) addresses.
+func (a *stacksAccountID) IsMultisig() bool {
+	v := a.Version()
+	return v == StacksVersionMainnetP2SH || v == StacksVersionTestnetP2SH
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *stacksAccountID) IsMainnet() bool {
+	return a.Network() == StacksMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *stacksAccountID) IsTestnet() bool {
+	return a.Network() == StacksTestnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *stacksAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *stacksAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- stacksParser ---
+
+type stacksParser struct{}
+
+func (p *stacksParser) Namespace() Namespace {
+	return NamespaceStacks
+}
+
+func (p *stacksParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceStacks {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceStacks, ns)
+	}
+	return NewStacksFromAddress(StacksNetwork(ref), addr)
+}
+
+func (p *stacksParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewStacksFromAddress(StacksNetwork(reference), address)
+}
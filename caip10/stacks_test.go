@@ -0,0 +1,96 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stacksMainnetAddress = "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"
+	stacksTestnetAddress = "ST2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKQYAC0RQ"
+	stacksHash160        = "a46ff88886c2ef9762d970b4d2c63678835bd39d"
+)
+
+func TestStacksParse(t *testing.T) {
+	input := "stacks:1:" + stacksMainnetAddress
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	stx, ok := a.(StacksAccountID)
+	require.True(t, ok, "expected StacksAccountID, got %T", a)
+	assert.Equal(t, StacksMainnet, stx.Network())
+	assert.Equal(t, StacksVersionMainnetP2PKH, stx.Version())
+	assert.False(t, stx.IsMultisig())
+	assert.True(t, stx.IsMainnet())
+	assert.Equal(t, ChainIDStacksMainnet, stx.ChainID())
+
+	hash := stx.Hash160()
+	assert.Equal(t, stacksHash160, hex.EncodeToString(hash[:]))
+	assert.Equal(t, input, stx.String())
+}
+
+func TestStacksEncode(t *testing.T) {
+	var hash [StacksHashLength]byte
+	b, _ := hex.DecodeString(stacksHash160)
+	copy(hash[:], b)
+
+	assert.Equal(t, stacksMainnetAddress, EncodeStacksAddress(StacksVersionMainnetP2PKH, hash))
+	assert.Equal(t, stacksTestnetAddress, EncodeStacksAddress(StacksVersionTestnetP2PKH, hash))
+	assert.Equal(t, "stacks:2147483648:"+stacksTestnetAddress, NewStacks(StacksTestnet, StacksVersionTestnetP2PKH, hash).String())
+
+	// Leading zero bytes are kept as '0' characters
+	zero := EncodeStacksAddress(StacksVersionMainnetP2PKH, [StacksHashLength]byte{})
+	assert.Equal(t, "SP000000000000000000002Q6VF78", zero)
+	_, decoded, err := DecodeStacksAddress(zero)
+	require.NoError(t, err)
+	assert.Equal(t, [StacksHashLength]byte{}, decoded)
+}
+
+func TestStacksMultisig(t *testing.T) {
+	var hash [StacksHashLength]byte
+	hash[0] = 1
+	addr := EncodeStacksAddress(StacksVersionMainnetP2SH, hash)
+	assert.Equal(t, "SM", addr[:2])
+
+	a, err := NewStacksFromAddress(StacksMainnet, addr)
+	require.NoError(t, err)
+	assert.True(t, a.IsMultisig())
+}
+
+func TestStacksParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "stacks:mainnet:" + stacksMainnetAddress, ErrInvalidReference},
+		{"testnet address on mainnet", "stacks:1:" + stacksTestnetAddress, ErrInvalidAddress},
+		{"mainnet address on testnet", "stacks:2147483648:" + stacksMainnetAddress, ErrInvalidAddress},
+		{"bad checksum", "stacks:1:SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ8", ErrInvalidAddress},
+		{"unknown version", "stacks:1:SX2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", ErrInvalidAddress},
+		{"lowercase", "stacks:1:sp2j6zy48gv1ez5v2v5rb9mp66sw86pykknrv9ej7", ErrInvalidAddress},
+		{"invalid character", "stacks:1:SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJU", ErrInvalidAddress},
+		{"too short", "stacks:1:SP2J6ZY48", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestStacksNilReceiver(t *testing.T) {
+	var a *stacksAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, StacksNetwork(""), a.Network())
+	assert.Equal(t, byte(0), a.Version())
+	assert.Equal(t, [StacksHashLength]byte{}, a.Hash160())
+	assert.False(t, a.IsMultisig())
+}
@@ -0,0 +1,21 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// starknetFieldPrime is the prime of the Starknet field, 2^251 + 17*2^192 + 1.
+var starknetFieldPrime = new(big.Int).Add(
+	new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 251), new(big.Int).Lsh(big.NewInt(17), 192)),
+	big.NewInt(1),
+)
+
+// validateStarknetFelt validates that a Starknet address is a field element.
+func validateStarknetFelt(address string) error {
+	v, ok := new(big.Int).SetString(address[2:], 16)
+	if !ok || v.Cmp(starknetFieldPrime) >= 0 {
+		return fmt.Errorf("%w: Starknet address %q is not a field element", ErrInvalidAddress, address)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package caip10
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+)
+
+const NamespaceStellar Namespace = "stellar"
+
+// StellarNetwork represents a Stellar network (chain reference).
+// https://github.com/ChainAgnostic/namespaces/blob/main/stellar/caip10.md
+type StellarNetwork string
+
+// Stellar networks
+const (
+	StellarPubnet  StellarNetwork = "pubnet"
+	StellarTestnet StellarNetwork = "testnet"
+)
+
+// String returns the network reference string.
+func (n StellarNetwork) String() string {
+	return string(n)
+}
+
+// StellarPublicKeyLength is the length of a raw ed25519 account public key.
+const StellarPublicKeyLength = 32
+
+// stellarAddressLength is the length of a strkey-encoded account public key:
+// base32 of 1 version byte, 32 key bytes and a 2 byte checksum.
+const stellarAddressLength = 56
+
+// stellarVersionAccountID is the strkey version byte of ed25519 public keys ('G').
+const stellarVersionAccountID byte = 6 << 3
+
+// stellarEncoding is the unpadded RFC 4648 base32 alphabet used by strkey.
+var stellarEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// crc16XModem computes the CRC16-XModem checksum used by strkey.
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// EncodeStellarAddress encodes a raw ed25519 public key as a G... strkey address.
+func EncodeStellarAddress(pubkey [StellarPublicKeyLength]byte) string {
+	data := make([]byte, 0, 1+StellarPublicKeyLength+2)
+	data = append(data, stellarVersionAccountID)
+	data = append(data, pubkey[:]...)
+	data = binary.LittleEndian.AppendUint16(data, crc16XModem(data))
+	return stellarEncoding.EncodeToString(data)
+}
+
+// DecodeStellarAddress decodes a G... strkey address into its raw ed25519 public key.
+//
+// Validation steps:
+//  1. Length is exactly 56 characters
+//  2. Base32 decode succeeds (uppercase alphabet, no padding)
+//  3. Version byte is the account ID version ('G')
+//  4. CRC16-XModem checksum matches
+func DecodeStellarAddress(address string) ([StellarPublicKeyLength]byte, error) {
+	var pubkey [StellarPublicKeyLength]byte
+	if len(address) != stellarAddressLength {
+		return pubkey, fmt.Errorf("%w: stellar address must be %d characters, got %d",
+			ErrInvalidAddress, stellarAddressLength, len(address))
+	}
+
+	data, err := stellarEncoding.DecodeString(address)
+	if err != nil {
+		return pubkey, fmt.Errorf("%w: invalid base32 encoding", ErrInvalidAddress)
+	}
+	if data[0] != stellarVersionAccountID {
+		return pubkey, fmt.Errorf("%w: stellar address must be an account public key (G...)", ErrInvalidAddress)
+	}
+
+	body := data[:len(data)-2]
+	if binary.LittleEndian.Uint16(data[len(data)-2:]) != crc16XModem(body) {
+		return pubkey, fmt.Errorf("%w: stellar checksum mismatch", ErrInvalidChecksum)
+	}
+	copy(pubkey[:], body[1:])
+	return pubkey, nil
+}
+
+// ValidateStellarAddress validates a Stellar account address string.
+// Returns nil if valid, error otherwise.
+func ValidateStellarAddress(address string) error {
+	_, err := DecodeStellarAddress(address)
+	return err
+}
+
+// StellarAccountID is the interface for Stellar account IDs.
+type StellarAccountID interface {
+	AccountID
+	// Network returns the Stellar network.
+	Network() StellarNetwork
+	// PublicKey returns the raw ed25519 public key.
+	PublicKey() [StellarPublicKeyLength]byte
+	// IsPubnet returns true if this is a pubnet account.
+	IsPubnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+}
+
+// Ensure stellarAccountID implements StellarAccountID at compile time
+var _ StellarAccountID = (*stellarAccountID)(nil)
+
+func init() {
+	RegisterParser(&stellarParser{})
+}
+
+// stellarAccountID represents a Stellar account ID per CAIP-10.
+type stellarAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	pubkey            [StellarPublicKeyLength]byte
+}
+
+// NewStellar creates a new StellarAccountID from a raw ed25519 public key.
+func NewStellar(network StellarNetwork, pubkey [StellarPublicKeyLength]byte) StellarAccountID {
+	return &stellarAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceStellar, network.String(), EncodeStellarAddress(pubkey)),
+		pubkey:           pubkey,
+	}
+}
+
+// NewStellarFromStrKey creates a new StellarAccountID from a G... strkey address.
+func NewStellarFromStrKey(network StellarNetwork, address string) (StellarAccountID, error) {
+	if err := validateReference(NamespaceStellar, string(network)); err != nil {
+		return nil, err
+	}
+	pubkey, err := DecodeStellarAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return NewStellar(network, pubkey), nil
+}
+
+// MustNewStellarFromStrKey creates a new StellarAccountID from a strkey address and panics if invalid.
+func MustNewStellarFromStrKey(network StellarNetwork, address string) StellarAccountID {
+	a, err := NewStellarFromStrKey(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the Stellar network.
+func (a *stellarAccountID) Network() StellarNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return StellarNetwork(a.Reference())
+}
+
+// PublicKey returns the raw ed25519 public key.
+func (a *stellarAccountID) PublicKey() [StellarPublicKeyLength]byte {
+	if a == nil {
+		return [StellarPublicKeyLength]byte{}
+	}
+	return a.pubkey
+}
+
+// IsPubnet returns true if this is a pubnet account.
+func (a *stellarAccountID) IsPubnet() bool {
+	return a.Network() == StellarPubnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *stellarAccountID) IsTestnet() bool {
+	return a.Network() == StellarTestnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *stellarAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *stellarAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- stellarParser ---
+
+type stellarParser struct{}
+
+func (p *stellarParser) Namespace() Namespace {
+	return NamespaceStellar
+}
+
+func (p *stellarParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceStellar {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceStellar, ns)
+	}
+	return NewStellarFromStrKey(StellarNetwork(ref), addr)
+}
+
+func (p *stellarParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewStellarFromStrKey(StellarNetwork(reference), address)
+}
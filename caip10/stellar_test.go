@@ -0,0 +1,81 @@
+package caip10
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SEP-23 account ID test vector
+const (
+	stellarAddress   = "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+	stellarPublicKey = "3f0c34bf93ad0d9971d04ccc90f705511c838aad9734a4a2fb0d7a03fc7fe89a"
+)
+
+func TestStellarAddressRoundTrip(t *testing.T) {
+	pubkey, err := DecodeStellarAddress(stellarAddress)
+	require.NoError(t, err)
+	assert.Equal(t, stellarPublicKey, hex.EncodeToString(pubkey[:]))
+	assert.Equal(t, stellarAddress, EncodeStellarAddress(pubkey))
+}
+
+func TestStellarParse(t *testing.T) {
+	input := "stellar:pubnet:" + stellarAddress
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	xlm, ok := a.(StellarAccountID)
+	require.True(t, ok, "expected StellarAccountID, got %T", a)
+	assert.Equal(t, StellarPubnet, xlm.Network())
+	assert.True(t, xlm.IsPubnet())
+	assert.False(t, xlm.IsTestnet())
+	assert.Equal(t, ChainIDStellarPubnet, xlm.ChainID())
+	pubkey := xlm.PublicKey()
+	assert.Equal(t, stellarPublicKey, hex.EncodeToString(pubkey[:]))
+	assert.Equal(t, input, xlm.String())
+}
+
+func TestNewStellar(t *testing.T) {
+	pubkey, err := DecodeStellarAddress(stellarAddress)
+	require.NoError(t, err)
+
+	a := NewStellar(StellarTestnet, pubkey)
+	assert.Equal(t, "stellar:testnet:"+stellarAddress, a.String())
+	assert.True(t, a.IsTestnet())
+	assert.NoError(t, a.Validate())
+}
+
+func TestStellarParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"unknown network", "stellar:mainnet:" + stellarAddress, ErrInvalidReference},
+		{"bad checksum", "stellar:pubnet:GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGA", ErrInvalidAddress},
+		{"lowercase", "stellar:pubnet:ga7qynf7sowq3glr2bgmzehxavirza4kvwltjjfc7mgxua74p7ujvsgz", ErrInvalidAddress},
+		{"seed version", "stellar:pubnet:SBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHOKR", ErrInvalidAddress},
+		{"muxed account", "stellar:pubnet:MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ", ErrInvalidAddress},
+		{"too short", "stellar:pubnet:GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJ", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestStellarNilReceiver(t *testing.T) {
+	var a *stellarAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, StellarNetwork(""), a.Network())
+	assert.Equal(t, [StellarPublicKeyLength]byte{}, a.PublicKey())
+	assert.False(t, a.IsPubnet())
+	assert.False(t, a.IsTestnet())
+}
@@ -0,0 +1,172 @@
+package caip10
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+// TokenMetadata is descriptive information about a token.
+type TokenMetadata struct {
+	Asset    AssetID
+	Symbol   string // e.g. "USDC"
+	Name     string // e.g. "USD Coin"
+	Decimals uint8
+	LogoURI  string
+}
+
+// defaultTokens are the tokens registered at package initialization.
+var defaultTokens = []TokenMetadata{
+	{Asset: NewERC20Asset(1, ecommon.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewERC20Asset(1, ecommon.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")), Symbol: "USDT", Name: "Tether USD", Decimals: 6},
+	{Asset: NewERC20Asset(1, ecommon.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")), Symbol: "DAI", Name: "Dai Stablecoin", Decimals: 18},
+	{Asset: NewERC20Asset(1, ecommon.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")), Symbol: "WETH", Name: "Wrapped Ether", Decimals: 18},
+	{Asset: NewERC20Asset(1, ecommon.HexToAddress("0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599")), Symbol: "WBTC", Name: "Wrapped BTC", Decimals: 8},
+	{Asset: NewERC20Asset(10, ecommon.HexToAddress("0x0b2C639c533813f4Aa9D7837cAf62653d097Ff85")), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewERC20Asset(137, ecommon.HexToAddress("0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359")), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewERC20Asset(8453, ecommon.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewERC20Asset(42161, ecommon.HexToAddress("0xaf88d065e77c8cC2239327C5EDb3A432268e5831")), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewSolanaTokenAsset(SolanaMainnet, MustNewSolanaFromBase58(SolanaMainnet, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v").Account()), Symbol: "USDC", Name: "USD Coin", Decimals: 6},
+	{Asset: NewSolanaTokenAsset(SolanaMainnet, MustNewSolanaFromBase58(SolanaMainnet, "Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB").Account()), Symbol: "USDT", Name: "Tether USD", Decimals: 6},
+}
+
+var (
+	tokenMetadataMu sync.RWMutex
+	tokenMetadata   = map[string]TokenMetadata{}
+)
+
+func init() {
+	for _, meta := range defaultTokens {
+		tokenMetadata[tokenKey(meta.Asset)] = meta
+	}
+}
+
+// tokenKey returns the registry key of an asset. EVM hex references are lower cased.
+func tokenKey(a AssetID) string {
+	if a.ChainID().Namespace == NamespaceEIP155 {
+		return strings.ToLower(a.String())
+	}
+	return a.String()
+}
+
+// RegisterTokenMetadata registers or replaces the metadata of a token. The
+// metadata is used for the decimals and symbol of AssetAmount.
+func RegisterTokenMetadata(meta TokenMetadata) error {
+	if meta.Asset == nil || meta.Asset.IsZero() {
+		return ErrEmptyValue
+	}
+	if err := meta.Asset.Validate(); err != nil {
+		return err
+	}
+
+	tokenMetadataMu.Lock()
+	defer tokenMetadataMu.Unlock()
+	tokenMetadata[tokenKey(meta.Asset)] = meta
+	return nil
+}
+
+// LookupTokenMetadata returns the metadata registered for a token.
+func LookupTokenMetadata(asset AssetID) (TokenMetadata, bool) {
+	if asset == nil || asset.IsZero() {
+		return TokenMetadata{}, false
+	}
+	tokenMetadataMu.RLock()
+	defer tokenMetadataMu.RUnlock()
+	meta, ok := tokenMetadata[tokenKey(asset)]
+	return meta, ok
+}
+
+// LookupTokenBySymbol returns the token registered on a chain with the symbol,
+// compared case-insensitively. If several match, the first by asset ID is returned.
+func LookupTokenBySymbol(chainID ChainID, symbol string) (TokenMetadata, bool) {
+	for _, meta := range RegisteredTokens(chainID) {
+		if strings.EqualFold(meta.Symbol, symbol) {
+			return meta, true
+		}
+	}
+	return TokenMetadata{}, false
+}
+
+// RegisteredTokens returns the tokens with registered metadata on a chain, or on
+// all chains if chainID is zero, sorted by asset ID.
+func RegisteredTokens(chainID ChainID) []TokenMetadata {
+	tokenMetadataMu.RLock()
+	defer tokenMetadataMu.RUnlock()
+	var out []TokenMetadata
+	for _, meta := range tokenMetadata {
+		if chainID.IsZero() || meta.Asset.ChainID() == chainID {
+			out = append(out, meta)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Asset.String() < out[j].Asset.String() })
+	return out
+}
+
+// tokenListEntry is one token of a Uniswap token list; unused fields are omitted.
+type tokenListEntry struct {
+	ChainID  uint64 `json:"chainId"`
+	Address  string `json:"address"`
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	LogoURI  string `json:"logoURI"`
+}
+
+// ParseTokenList decodes a token list in the Uniswap format into ERC-20 token
+// metadata. https://github.com/Uniswap/token-lists
+func ParseTokenList(r io.Reader) ([]TokenMetadata, error) {
+	var list struct {
+		Tokens []tokenListEntry `json:"tokens"`
+	}
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, fmt.Errorf("%w: token list: %v", ErrInvalidFormat, err)
+	}
+
+	out := make([]TokenMetadata, 0, len(list.Tokens))
+	for _, t := range list.Tokens {
+		if !ecommon.IsHexAddress(t.Address) {
+			return nil, fmt.Errorf("%w: token list: invalid address %q", ErrInvalidAssetReference, t.Address)
+		}
+		out = append(out, TokenMetadata{
+			Asset:    NewERC20Asset(t.ChainID, ecommon.HexToAddress(t.Address)),
+			Symbol:   t.Symbol,
+			Name:     t.Name,
+			Decimals: t.Decimals,
+			LogoURI:  t.LogoURI,
+		})
+	}
+	return out, nil
+}
+
+// ImportTokenList parses a Uniswap token list and registers the token metadata.
+// Returns the number of tokens registered.
+func ImportTokenList(r io.Reader) (int, error) {
+	tokens, err := ParseTokenList(r)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, meta := range tokens {
+		if err := RegisterTokenMetadata(meta); err != nil {
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// ImportTokenListFile imports a Uniswap token list file.
+func ImportTokenListFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return ImportTokenList(f)
+}
@@ -0,0 +1,93 @@
+package caip10
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTokens(t *testing.T) {
+	usdc := MustParseAssetID("eip155:1/erc20:0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	meta, ok := LookupTokenMetadata(usdc)
+	require.True(t, ok)
+	assert.Equal(t, "USDC", meta.Symbol)
+	assert.Equal(t, uint8(6), meta.Decimals)
+
+	lower := MustNewGenericAsset(ChainIDEthereumMainnet, AssetNamespaceERC20, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "")
+	_, ok = LookupTokenMetadata(lower)
+	assert.True(t, ok, "EVM references match case-insensitively")
+
+	sol, ok := LookupTokenBySymbol(ChainIDSolanaMainnet, "usdt")
+	require.True(t, ok)
+	assert.Equal(t, "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/token:Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB", sol.Asset.String())
+
+	for _, meta := range defaultTokens {
+		assert.NoError(t, meta.Asset.Validate(), meta.Asset.String())
+	}
+	assert.Len(t, RegisteredTokens(ChainIDBase), 1)
+
+	_, ok = LookupTokenMetadata(nil)
+	assert.False(t, ok)
+}
+
+func TestTokenAssetAmount(t *testing.T) {
+	usdc := MustParseAssetID("eip155:1/erc20:0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	a, err := ParseAssetAmount(usdc, "1.5 USDC")
+	require.NoError(t, err)
+	assert.Equal(t, "1500000", a.Raw.String())
+	assert.Equal(t, "1.5 USDC", a.String())
+}
+
+func TestRegisterTokenMetadata(t *testing.T) {
+	asset := NewERC20Asset(uint64(7654321), ecommon.HexToAddress("0x00000000000000000000000000000000000000aa"))
+	require.NoError(t, RegisterTokenMetadata(TokenMetadata{Asset: asset, Symbol: "TKN", Decimals: 2}))
+	a, err := NewAssetAmount(asset, big.NewInt(150))
+	require.NoError(t, err)
+	assert.Equal(t, "1.5 TKN", a.String())
+
+	assert.True(t, errors.Is(RegisterTokenMetadata(TokenMetadata{}), ErrEmptyValue))
+	bad := newGenericAssetUnchecked(ChainIDEthereumMainnet, AssetNamespaceERC20, "0x12", "")
+	assert.Error(t, RegisterTokenMetadata(TokenMetadata{Asset: bad}))
+}
+
+const testTokenList = `{
+  "name": "Test List",
+  "timestamp": "2024-01-01T00:00:00Z",
+  "version": {"major": 1, "minor": 0, "patch": 0},
+  "tokens": [
+    {"chainId": 6543210, "address": "0x00000000000000000000000000000000000000bb", "name": "Test Token", "symbol": "TT", "decimals": 9, "logoURI": "https://logo.example/tt.png"},
+    {"chainId": 6543210, "address": "0x00000000000000000000000000000000000000cc", "name": "Other", "symbol": "OT", "decimals": 18}
+  ]
+}`
+
+func TestImportTokenList(t *testing.T) {
+	n, err := ImportTokenList(strings.NewReader(testTokenList))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	tokens := RegisteredTokens(NewEIP155ChainID(6543210))
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "TT", tokens[0].Symbol)
+	assert.Equal(t, "https://logo.example/tt.png", tokens[0].LogoURI)
+	assert.Equal(t, uint8(9), tokens[0].Decimals)
+
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(path, []byte(testTokenList), 0o600))
+	n, err = ImportTokenListFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	_, err = ImportTokenList(strings.NewReader(`{"tokens": [{"chainId": 1, "address": "nope"}]}`))
+	assert.True(t, errors.Is(err, ErrInvalidAssetReference), "got %v", err)
+	_, err = ImportTokenList(strings.NewReader(`[`))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	_, err = ImportTokenListFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package caip10
+
+import "fmt"
+
+// TOML support. MarshalTOML and UnmarshalTOML match the Marshaler and Unmarshaler
+// interfaces of github.com/BurntSushi/toml and github.com/pelletier/go-toml (v1);
+// github.com/pelletier/go-toml/v2 and map keys use MarshalText and UnmarshalText.
+// Values are encoded as TOML strings; a zero value is the empty string. BurntSushi/toml
+// only decodes maps with string keys, so configs keyed by chain ID use map[string]T there.
+
+// MarshalTOML encodes the account as a TOML string.
+func (a *GenericAccountID) MarshalTOML() ([]byte, error) {
+	return []byte(`"` + a.String() + `"`), nil
+}
+
+// UnmarshalTOML decodes a TOML string into the account.
+func (a *GenericAccountID) UnmarshalTOML(v any) error {
+	s, err := tomlString("account ID", v)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*a = GenericAccountID{}
+		return nil
+	}
+	if err := a.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("toml: account ID %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalTOML encodes the chain ID as a TOML string.
+func (c ChainID) MarshalTOML() ([]byte, error) {
+	return []byte(`"` + c.String() + `"`), nil
+}
+
+// UnmarshalTOML decodes a TOML string into the chain ID.
+func (c *ChainID) UnmarshalTOML(v any) error {
+	s, err := tomlString("chain ID", v)
+	if err != nil {
+		return err
+	}
+	if err := c.UnmarshalText([]byte(s)); err != nil {
+		return fmt.Errorf("toml: chain ID %q: %w", s, err)
+	}
+	return nil
+}
+
+func tomlString(what string, v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("%w: toml: %s must be a string, got %T", ErrInvalidFormat, what, v)
+	}
+}
@@ -0,0 +1,105 @@
+package caip10
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	pelletier "github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tomlDeployment struct {
+	Owner     *GenericAccountID   `toml:"owner"`
+	Chain     ChainID             `toml:"chain"`
+	Contracts map[string]string   `toml:"contracts"`
+	Admins    []*GenericAccountID `toml:"admins"`
+}
+
+// BurntSushi/toml does not decode into maps with non-string keys.
+type tomlPelletierDeployment struct {
+	Owner     *GenericAccountID  `toml:"owner"`
+	Chain     ChainID            `toml:"chain"`
+	Contracts map[ChainID]string `toml:"contracts"`
+}
+
+const tomlDeploymentDoc = `owner = "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"
+chain = "eip155:137"
+admins = ["solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:7S3P4HxJpyyigGzodYwHtCxZyUQe9JiBMHyRWXArAaKv"]
+
+[contracts]
+"eip155:1" = "0x1111"
+"eip155:10" = "0x2222"
+`
+
+func TestTOMLBurntSushi(t *testing.T) {
+	var d tomlDeployment
+	_, err := toml.Decode(tomlDeploymentDoc, &d)
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", d.Owner.String())
+	assert.Equal(t, ChainIDPolygon, d.Chain)
+	assert.Equal(t, "0x2222", d.Contracts["eip155:10"])
+	require.Len(t, d.Admins, 1)
+	assert.Equal(t, NamespaceSolana, d.Admins[0].Namespace())
+
+	out, err := toml.Marshal(d)
+	require.NoError(t, err)
+	var back tomlDeployment
+	_, err = toml.Decode(string(out), &back)
+	require.NoError(t, err)
+	assert.True(t, d.Owner.Equal(back.Owner))
+	assert.Equal(t, d.Chain, back.Chain)
+	assert.Equal(t, d.Contracts, back.Contracts)
+}
+
+func TestTOMLPelletier(t *testing.T) {
+	var d tomlPelletierDeployment
+	require.NoError(t, pelletier.Unmarshal([]byte(tomlDeploymentDoc), &d))
+	assert.Equal(t, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", d.Owner.String())
+	assert.Equal(t, ChainIDPolygon, d.Chain)
+	assert.Equal(t, "0x2222", d.Contracts[NewEIP155ChainID(10)])
+
+	out, err := pelletier.Marshal(d)
+	require.NoError(t, err)
+	var back tomlPelletierDeployment
+	require.NoError(t, pelletier.Unmarshal(out, &back))
+	assert.True(t, d.Owner.Equal(back.Owner))
+	assert.Equal(t, d.Contracts, back.Contracts)
+}
+
+func TestTOMLErrors(t *testing.T) {
+	var d tomlDeployment
+	_, err := toml.Decode(`owner = "eip155:1:0x12"`, &d)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `account ID "eip155:1:0x12"`)
+
+	_, err = toml.Decode(`chain = "eip155"`, &d)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain ID")
+
+	_, err = toml.Decode(`owner = 42`, &d)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be a string, got int64")
+
+	var a GenericAccountID
+	err = a.UnmarshalTOML("eip155:1:0x12")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	err = a.UnmarshalTOML(int64(42))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+}
+
+func TestTOMLZero(t *testing.T) {
+	a := &GenericAccountID{}
+	data, err := a.MarshalTOML()
+	require.NoError(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	b := MustNewGeneric("cosmos", "cosmoshub-3", "cosmos1abc")
+	require.NoError(t, b.UnmarshalTOML(""))
+	assert.True(t, b.IsZero())
+
+	var c ChainID
+	require.NoError(t, c.UnmarshalTOML(""))
+	assert.True(t, c.IsZero())
+}
@@ -0,0 +1,344 @@
+package caip10
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const NamespaceTON Namespace = "ton"
+
+// TONNetwork represents a TON network (chain reference), identified by its global id.
+type TONNetwork string
+
+// TON networks
+const (
+	TONMainnet TONNetwork = "-239"
+	TONTestnet TONNetwork = "-3"
+)
+
+// String returns the network reference string.
+func (n TONNetwork) String() string {
+	return string(n)
+}
+
+// TON workchains
+const (
+	TONMasterchain int8 = -1
+	TONBasechain   int8 = 0
+)
+
+// TONHashLength is the length of an account's state hash.
+const TONHashLength = 32
+
+// tonUserFriendlyLength is the base64 length of a user-friendly address:
+// 1 flag byte, 1 workchain byte, 32 hash bytes and a 2 byte checksum.
+const tonUserFriendlyLength = 48
+
+// User-friendly address flag bits
+const (
+	tonFlagBounceable    byte = 0x11
+	tonFlagNonBounceable byte = 0x51
+	tonFlagTestnetOnly   byte = 0x80
+)
+
+// TONAddress is a decoded TON account address.
+type TONAddress struct {
+	Workchain int8
+	Hash      [TONHashLength]byte
+	// Bounceable and TestnetOnly are only meaningful for user-friendly addresses.
+	Bounceable  bool
+	TestnetOnly bool
+	// UserFriendly is true if the address was decoded from the user-friendly form.
+	UserFriendly bool
+}
+
+// Raw returns the raw form <workchain>:<hex hash>.
+func (a TONAddress) Raw() string {
+	return strconv.Itoa(int(a.Workchain)) + ":" + hex.EncodeToString(a.Hash[:])
+}
+
+// UserFriendlyString returns the url-safe base64 user-friendly form using the given flags.
+func (a TONAddress) UserFriendlyString(bounceable, testnetOnly bool) string {
+	flag := tonFlagNonBounceable
+	if bounceable {
+		flag = tonFlagBounceable
+	}
+	if testnetOnly {
+		flag |= tonFlagTestnetOnly
+	}
+	data := make([]byte, 0, 36)
+	data = append(data, flag, byte(a.Workchain))
+	data = append(data, a.Hash[:]...)
+	data = binary.BigEndian.AppendUint16(data, crc16XModem(data))
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// ParseTONAddress decodes a raw (0:abcd...) or user-friendly (EQ.../UQ...) TON address.
+// User-friendly addresses may use either the url-safe or the standard base64 alphabet.
+func ParseTONAddress(address string) (TONAddress, error) {
+	if strings.Contains(address, ":") {
+		return parseTONRawAddress(address)
+	}
+	return parseTONUserFriendlyAddress(address)
+}
+
+func parseTONRawAddress(address string) (TONAddress, error) {
+	var addr TONAddress
+	wc, hash, _ := strings.Cut(address, ":")
+	workchain, err := strconv.ParseInt(wc, 10, 8)
+	if err != nil {
+		return addr, fmt.Errorf("%w: invalid TON workchain %q", ErrInvalidAddress, wc)
+	}
+	if len(hash) != 2*TONHashLength {
+		return addr, fmt.Errorf("%w: TON account hash must be %d hex characters, got %d",
+			ErrInvalidAddress, 2*TONHashLength, len(hash))
+	}
+	if _, err := hex.Decode(addr.Hash[:], []byte(hash)); err != nil {
+		return addr, fmt.Errorf("%w: invalid TON account hash", ErrInvalidAddress)
+	}
+	addr.Workchain = int8(workchain)
+	return addr, nil
+}
+
+func parseTONUserFriendlyAddress(address string) (TONAddress, error) {
+	var addr TONAddress
+	if len(address) != tonUserFriendlyLength {
+		return addr, fmt.Errorf("%w: TON user-friendly address must be %d characters, got %d",
+			ErrInvalidAddress, tonUserFriendlyLength, len(address))
+	}
+
+	enc := base64.URLEncoding
+	if strings.ContainsAny(address, "+/") {
+		enc = base64.StdEncoding
+	}
+	data, err := enc.DecodeString(address)
+	if err != nil {
+		return addr, fmt.Errorf("%w: invalid base64 encoding", ErrInvalidAddress)
+	}
+
+	body := data[:len(data)-2]
+	if binary.BigEndian.Uint16(data[len(data)-2:]) != crc16XModem(body) {
+		return addr, fmt.Errorf("%w: TON checksum mismatch", ErrInvalidChecksum)
+	}
+
+	flag := body[0]
+	addr.TestnetOnly = flag&tonFlagTestnetOnly != 0
+	switch flag &^ tonFlagTestnetOnly {
+	case tonFlagBounceable:
+		addr.Bounceable = true
+	case tonFlagNonBounceable:
+	default:
+		return addr, fmt.Errorf("%w: unknown TON address flag 0x%02x", ErrInvalidAddress, flag)
+	}
+	addr.Workchain = int8(body[1])
+	copy(addr.Hash[:], body[2:])
+	addr.UserFriendly = true
+	return addr, nil
+}
+
+// TONAccountID is the interface for TON account IDs.
+type TONAccountID interface {
+	AccountID
+	// Network returns the TON network.
+	Network() TONNetwork
+	// Workchain returns the account workchain (-1 masterchain, 0 basechain).
+	Workchain() int8
+	// Hash returns the 32-byte account hash.
+	Hash() [TONHashLength]byte
+	// TONAddress returns the decoded address.
+	TONAddress() TONAddress
+	// RawAddress returns the raw form <workchain>:<hex hash>.
+	RawAddress() string
+	// UserFriendlyAddress returns the url-safe base64 user-friendly form.
+	// The testnet-only flag is set for testnet accounts.
+	UserFriendlyAddress(bounceable bool) string
+	// ToRaw returns a TONAccountID using the raw address form.
+	ToRaw() TONAccountID
+	// ToUserFriendly returns a TONAccountID using the user-friendly address form.
+	ToUserFriendly(bounceable bool) TONAccountID
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+	// IsTestnet returns true if this is a testnet account.
+	IsTestnet() bool
+}
+
+// Ensure tonAccountID implements TONAccountID at compile time
+var _ TONAccountID = (*tonAccountID)(nil)
+
+func init() {
+	RegisterParser(&tonParser{})
+}
+
+// tonAccountID represents a TON account ID per CAIP-10.
+type tonAccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+	addr              TONAddress
+}
+
+// NewTON creates a new TONAccountID in raw address form.
+func NewTON(network TONNetwork, workchain int8, hash [TONHashLength]byte) TONAccountID {
+	addr := TONAddress{Workchain: workchain, Hash: hash}
+	return newTON(network, addr, addr.Raw())
+}
+
+func newTON(network TONNetwork, addr TONAddress, address string) *tonAccountID {
+	return &tonAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceTON, network.String(), address),
+		addr:             addr,
+	}
+}
+
+// NewTONFromString creates a new TONAccountID from a raw or user-friendly address.
+// The address form is preserved; use ToRaw or ToUserFriendly to convert.
+// Validation:
+//  1. Raw form: workchain fits in int8 and hash is 64 hex characters
+//  2. User-friendly form: base64 decodes to 36 bytes with a known flag byte
+//  3. User-friendly form: CRC16 checksum matches
+//  4. Testnet-only addresses are rejected on mainnet
+func NewTONFromString(network TONNetwork, address string) (TONAccountID, error) {
+	if err := validateReference(NamespaceTON, string(network)); err != nil {
+		return nil, err
+	}
+	addr, err := ParseTONAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if addr.TestnetOnly && network == TONMainnet {
+		return nil, fmt.Errorf("%w: testnet-only TON address on mainnet", ErrInvalidAddress)
+	}
+	return newTON(network, addr, address), nil
+}
+
+// MustNewTONFromString creates a new TONAccountID and panics if invalid.
+func MustNewTONFromString(network TONNetwork, address string) TONAccountID {
+	a, err := NewTONFromString(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the TON network.
+func (a *tonAccountID) Network() TONNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return TONNetwork(a.Reference())
+}
+
+// Workchain returns the account workchain.
+func (a *tonAccountID) Workchain() int8 {
+	if a == nil {
+		return 0
+	}
+	return a.addr.Workchain
+}
+
+// Hash returns the 32-byte account hash.
+func (a *tonAccountID) Hash() [TONHashLength]byte {
+	if a == nil {
+		return [TONHashLength]byte{}
+	}
+	return a.addr.Hash
+}
+
+// TONAddress returns the decoded address.
+func (a *tonAccountID) TONAddress() TONAddress {
+	if a == nil {
+		return TONAddress{}
+	}
+	return a.addr
+}
+
+// RawAddress returns the raw form <workchain>:<hex hash>.
+func (a *tonAccountID) RawAddress() string {
+	if a == nil {
+		return ""
+	}
+	return a.addr.Raw()
+}
+
+// UserFriendlyAddress returns the url-safe base64 user-friendly form.
+func (a *tonAccountID) UserFriendlyAddress(bounceable bool) string {
+	if a == nil {
+		return ""
+	}
+	return a.addr.UserFriendlyString(bounceable, a.IsTestnet())
+}
+
+// ToRaw returns a TONAccountID using the raw address form.
+func (a *tonAccountID) ToRaw() TONAccountID {
+	if a == nil {
+		return nil
+	}
+	return NewTON(a.Network(), a.addr.Workchain, a.addr.Hash)
+}
+
+// ToUserFriendly returns a TONAccountID using the user-friendly address form.
+func (a *tonAccountID) ToUserFriendly(bounceable bool) TONAccountID {
+	if a == nil {
+		return nil
+	}
+	addr := TONAddress{
+		Workchain:    a.addr.Workchain,
+		Hash:         a.addr.Hash,
+		Bounceable:   bounceable,
+		TestnetOnly:  a.IsTestnet(),
+		UserFriendly: true,
+	}
+	return newTON(a.Network(), addr, addr.UserFriendlyString(addr.Bounceable, addr.TestnetOnly))
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *tonAccountID) IsMainnet() bool {
+	return a.Network() == TONMainnet
+}
+
+// IsTestnet returns true if this is a testnet account.
+func (a *tonAccountID) IsTestnet() bool {
+	return a.Network() == TONTestnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *tonAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+// Raw and user-friendly forms of the same account are not equal; convert with ToRaw first.
+func (a *tonAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// --- tonParser ---
+
+type tonParser struct{}
+
+func (p *tonParser) Namespace() Namespace {
+	return NamespaceTON
+}
+
+func (p *tonParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceTON {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceTON, ns)
+	}
+	return NewTONFromString(TONNetwork(ref), addr)
+}
+
+func (p *tonParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewTONFromString(TONNetwork(reference), address)
+}
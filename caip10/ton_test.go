@@ -0,0 +1,133 @@
+package caip10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tonRaw           = "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"
+	tonBounceable    = "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N"
+	tonNonBounceable = "UQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqEBI"
+	tonTestnetOnly   = "kQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqKYH"
+)
+
+func TestTONParseRaw(t *testing.T) {
+	input := "ton:-239:" + tonRaw
+	a, err := Parse(input)
+	require.NoError(t, err)
+
+	ton, ok := a.(TONAccountID)
+	require.True(t, ok, "expected TONAccountID, got %T", a)
+	assert.Equal(t, TONMainnet, ton.Network())
+	assert.Equal(t, TONBasechain, ton.Workchain())
+	assert.False(t, ton.TONAddress().UserFriendly)
+	assert.Equal(t, ChainIDTONMainnet, ton.ChainID())
+	assert.Equal(t, input, ton.String())
+
+	assert.Equal(t, tonBounceable, ton.UserFriendlyAddress(true))
+	assert.Equal(t, tonNonBounceable, ton.UserFriendlyAddress(false))
+	assert.Equal(t, "ton:-239:"+tonBounceable, ton.ToUserFriendly(true).String())
+}
+
+func TestTONParseUserFriendly(t *testing.T) {
+	tests := []struct {
+		address    string
+		bounceable bool
+	}{
+		{tonBounceable, true},
+		{tonNonBounceable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			a, err := Parse("ton:-239:" + tt.address)
+			require.NoError(t, err)
+
+			ton := a.(TONAccountID)
+			addr := ton.TONAddress()
+			assert.True(t, addr.UserFriendly)
+			assert.Equal(t, tt.bounceable, addr.Bounceable)
+			assert.False(t, addr.TestnetOnly)
+			assert.Equal(t, tonRaw, ton.RawAddress())
+			assert.Equal(t, "ton:-239:"+tonRaw, ton.ToRaw().String())
+		})
+	}
+}
+
+func TestTONTestnet(t *testing.T) {
+	a, err := NewTONFromString(TONTestnet, tonTestnetOnly)
+	require.NoError(t, err)
+	assert.True(t, a.IsTestnet())
+	assert.True(t, a.TONAddress().TestnetOnly)
+	assert.Equal(t, tonTestnetOnly, a.UserFriendlyAddress(true))
+
+	// Testnet-only addresses are rejected on mainnet
+	_, err = NewTONFromString(TONMainnet, tonTestnetOnly)
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestNewTON(t *testing.T) {
+	var hash [TONHashLength]byte
+	for i := range hash {
+		hash[i] = 0x33
+	}
+	a := NewTON(TONMainnet, TONMasterchain, hash)
+	assert.Equal(t, "-1:3333333333333333333333333333333333333333333333333333333333333333", a.Address())
+	assert.Equal(t, "Ef8zMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzM0vF", a.UserFriendlyAddress(true))
+	assert.NoError(t, a.Validate())
+}
+
+func TestParseTONAddressStdBase64(t *testing.T) {
+	var hash [TONHashLength]byte
+	for i := range hash {
+		hash[i] = 0xff
+	}
+	urlSafe := NewTON(TONMainnet, TONBasechain, hash).UserFriendlyAddress(true)
+	std := strings.NewReplacer("-", "+", "_", "/").Replace(urlSafe)
+	require.NotEqual(t, urlSafe, std)
+
+	// Standard alphabet (+ and /) is accepted as well
+	addr, err := ParseTONAddress(std)
+	require.NoError(t, err)
+	assert.Equal(t, hash, addr.Hash)
+	assert.True(t, addr.Bounceable)
+}
+
+func TestTONParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errType error
+	}{
+		{"bad reference", "ton:mainnet:" + tonRaw, ErrInvalidReference},
+		{"bad checksum", "ton:-239:EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2O", ErrInvalidAddress},
+		{"bad flag", "ton:-239:AQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N", ErrInvalidAddress},
+		{"short user-friendly", "ton:-239:EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8x", ErrInvalidAddress},
+		{"bad workchain", "ton:-239:1000:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", ErrInvalidAddress},
+		{"short hash", "ton:-239:0:83dfd552", ErrInvalidAddress},
+		{"non-hex hash", "ton:-239:0:zzdfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "got %v, want %v", err, tt.errType)
+		})
+	}
+}
+
+func TestTONNilReceiver(t *testing.T) {
+	var a *tonAccountID
+	assert.True(t, a.IsZero())
+	assert.Equal(t, TONNetwork(""), a.Network())
+	assert.Equal(t, "", a.RawAddress())
+	assert.Equal(t, "", a.UserFriendlyAddress(true))
+	assert.Nil(t, a.ToRaw())
+	assert.Nil(t, a.ToUserFriendly(true))
+}
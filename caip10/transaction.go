@@ -0,0 +1,270 @@
+package caip10
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mr-tron/base58"
+)
+
+// TransactionID identifies a transaction on a chain by its hash or signature.
+// Format: namespace:reference:hash, e.g.
+// "eip155:1:0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060".
+type TransactionID struct {
+	ChainID ChainID
+	Hash    string // transaction hash, or signature for solana
+}
+
+// SolanaSignatureLength is the length of a decoded Solana transaction signature.
+const SolanaSignatureLength = 64
+
+// defaultTransactionExplorers are the transaction URL templates of well-known
+// chains; "{hash}" is replaced by the transaction hash.
+var defaultTransactionExplorers = map[ChainID]string{
+	ChainIDEthereumMainnet: "https://etherscan.io/tx/{hash}",
+	ChainIDEthereumSepolia: "https://sepolia.etherscan.io/tx/{hash}",
+	ChainIDOptimism:        "https://optimistic.etherscan.io/tx/{hash}",
+	ChainIDArbitrumOne:     "https://arbiscan.io/tx/{hash}",
+	ChainIDBase:            "https://basescan.org/tx/{hash}",
+	ChainIDPolygon:         "https://polygonscan.com/tx/{hash}",
+	ChainIDBSC:             "https://bscscan.com/tx/{hash}",
+	ChainIDAvalanche:       "https://snowtrace.io/tx/{hash}",
+	ChainIDGnosis:          "https://gnosisscan.io/tx/{hash}",
+	ChainIDSolanaMainnet:   "https://explorer.solana.com/tx/{hash}",
+	ChainIDSolanaDevnet:    "https://explorer.solana.com/tx/{hash}?cluster=devnet",
+	ChainIDSolanaTestnet:   "https://explorer.solana.com/tx/{hash}?cluster=testnet",
+	ChainIDBitcoinMainnet:  "https://mempool.space/tx/{hash}",
+	ChainIDBitcoinTestnet:  "https://mempool.space/testnet/tx/{hash}",
+}
+
+var (
+	transactionExplorersMu sync.RWMutex
+	transactionExplorers   = map[ChainID]string{}
+)
+
+func init() {
+	for c, tmpl := range defaultTransactionExplorers {
+		transactionExplorers[c] = tmpl
+	}
+}
+
+// RegisterTransactionExplorer registers or replaces the transaction URL template
+// of a chain. The template must contain "{hash}", e.g. "https://etherscan.io/tx/{hash}".
+func RegisterTransactionExplorer(chainID ChainID, template string) error {
+	if err := chainID.Validate(); err != nil {
+		return err
+	}
+	if !strings.Contains(template, "{hash}") {
+		return fmt.Errorf("%w: explorer template must contain {hash}, got %q", ErrInvalidFormat, template)
+	}
+	transactionExplorersMu.Lock()
+	defer transactionExplorersMu.Unlock()
+	transactionExplorers[chainID] = template
+	return nil
+}
+
+// NewTransactionID creates a TransactionID. Hex hashes are lower cased.
+//
+// Hashes are validated per namespace:
+//   - eip155: 0x followed by 64 hex characters
+//   - bip122: 64 hex characters
+//   - solana: base58 encoding of a 64-byte signature, 87 or 88 characters
+//   - others: CAIP-10 address characters, [-.%a-zA-Z0-9]{1,128}
+func NewTransactionID(chainID ChainID, hash string) (TransactionID, error) {
+	if err := chainID.Validate(); err != nil {
+		return TransactionID{}, err
+	}
+	hash = normalizeTransactionHash(chainID.Namespace, hash)
+	if err := validateTransactionHash(chainID.Namespace, hash); err != nil {
+		return TransactionID{}, err
+	}
+	return TransactionID{ChainID: chainID, Hash: hash}, nil
+}
+
+// ParseTransactionID parses a TransactionID string.
+func ParseTransactionID(s string) (TransactionID, error) {
+	ns, reference, hash, err := SplitCAIP10(s)
+	if err != nil {
+		return TransactionID{}, err
+	}
+	return NewTransactionID(ChainID{Namespace: ns, Reference: reference}, hash)
+}
+
+// MustParseTransactionID parses a TransactionID string and panics if invalid.
+func MustParseTransactionID(s string) TransactionID {
+	tx, err := ParseTransactionID(s)
+	if err != nil {
+		panic(err)
+	}
+	return tx
+}
+
+// ToTransactionID creates a TransactionID on this chain, see NewTransactionID.
+func (c ChainID) ToTransactionID(hash string) (TransactionID, error) {
+	return NewTransactionID(c, hash)
+}
+
+// IsZero reports whether the TransactionID is the zero value.
+func (tx TransactionID) IsZero() bool {
+	return tx.ChainID.IsZero() && tx.Hash == ""
+}
+
+// Equal reports whether two TransactionIDs are equal.
+func (tx TransactionID) Equal(other TransactionID) bool {
+	return tx.ChainID.Equal(other.ChainID) && tx.Hash == other.Hash
+}
+
+// Validate checks if the TransactionID is valid.
+func (tx TransactionID) Validate() error {
+	if tx.IsZero() {
+		return ErrEmptyValue
+	}
+	if err := tx.ChainID.Validate(); err != nil {
+		return err
+	}
+	return validateTransactionHash(tx.ChainID.Namespace, tx.Hash)
+}
+
+func (tx TransactionID) String() string {
+	if tx.IsZero() {
+		return ""
+	}
+	return tx.ChainID.String() + ":" + tx.Hash
+}
+
+// ExplorerURL returns the block explorer URL of the transaction. Chains without a
+// registered template fall back to the first explorer in their ChainMetadata,
+// assuming the common "/tx/<hash>" path. ok is false if no explorer is known.
+func (tx TransactionID) ExplorerURL() (url string, ok bool) {
+	if tx.IsZero() {
+		return "", false
+	}
+	transactionExplorersMu.RLock()
+	tmpl, ok := transactionExplorers[tx.ChainID]
+	transactionExplorersMu.RUnlock()
+	if ok {
+		return strings.ReplaceAll(tmpl, "{hash}", tx.Hash), true
+	}
+	if meta, ok := LookupChainMetadata(tx.ChainID); ok && len(meta.Explorers) > 0 {
+		return strings.TrimSuffix(meta.Explorers[0], "/") + "/tx/" + tx.Hash, true
+	}
+	return "", false
+}
+
+// normalizeTransactionHash lower cases hex transaction hashes.
+func normalizeTransactionHash(ns Namespace, hash string) string {
+	switch ns {
+	case NamespaceEIP155:
+		if len(hash) > 2 && (hash[:2] == "0x" || hash[:2] == "0X") {
+			return "0x" + strings.ToLower(hash[2:])
+		}
+	case NamespaceBIP122:
+		return strings.ToLower(hash)
+	}
+	return hash
+}
+
+// validateTransactionHash validates a transaction hash per namespace rules.
+func validateTransactionHash(ns Namespace, hash string) error {
+	if hash == "" {
+		return fmt.Errorf("%w: empty hash", ErrInvalidTransaction)
+	}
+	switch ns {
+	case NamespaceEIP155:
+		if len(hash) != 66 || hash[:2] != "0x" || !isHex(hash[2:]) {
+			return fmt.Errorf("%w: eip155 hash must be 0x followed by 64 hex characters, got %q", ErrInvalidTransaction, hash)
+		}
+	case NamespaceBIP122:
+		if len(hash) != 64 || !isHex(hash) {
+			return fmt.Errorf("%w: bip122 txid must be 64 hex characters, got %q", ErrInvalidTransaction, hash)
+		}
+	case NamespaceSolana:
+		if len(hash) < 87 || len(hash) > 88 {
+			return fmt.Errorf("%w: solana signature must be 87 or 88 characters, got %d", ErrInvalidTransaction, len(hash))
+		}
+		if decoded, err := base58.Decode(hash); err != nil || len(decoded) != SolanaSignatureLength {
+			return fmt.Errorf("%w: solana signature must be base58 of %d bytes", ErrInvalidTransaction, SolanaSignatureLength)
+		}
+	default:
+		if !AddressRegex.MatchString(hash) {
+			return fmt.Errorf("%w: must match [-.%%a-zA-Z0-9]{1,128}, got %q", ErrInvalidTransaction, hash)
+		}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (tx TransactionID) MarshalText() ([]byte, error) {
+	return []byte(tx.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (tx *TransactionID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*tx = TransactionID{}
+		return nil
+	}
+	parsed, err := ParseTransactionID(string(text))
+	if err != nil {
+		return err
+	}
+	*tx = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (tx TransactionID) MarshalBinary() ([]byte, error) {
+	return []byte(tx.String()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (tx *TransactionID) UnmarshalBinary(data []byte) error {
+	return tx.UnmarshalText(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (tx TransactionID) MarshalJSON() ([]byte, error) {
+	if tx.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(tx.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (tx *TransactionID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*tx = TransactionID{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid JSON string for TransactionID")
+	}
+	return tx.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer.
+func (tx TransactionID) Value() (driver.Value, error) {
+	if tx.IsZero() {
+		return nil, nil
+	}
+	return tx.String(), nil
+}
+
+// Scan implements sql.Scanner.
+func (tx *TransactionID) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return tx.UnmarshalText([]byte(v))
+	case []byte:
+		return tx.UnmarshalText(v)
+	case nil:
+		*tx = TransactionID{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into TransactionID", src)
+	}
+}
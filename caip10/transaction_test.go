@@ -0,0 +1,127 @@
+package caip10
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEthTxHash    = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+	testSolanaTxSig  = "5VERv8NMvzbJMEkV8xnrLkEaWRtSz9CosKDYjCJjBRnbJLgp8uirBgmQpjKhoR4tjF3ZpRzrFmBV6UjKdiSZkQUW"
+	testBitcoinTxID  = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+	testPolkadotHash = "0x0e213cbf996726e8bb9f3be6ab5a8cc57193755bb6a9791405ed39e6818d1ab0"
+)
+
+func TestParseTransactionID(t *testing.T) {
+	valid := []string{
+		"eip155:1:" + testEthTxHash,
+		"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:" + testSolanaTxSig,
+		"bip122:000000000019d6689c085ae165831e93:" + testBitcoinTxID,
+		"polkadot:91b171bb158e2d3848fa23a9f1c25182:" + testPolkadotHash,
+	}
+	for _, s := range valid {
+		tx, err := ParseTransactionID(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, s, tx.String())
+		assert.NoError(t, tx.Validate())
+	}
+
+	tx := MustParseTransactionID("eip155:1:0x5C504ED432CB51138BCF09AA5E8A410DD4A1E204EF84BFED1BE16DFBA1B22060")
+	assert.Equal(t, testEthTxHash, tx.Hash, "hex hashes are lower cased")
+	assert.Equal(t, ChainIDEthereumMainnet, tx.ChainID)
+
+	fromChain, err := ChainIDEthereumMainnet.ToTransactionID(testEthTxHash)
+	require.NoError(t, err)
+	assert.True(t, fromChain.Equal(tx))
+}
+
+func TestParseTransactionIDErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		sentinel error
+	}{
+		{"", ErrEmptyValue},
+		{"eip155:1", ErrInvalidFormat},
+		{"eip155:x:" + testEthTxHash, ErrInvalidReference},
+		{"eip155:1:", ErrInvalidTransaction},
+		{"eip155:1:" + testEthTxHash[2:], ErrInvalidTransaction},
+		{"eip155:1:0x1234", ErrInvalidTransaction},
+		{"bip122:000000000019d6689c085ae165831e93:0x" + testBitcoinTxID[2:], ErrInvalidTransaction},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:" + testSolanaTxSig[:40], ErrInvalidTransaction},
+		{"solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:" + testSolanaTxSig[:87] + "0", ErrInvalidTransaction},
+		{"polkadot:91b171bb158e2d3848fa23a9f1c25182:a/b", ErrInvalidTransaction},
+	}
+	for _, tt := range tests {
+		_, err := ParseTransactionID(tt.input)
+		assert.True(t, errors.Is(err, tt.sentinel), "%q: got %v", tt.input, err)
+	}
+	assert.True(t, errors.Is(TransactionID{}.Validate(), ErrEmptyValue))
+}
+
+func TestTransactionIDExplorerURL(t *testing.T) {
+	tx := MustParseTransactionID("eip155:1:" + testEthTxHash)
+	u, ok := tx.ExplorerURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://etherscan.io/tx/"+testEthTxHash, u)
+
+	sol := MustParseTransactionID("solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1:" + testSolanaTxSig)
+	u, _ = sol.ExplorerURL()
+	assert.Equal(t, "https://explorer.solana.com/tx/"+testSolanaTxSig+"?cluster=devnet", u)
+
+	chain := NewEIP155ChainID(9876543)
+	other, err := chain.ToTransactionID(testEthTxHash)
+	require.NoError(t, err)
+	_, ok = other.ExplorerURL()
+	assert.False(t, ok)
+
+	require.NoError(t, RegisterChainMetadata(ChainMetadata{ChainID: chain, Explorers: []string{"https://scan.example/"}}))
+	u, ok = other.ExplorerURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://scan.example/tx/"+testEthTxHash, u)
+
+	require.NoError(t, RegisterTransactionExplorer(chain, "https://other.example/transaction/{hash}"))
+	u, _ = other.ExplorerURL()
+	assert.Equal(t, "https://other.example/transaction/"+testEthTxHash, u)
+
+	assert.True(t, errors.Is(RegisterTransactionExplorer(chain, "https://other.example"), ErrInvalidFormat))
+	assert.Error(t, RegisterTransactionExplorer(ChainID{}, "{hash}"))
+
+	_, ok = TransactionID{}.ExplorerURL()
+	assert.False(t, ok)
+}
+
+func TestTransactionIDSerialization(t *testing.T) {
+	tx := MustParseTransactionID("eip155:1:" + testEthTxHash)
+
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+	assert.Equal(t, `"eip155:1:`+testEthTxHash+`"`, string(data))
+	var fromJSON TransactionID
+	require.NoError(t, json.Unmarshal(data, &fromJSON))
+	assert.Equal(t, tx, fromJSON)
+	require.NoError(t, json.Unmarshal([]byte("null"), &fromJSON))
+	assert.True(t, fromJSON.IsZero())
+
+	bin, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	var fromBinary TransactionID
+	require.NoError(t, fromBinary.UnmarshalBinary(bin))
+	assert.Equal(t, tx, fromBinary)
+
+	v, err := tx.Value()
+	require.NoError(t, err)
+	var scanned TransactionID
+	require.NoError(t, scanned.Scan(v))
+	assert.Equal(t, tx, scanned)
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+	assert.Error(t, scanned.Scan(1))
+
+	v, err = TransactionID{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
@@ -0,0 +1,220 @@
+package caip10
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/donutnomad/eths/ecommon"
+)
+
+const NamespaceTron Namespace = "tron"
+
+// TronNetwork represents a Tron network (chain reference), the hex chain ID
+// returned by eth_chainId.
+type TronNetwork string
+
+// Tron networks
+const (
+	TronMainnet TronNetwork = "0x2b6653dc"
+	TronShasta  TronNetwork = "0x94a9059e"
+	TronNile    TronNetwork = "0xcd8690dc"
+)
+
+// String returns the network reference string.
+func (n TronNetwork) String() string {
+	return string(n)
+}
+
+// EVMChainID returns the EIP-155 chain ID of the network's JSON-RPC interface.
+func (n TronNetwork) EVMChainID() (*big.Int, bool) {
+	if !tronReferenceRegex.MatchString(string(n)) {
+		return nil, false
+	}
+	id, err := strconv.ParseUint(string(n[2:]), 16, 64)
+	if err != nil {
+		return nil, false
+	}
+	return new(big.Int).SetUint64(id), true
+}
+
+// tronAddressVersion is the version byte of Tron base58check addresses.
+const tronAddressVersion = 0x41
+
+// EncodeTronAddress encodes a 20-byte account address, the same keccak-derived
+// address as on EVM chains, as a Tron base58check address, e.g. "TJCnKsPa...".
+func EncodeTronAddress(address ecommon.Address) string {
+	return encodeBase58Check(append([]byte{tronAddressVersion}, address.Bytes()...))
+}
+
+// DecodeTronAddress decodes a Tron base58check address into its 20-byte account
+// address.
+//
+// Validation steps:
+//  1. Base58 decode and double SHA-256 checksum matches
+//  2. Version byte 0x41 followed by a 20-byte address
+func DecodeTronAddress(address string) (ecommon.Address, error) {
+	if err := validateBase58CheckVersion(address, []byte{tronAddressVersion}); err != nil {
+		return ecommon.Address{}, err
+	}
+	data, _ := decodeBase58Check(address)
+	return ecommon.BytesToAddress(data[1:]), nil
+}
+
+// ValidateTronAddress validates a Tron base58check address.
+// Returns nil if valid, error otherwise.
+func ValidateTronAddress(address string) error {
+	_, err := DecodeTronAddress(address)
+	return err
+}
+
+// TronAccountID is the interface for Tron account IDs.
+type TronAccountID interface {
+	AccountID
+	// Network returns the Tron network.
+	Network() TronNetwork
+	// Account returns the 20-byte account address.
+	Account() ecommon.Address
+	// ToEVM returns the account with the same key on an EVM chain.
+	ToEVM(chainID *big.Int) EIP155AccountID
+	// IsMainnet returns true if this is a mainnet account.
+	IsMainnet() bool
+}
+
+// Ensure tronAccountID implements TronAccountID at compile time
+var _ TronAccountID = (*tronAccountID)(nil)
+
+func init() {
+	RegisterParser(&tronParser{})
+}
+
+// tronAccountID represents a Tron account ID per CAIP-10.
+type tronAccountID struct {
+	*GenericAccountID                 // embedded, inherits all serialization methods
+	account           ecommon.Address // 20-byte account address
+}
+
+// NewTron creates a new TronAccountID from a base58check address.
+func NewTron(network TronNetwork, address string) (TronAccountID, error) {
+	if err := validateReference(NamespaceTron, string(network)); err != nil {
+		return nil, err
+	}
+	account, err := DecodeTronAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return newTron(network, account), nil
+}
+
+// MustNewTron creates a new TronAccountID and panics if invalid.
+func MustNewTron(network TronNetwork, address string) TronAccountID {
+	a, err := NewTron(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewTronFromAccount creates a new TronAccountID from a 20-byte account address.
+func NewTronFromAccount(network TronNetwork, account ecommon.Address) (TronAccountID, error) {
+	if err := validateReference(NamespaceTron, string(network)); err != nil {
+		return nil, err
+	}
+	return newTron(network, account), nil
+}
+
+func newTron(network TronNetwork, account ecommon.Address) *tronAccountID {
+	return &tronAccountID{
+		GenericAccountID: newGenericUnchecked(NamespaceTron, network.String(), EncodeTronAddress(account)),
+		account:          account,
+	}
+}
+
+// Network returns the Tron network.
+func (a *tronAccountID) Network() TronNetwork {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return TronNetwork(a.Reference())
+}
+
+// Account returns the 20-byte account address.
+func (a *tronAccountID) Account() ecommon.Address {
+	if a == nil {
+		return ecommon.Address{}
+	}
+	return a.account
+}
+
+// ToEVM returns the account with the same key on an EVM chain.
+func (a *tronAccountID) ToEVM(chainID *big.Int) EIP155AccountID {
+	if a.IsZero() {
+		return nil
+	}
+	return NewEIP155(chainID, a.account)
+}
+
+// IsMainnet returns true if this is a mainnet account.
+func (a *tronAccountID) IsMainnet() bool {
+	return a.Network() == TronMainnet
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *tronAccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *tronAccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// ToTron returns the account with the same key on Tron. The network is the Tron
+// network whose EVM chain ID equals the account's chain ID, or mainnet otherwise.
+func (a *eip155AccountID) ToTron() TronAccountID {
+	if a.IsZero() {
+		return nil
+	}
+	network := TronMainnet
+	for _, n := range []TronNetwork{TronMainnet, TronShasta, TronNile} {
+		if id, _ := n.EVMChainID(); a.chainID != nil && id.Cmp(a.chainID) == 0 {
+			network = n
+			break
+		}
+	}
+	return newTron(network, a.ethAddr)
+}
+
+// ToTron returns a with the same key on Tron, see NewTronFromAccount.
+func ToTron(a EIP155AccountID) TronAccountID {
+	return eip155Native(a).ToTron()
+}
+
+// --- tronParser ---
+
+type tronParser struct{}
+
+func (p *tronParser) Namespace() Namespace {
+	return NamespaceTron
+}
+
+func (p *tronParser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != NamespaceTron {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, NamespaceTron, ns)
+	}
+	return NewTron(TronNetwork(ref), addr)
+}
+
+func (p *tronParser) ParseAddress(reference, address string) (AccountID, error) {
+	return NewTron(TronNetwork(reference), address)
+}
@@ -0,0 +1,67 @@
+package caip10
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/donutnomad/eths/ecommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// USDT TRC-20 contract
+const (
+	testTronAddress = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	testTronHex     = "0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C"
+)
+
+func TestTronParse(t *testing.T) {
+	a, err := Parse("tron:0x2b6653dc:" + testTronAddress)
+	require.NoError(t, err)
+	tron, ok := a.(TronAccountID)
+	require.True(t, ok)
+	assert.Equal(t, TronMainnet, tron.Network())
+	assert.True(t, tron.IsMainnet())
+	assert.Equal(t, ChainIDTronMainnet, tron.ChainID())
+	assert.Equal(t, ecommon.HexToAddress(testTronHex), tron.Account())
+	assert.NoError(t, newGenericUnchecked(NamespaceTron, TronNile.String(), testTronAddress).Validate())
+
+	for _, s := range []string{
+		"tron:0x2b6653dc:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", // checksum
+		"tron:0x2b6653dc:1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", // bitcoin version byte
+		"tron:mainnet:" + testTronAddress,
+	} {
+		_, err := Parse(s)
+		assert.Error(t, err, s)
+	}
+	_, err = NewTron(TronMainnet, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u")
+	assert.True(t, errors.Is(err, ErrInvalidChecksum), "got %v", err)
+}
+
+func TestTronEVMConversion(t *testing.T) {
+	evm := NewEIP155FromHex(1, testTronHex)
+	tron := ToTron(evm)
+	assert.Equal(t, testTronAddress, tron.Address())
+	assert.Equal(t, TronMainnet, tron.Network())
+
+	// Tron's JSON-RPC chain ID selects the network
+	nile, _ := TronNile.EVMChainID()
+	assert.Equal(t, TronNile, ToTron(evm.SetChainID(nile)).Network())
+
+	back := tron.ToEVM(big.NewInt(56))
+	assert.Equal(t, "eip155:56:"+testTronHex, back.String())
+
+	id, ok := TronMainnet.EVMChainID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(728126428), id.Int64())
+
+	decoded, err := DecodeTronAddress(testTronAddress)
+	require.NoError(t, err)
+	assert.Equal(t, testTronAddress, EncodeTronAddress(decoded))
+
+	var zero *tronAccountID
+	assert.Nil(t, zero.ToEVM(big.NewInt(1)))
+	var zeroEVM *eip155AccountID
+	assert.Nil(t, zeroEVM.ToTron())
+}
@@ -0,0 +1,26 @@
+package caip10
+
+import "github.com/google/uuid"
+
+// UUIDNamespace is the namespace UUID for account UUIDs. It is the UUIDv5 of the
+// CAIP-10 specification URL in the URL namespace, and will never change.
+var UUIDNamespace = uuid.MustParse("b6bf42c1-faa6-5ddf-bfc6-51f526413a8b")
+
+// UUID returns the UUIDv5 of the account's key, see AccountKey.UUID.
+func UUID(a AccountID) uuid.UUID {
+	return KeyOf(a).UUID()
+}
+
+// UUID returns the UUIDv5 of the account's key, see AccountKey.UUID.
+func (a *GenericAccountID) UUID() uuid.UUID {
+	return KeyOf(a).UUID()
+}
+
+// UUID returns the UUIDv5 of the key's canonical string in UUIDNamespace, so
+// equal accounts map to the same UUID. The zero key maps to uuid.Nil.
+func (k AccountKey) UUID() uuid.UUID {
+	if k.IsZero() {
+		return uuid.Nil
+	}
+	return uuid.NewSHA1(UUIDNamespace, []byte(k.String()))
+}
@@ -0,0 +1,25 @@
+package caip10
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID(t *testing.T) {
+	assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/ChainAgnostic/CAIPs/blob/main/CAIPs/caip-10.md")), UUIDNamespace)
+
+	a := MustParse("eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	lower := MustNewGeneric(NamespaceEIP155, "1", "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb")
+
+	// Golden value: the UUID must never change.
+	assert.Equal(t, "cf7b5f69-b9d8-5cda-944d-a80d04caf7c3", UUID(a).String())
+	assert.Equal(t, uuid.Version(5), UUID(a).Version())
+	assert.Equal(t, UUID(a), UUID(lower))
+	assert.Equal(t, UUID(a), KeyOf(a).UUID())
+	assert.NotEqual(t, UUID(a), UUID(MustNewGeneric(NamespaceEIP155, "137", a.Address())))
+
+	assert.Equal(t, uuid.Nil, (*GenericAccountID)(nil).UUID())
+	assert.Equal(t, uuid.Nil, AccountKey{}.UUID())
+}
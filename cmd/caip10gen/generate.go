@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// generatedSuffix ends the names of files written by caip10gen.
+const generatedSuffix = "_gen.go"
+
+// generate writes the files of every namespace in spec to outDir.
+func generate(spec *Spec, outDir, pkg, specPath string) error {
+	for _, ns := range spec.Namespaces {
+		data := templateData{NamespaceSpec: ns, Package: pkg, Source: filepath.Base(specPath)}
+		src, err := render(namespaceTemplate, data)
+		if err != nil {
+			return fmt.Errorf("namespace %q: %w", ns.Namespace, err)
+		}
+		if err := os.WriteFile(filepath.Join(outDir, fileName(ns.Namespace)+generatedSuffix), src, 0o644); err != nil {
+			return err
+		}
+		if ns.Address.Validator == "" {
+			continue
+		}
+		stub := filepath.Join(outDir, fileName(ns.Namespace)+"_validate.go")
+		if _, err := os.Stat(stub); err == nil {
+			continue
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		src, err = render(validatorTemplate, data)
+		if err != nil {
+			return fmt.Errorf("namespace %q: %w", ns.Namespace, err)
+		}
+		if err := os.WriteFile(stub, src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileName returns the file name prefix of a namespace.
+func fileName(namespace string) string {
+	return strings.ReplaceAll(namespace, "-", "_")
+}
+
+type templateData struct {
+	NamespaceSpec
+	Package string
+	Source  string
+}
+
+// Var returns the unexported identifier prefix, e.g. "sui" for "Sui".
+func (d templateData) Var() string {
+	r, n := utf8.DecodeRuneInString(d.Name)
+	return string(unicode.ToLower(r)) + d.Name[n:]
+}
+
+func render(t *template.Template, data templateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// rawString quotes s as a Go raw string literal if possible.
+func rawString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+var funcs = template.FuncMap{
+	"raw":   rawString,
+	"quote": strconv.Quote,
+	// errorf escapes a description for use in a fmt format string.
+	"errorf": func(s string) string {
+		q := strconv.Quote(strings.ReplaceAll(s, "%", "%%"))
+		return q[1 : len(q)-1]
+	},
+}
+
+var namespaceTemplate = template.Must(template.New("namespace").Funcs(funcs).Parse(`// Code generated by caip10gen from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const Namespace{{.Name}} Namespace = {{quote .Namespace}}
+
+// {{.Name}}Network represents a {{.Name}} network (chain reference).
+{{- if .Spec}}
+// {{.Spec}}
+{{- end}}
+type {{.Name}}Network string
+{{if .Networks}}
+// {{.Name}} networks
+const (
+{{- range .Networks}}
+	{{$.Name}}{{.Name}} {{$.Name}}Network = {{quote .Reference}}{{if .Comment}} // {{.Comment}}{{end}}
+{{- end}}
+)
+{{end}}
+// String returns the network reference string.
+func (n {{.Name}}Network) String() string {
+	return string(n)
+}
+
+// New{{.Name}}ChainID creates a CAIP-2 chain ID for a {{.Name}} network.
+func New{{.Name}}ChainID(network {{.Name}}Network) ChainID {
+	return ChainID{Namespace: Namespace{{.Name}}, Reference: network.String()}
+}
+
+// {{.Var}}ReferenceRegex validates {{.Name}} chain references: {{.Reference.Description}}.
+var {{.Var}}ReferenceRegex = regexp.MustCompile({{raw .Reference.Pattern}})
+
+// {{.Var}}AddressRegex validates {{.Name}} addresses: {{.Address.Description}}.
+var {{.Var}}AddressRegex = regexp.MustCompile({{raw .Address.Pattern}})
+
+func init() {
+	registerGeneratedNamespace(Namespace{{.Name}}, validate{{.Name}}Reference, Validate{{.Name}}Address)
+	RegisterParser(&{{.Var}}Parser{})
+}
+
+func validate{{.Name}}Reference(reference string) error {
+	if !{{.Var}}ReferenceRegex.MatchString(reference) {
+		return fmt.Errorf("%w: invalid {{.Name}} reference, must be {{errorf .Reference.Description}}, got %q", ErrInvalidReference, reference)
+	}
+	return nil
+}
+
+// Validate{{.Name}}Address validates a {{.Name}} address string.
+// Returns nil if valid, error otherwise.
+func Validate{{.Name}}Address(address string) error {
+	if !{{.Var}}AddressRegex.MatchString(address) {
+		return fmt.Errorf("%w: invalid {{.Name}} address, must be {{errorf .Address.Description}}, got %q", ErrInvalidAddress, address)
+	}
+{{- if .Address.Validator}}
+	return {{.Address.Validator}}(address)
+{{- else}}
+	return nil
+{{- end}}
+}
+
+// {{.Name}}AccountID is the interface for {{.Name}} account IDs.
+type {{.Name}}AccountID interface {
+	AccountID
+	// Network returns the {{.Name}} network.
+	Network() {{.Name}}Network
+}
+
+// Ensure {{.Var}}AccountID implements {{.Name}}AccountID at compile time
+var _ {{.Name}}AccountID = (*{{.Var}}AccountID)(nil)
+
+// {{.Var}}AccountID represents a {{.Name}} account ID per CAIP-10.
+type {{.Var}}AccountID struct {
+	*GenericAccountID // embedded, inherits all serialization methods
+}
+
+// New{{.Name}} creates a new {{.Name}}AccountID.
+func New{{.Name}}(network {{.Name}}Network, address string) ({{.Name}}AccountID, error) {
+	if err := validateReference(Namespace{{.Name}}, string(network)); err != nil {
+		return nil, err
+	}
+	if err := Validate{{.Name}}Address(address); err != nil {
+		return nil, err
+	}
+	return &{{.Var}}AccountID{
+		GenericAccountID: newGenericUnchecked(Namespace{{.Name}}, network.String(), address),
+	}, nil
+}
+
+// MustNew{{.Name}} creates a new {{.Name}}AccountID and panics if invalid.
+func MustNew{{.Name}}(network {{.Name}}Network, address string) {{.Name}}AccountID {
+	a, err := New{{.Name}}(network, address)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Network returns the {{.Name}} network.
+func (a *{{.Var}}AccountID) Network() {{.Name}}Network {
+	if a == nil || a.GenericAccountID == nil {
+		return ""
+	}
+	return {{.Name}}Network(a.Reference())
+}
+
+// IsZero reports whether the AccountID is the zero value.
+func (a *{{.Var}}AccountID) IsZero() bool {
+	return a == nil || a.GenericAccountID == nil || a.GenericAccountID.IsZero()
+}
+
+// Equal reports whether two AccountIDs are equal.
+func (a *{{.Var}}AccountID) Equal(other AccountID) bool {
+	if a.IsZero() && (other == nil || other.IsZero()) {
+		return true
+	}
+	if a.IsZero() || other == nil || other.IsZero() {
+		return false
+	}
+	return a.GenericAccountID.Equal(other)
+}
+
+// Format implements fmt.Formatter, see formatAccount.
+func (a *{{.Var}}AccountID) Format(f fmt.State, verb rune) {
+	formatNative(f, verb, a, a == nil || a.GenericAccountID == nil)
+}
+
+// --- {{.Var}}Parser ---
+
+type {{.Var}}Parser struct{}
+
+func (p *{{.Var}}Parser) Namespace() Namespace {
+	return Namespace{{.Name}}
+}
+
+func (p *{{.Var}}Parser) Parse(s string) (AccountID, error) {
+	ns, ref, addr, err := SplitCAIP10(s)
+	if err != nil {
+		return nil, err
+	}
+	if ns != Namespace{{.Name}} {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrInvalidNamespace, Namespace{{.Name}}, ns)
+	}
+	return New{{.Name}}({{.Name}}Network(ref), addr)
+}
+
+func (p *{{.Var}}Parser) ParseAddress(reference, address string) (AccountID, error) {
+	return New{{.Name}}({{.Name}}Network(reference), address)
+}
+`))
+
+var validatorTemplate = template.Must(template.New("validator").Funcs(funcs).Parse(`package {{.Package}}
+
+// {{.Address.Validator}} validates {{.Name}} addresses that match the address
+// pattern of {{.Source}}.
+func {{.Address.Validator}}(address string) error {
+	return nil
+}
+`))
@@ -0,0 +1,67 @@
+// Command caip10gen generates CAIP-10 namespace support for the caip10 package
+// from a curated YAML description of ChainAgnostic namespaces.
+//
+// For each namespace in the spec it writes <namespace>_gen.go with the
+// Namespace constant, a typed network with the known references, reference and
+// address patterns, a typed AccountID with constructors, and the parser
+// registration. Namespaces that need more than a pattern name an address
+// validator; a stub for it is written to <namespace>_validate.go unless the
+// file exists.
+//
+// With -upstream pointing to a checkout of github.com/ChainAgnostic/namespaces,
+// caip10gen also reports upstream namespaces supported neither by the spec nor
+// by hand-written code, and exits with status 1 if there are any.
+//
+// Usage:
+//
+//	go run ./cmd/caip10gen -spec caip10/namespaces.yaml -out caip10 [-upstream ../namespaces]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	specPath := flag.String("spec", "namespaces.yaml", "curated namespace spec")
+	outDir := flag.String("out", ".", "directory of the caip10 package")
+	pkg := flag.String("pkg", "caip10", "package name of the generated files")
+	upstream := flag.String("upstream", "", "checkout of ChainAgnostic/namespaces to check coverage against")
+	flag.Parse()
+
+	if err := run(*specPath, *outDir, *pkg, *upstream); err != nil {
+		fmt.Fprintln(os.Stderr, "caip10gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, outDir, pkg, upstream string) error {
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+	handWritten, err := handWrittenNamespaces(outDir)
+	if err != nil {
+		return err
+	}
+	for _, ns := range spec.Namespaces {
+		if _, ok := handWritten[ns.Namespace]; ok {
+			return fmt.Errorf("namespace %q is already implemented by hand-written code in %s", ns.Namespace, outDir)
+		}
+	}
+	if err := generate(spec, outDir, pkg, specPath); err != nil {
+		return err
+	}
+	if upstream == "" {
+		return nil
+	}
+	missing, err := missingNamespaces(upstream, spec, handWritten)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("upstream namespaces not covered: %v", missing)
+	}
+	return nil
+}
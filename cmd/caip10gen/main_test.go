@@ -0,0 +1,137 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedFilesUpToDate fails if the generated files of the caip10
+// package differ from the output for namespaces.yaml; run go generate.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	const pkgDir = "../../caip10"
+	spec, err := loadSpec(filepath.Join(pkgDir, "namespaces.yaml"))
+	require.NoError(t, err)
+	out := t.TempDir()
+	require.NoError(t, generate(spec, out, "caip10", "namespaces.yaml"))
+
+	for _, ns := range spec.Namespaces {
+		name := fileName(ns.Namespace) + generatedSuffix
+		want, err := os.ReadFile(filepath.Join(out, name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(pkgDir, name))
+		require.NoError(t, err, "missing %s, run go generate", name)
+		assert.Equal(t, string(want), string(got), "%s is stale, run go generate", name)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "namespaces.yaml", `
+namespaces:
+  - namespace: my-chain
+    name: MyChain
+    reference:
+      pattern: '^(main|test)$'
+      description: main or test
+    networks:
+      - {name: Main, reference: main, comment: production}
+    address:
+      pattern: '^[a-z]{4}$'
+      description: 4 lowercase letters with 100% coverage
+      validator: validateMyChainAddress
+`)
+	writeFile(t, dir, "my_chain_gen.go", "stale")
+	require.NoError(t, run(filepath.Join(dir, "namespaces.yaml"), dir, "caip10", ""))
+
+	src, err := os.ReadFile(filepath.Join(dir, "my_chain_gen.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"// Code generated by caip10gen from namespaces.yaml. DO NOT EDIT.",
+		`const NamespaceMyChain Namespace = "my-chain"`,
+		`MyChainMain MyChainNetwork = "main" // production`,
+		"regexp.MustCompile(`^(main|test)$`)",
+		"with 100%% coverage",
+		"return validateMyChainAddress(address)",
+		"RegisterParser(&myChainParser{})",
+	} {
+		assert.Contains(t, string(src), want)
+	}
+
+	stub := filepath.Join(dir, "my_chain_validate.go")
+	src, err = os.ReadFile(stub)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func validateMyChainAddress(address string) error")
+
+	// Validator stubs are not overwritten
+	writeFile(t, dir, "my_chain_validate.go", "package caip10\n")
+	require.NoError(t, run(filepath.Join(dir, "namespaces.yaml"), dir, "caip10", ""))
+	src, err = os.ReadFile(stub)
+	require.NoError(t, err)
+	assert.Equal(t, "package caip10\n", string(src))
+}
+
+func TestSpecErrors(t *testing.T) {
+	tests := map[string]string{
+		"invalid namespace": `{namespaces: [{namespace: "X", name: X}]}`,
+		"unexported name":   `{namespaces: [{namespace: abc, name: abc}]}`,
+		"missing pattern":   `{namespaces: [{namespace: abc, name: Abc, reference: {description: x}}]}`,
+		"bad pattern":       `{namespaces: [{namespace: abc, name: Abc, reference: {pattern: "(", description: x}}]}`,
+		"network mismatch": `{namespaces: [{namespace: abc, name: Abc,
+			reference: {pattern: "^a$", description: a}, address: {pattern: "^a$", description: a},
+			networks: [{name: Main, reference: b}]}]}`,
+		"exported validator": `{namespaces: [{namespace: abc, name: Abc,
+			reference: {pattern: "^a$", description: a}, address: {pattern: "^a$", description: a, validator: Check}}]}`,
+		"duplicate": `{namespaces: [
+			{namespace: abc, name: Abc, reference: {pattern: "^a$", description: a}, address: {pattern: "^a$", description: a}},
+			{namespace: abc, name: Abc, reference: {pattern: "^a$", description: a}, address: {pattern: "^a$", description: a}}]}`,
+	}
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, dir, "namespaces.yaml", spec)
+			_, err := loadSpec(filepath.Join(dir, "namespaces.yaml"))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHandWrittenConflict(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "abc.go", "package caip10\n\nconst NamespaceAbc Namespace = \"abc\"\n")
+	writeFile(t, dir, "namespaces.yaml", `{namespaces: [{namespace: abc, name: Abc,
+		reference: {pattern: "^a$", description: a}, address: {pattern: "^a$", description: a}}]}`)
+	err := run(filepath.Join(dir, "namespaces.yaml"), dir, "caip10", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hand-written")
+}
+
+func TestMissingNamespaces(t *testing.T) {
+	upstream := t.TempDir()
+	for _, ns := range []string{"eip155", "sui", "tezos", "_template"} {
+		writeFile(t, filepath.Join(upstream, ns), "caip10.md", "")
+	}
+	writeFile(t, filepath.Join(upstream, "mina"), "caip2.md", "") // no CAIP-10 profile
+	writeFile(t, upstream, "README.md", "")
+
+	spec := &Spec{Namespaces: []NamespaceSpec{{Namespace: "sui"}}}
+	missing, err := missingNamespaces(upstream, spec, map[string]struct{}{"eip155": {}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tezos"}, missing)
+
+	handWritten, err := handWrittenNamespaces("../../caip10")
+	require.NoError(t, err)
+	assert.Contains(t, handWritten, "eip155")
+	assert.Contains(t, handWritten, "fil")
+	assert.NotContains(t, handWritten, "sui")
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(strings.TrimLeft(content, "\n")), 0o644))
+}
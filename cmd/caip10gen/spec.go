@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the curated description of generated namespaces.
+type Spec struct {
+	Namespaces []NamespaceSpec `yaml:"namespaces"`
+}
+
+// NamespaceSpec describes one CAIP-2 namespace.
+type NamespaceSpec struct {
+	// Namespace is the CAIP-2 namespace, e.g. "sui".
+	Namespace string `yaml:"namespace"`
+	// Name is the Go identifier prefix, e.g. "Sui".
+	Name string `yaml:"name"`
+	// Spec is the URL of the namespace's CAIP-2 profile.
+	Spec      string        `yaml:"spec"`
+	Reference PatternSpec   `yaml:"reference"`
+	Networks  []NetworkSpec `yaml:"networks"`
+	Address   AddressSpec   `yaml:"address"`
+}
+
+// PatternSpec is a regular expression with a description for error messages.
+type PatternSpec struct {
+	Pattern     string `yaml:"pattern"`
+	Description string `yaml:"description"`
+}
+
+// NetworkSpec is a known chain reference of a namespace.
+type NetworkSpec struct {
+	// Name is the Go identifier suffix, e.g. "Mainnet".
+	Name      string `yaml:"name"`
+	Reference string `yaml:"reference"`
+	Comment   string `yaml:"comment"`
+}
+
+// AddressSpec describes the account addresses of a namespace.
+type AddressSpec struct {
+	PatternSpec `yaml:",inline"`
+	// Validator is the unexported function that validates addresses matching
+	// the pattern further, e.g. by checking a checksum. Optional.
+	Validator string `yaml:"validator"`
+}
+
+// namespaceRegex is the CAIP-2 namespace syntax.
+var namespaceRegex = regexp.MustCompile(`^[-a-z0-9]{3,8}$`)
+
+func loadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+func (s *Spec) validate() error {
+	seen := make(map[string]bool)
+	for _, ns := range s.Namespaces {
+		if !namespaceRegex.MatchString(ns.Namespace) {
+			return fmt.Errorf("invalid namespace %q", ns.Namespace)
+		}
+		if seen[ns.Namespace] {
+			return fmt.Errorf("duplicate namespace %q", ns.Namespace)
+		}
+		seen[ns.Namespace] = true
+		if err := ns.validate(); err != nil {
+			return fmt.Errorf("namespace %q: %w", ns.Namespace, err)
+		}
+	}
+	return nil
+}
+
+func (ns *NamespaceSpec) validate() error {
+	if !token.IsIdentifier(ns.Name) || !token.IsExported(ns.Name) {
+		return fmt.Errorf("name %q is not an exported Go identifier", ns.Name)
+	}
+	ref, err := compilePattern("reference", ns.Reference)
+	if err != nil {
+		return err
+	}
+	if _, err := compilePattern("address", ns.Address.PatternSpec); err != nil {
+		return err
+	}
+	if v := ns.Address.Validator; v != "" && (!token.IsIdentifier(v) || token.IsExported(v)) {
+		return fmt.Errorf("address validator %q is not an unexported Go identifier", v)
+	}
+	for _, n := range ns.Networks {
+		if !token.IsIdentifier(ns.Name + n.Name) {
+			return fmt.Errorf("network name %q is not a Go identifier", n.Name)
+		}
+		if !ref.MatchString(n.Reference) {
+			return fmt.Errorf("network %s reference %q does not match %s", n.Name, n.Reference, ns.Reference.Pattern)
+		}
+	}
+	return nil
+}
+
+func compilePattern(field string, p PatternSpec) (*regexp.Regexp, error) {
+	if p.Pattern == "" || p.Description == "" {
+		return nil, fmt.Errorf("%s needs a pattern and a description", field)
+	}
+	re, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%s pattern: %w", field, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// handWrittenNamespaces returns the values of the Namespace constants declared
+// in the non-generated files of the package in dir.
+func handWrittenNamespaces(dir string) (map[string]struct{}, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	out := make(map[string]struct{})
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, generatedSuffix) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				addNamespaceConst(out, spec.(*ast.ValueSpec))
+			}
+		}
+	}
+	return out, nil
+}
+
+func addNamespaceConst(out map[string]struct{}, spec *ast.ValueSpec) {
+	typ, ok := spec.Type.(*ast.Ident)
+	if !ok || typ.Name != "Namespace" {
+		return
+	}
+	for _, v := range spec.Values {
+		lit, ok := v.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if s, err := strconv.Unquote(lit.Value); err == nil {
+			out[s] = struct{}{}
+		}
+	}
+}
+
+// missingNamespaces returns the namespaces with a CAIP-10 profile in the
+// ChainAgnostic/namespaces checkout at dir that are supported neither by spec
+// nor by hand-written code.
+func missingNamespaces(dir string, spec *Spec, handWritten map[string]struct{}) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	covered := make(map[string]bool, len(spec.Namespaces)+len(handWritten))
+	for _, ns := range spec.Namespaces {
+		covered[ns.Namespace] = true
+	}
+	for ns := range handWritten {
+		covered[ns] = true
+	}
+	var missing []string
+	for _, e := range entries {
+		ns := e.Name()
+		if !e.IsDir() || !namespaceRegex.MatchString(ns) || covered[ns] {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, ns, "caip10.md")); err != nil {
+			continue
+		}
+		missing = append(missing, ns)
+	}
+	slices.Sort(missing)
+	return missing, nil
+}